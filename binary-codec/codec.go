@@ -1,7 +1,6 @@
 package binarycodec
 
 import (
-	"bytes"
 	"encoding/hex"
 	"errors"
 	"math"
@@ -41,14 +40,16 @@ const (
 
 // Encode converts a JSON transaction object to a hex string in the canonical binary format.
 // The binary format is defined in XRPL's core codebase.
-func Encode(json map[string]any) (string, error) {
-	st := types.NewSTObject(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(definitions.Get())))
+// The embedded definitions are used unless WithDefinitions is passed.
+func Encode(json map[string]any, opts ...Option) (string, error) {
+	o := newOptions(opts)
+	st := types.NewSTObjectWithDefinitions(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(o.defs())), o.definitions)
 
 	// Iterate over the keys in the provided JSON
 	for k := range json {
 
 		// Get the FieldIdNameMap from the definitions package
-		fh := definitions.Get().Fields[k]
+		fh := o.defs().Fields[k]
 
 		// If the field is not found in the FieldIdNameMap, delete it from the JSON
 
@@ -69,7 +70,7 @@ func Encode(json map[string]any) (string, error) {
 // EncodeForMultiSign: encodes a transaction into binary format in preparation for providing one
 // signature towards a multi-signed transaction.
 // (Only encodes fields that are intended to be signed.)
-func EncodeForMultisigning(json map[string]any, xrpAccountID string) (string, error) {
+func EncodeForMultisigning(json map[string]any, xrpAccountID string, opts ...Option) (string, error) {
 	st := &types.AccountID{}
 
 	// SigningPubKey is required for multi-signing but should be set to empty string.
@@ -81,7 +82,7 @@ func EncodeForMultisigning(json map[string]any, xrpAccountID string) (string, er
 		return "", err
 	}
 
-	encoded, err := Encode(removeNonSigningFields(json))
+	encoded, err := Encode(removeNonSigningFields(json, newOptions(opts).defs()), opts...)
 
 	if err != nil {
 		return "", err
@@ -91,9 +92,9 @@ func EncodeForMultisigning(json map[string]any, xrpAccountID string) (string, er
 }
 
 // Encodes a transaction into binary format in preparation for signing.
-func EncodeForSigning(json map[string]any) (string, error) {
+func EncodeForSigning(json map[string]any, opts ...Option) (string, error) {
 
-	encoded, err := Encode(removeNonSigningFields(json))
+	encoded, err := Encode(removeNonSigningFields(json, newOptions(opts).defs()), opts...)
 
 	if err != nil {
 		return "", err
@@ -123,8 +124,10 @@ func EncodeForSigningClaim(json map[string]any) (string, error) {
 
 	}
 
-	if bytes.HasPrefix(amount, []byte{0x40}) {
-		amount = bytes.Replace(amount, []byte{0x40}, []byte{0x00}, 1)
+	// XRP amounts are signed as a plain 64-bit number of drops, without the
+	// positive bit of their serialized form.
+	if len(amount) == 8 {
+		amount[0] &^= 0x40
 	}
 
 	return strings.ToUpper(paymentChannelClaimPrefix + hex.EncodeToString(channel) + hex.EncodeToString(amount)), nil
@@ -186,9 +189,9 @@ func EncodeForSigningBatch(json map[string]any) (string, error) {
 }
 
 // removeNonSigningFields removes the fields from a JSON transaction object that should not be signed.
-func removeNonSigningFields(json map[string]any) map[string]any {
+func removeNonSigningFields(json map[string]any, d *definitions.Definitions) map[string]any {
 	for k := range json {
-		fi, _ := definitions.Get().GetFieldInstanceByFieldName(k)
+		fi, _ := d.GetFieldInstanceByFieldName(k)
 
 		if fi != nil && !fi.IsSigningField {
 			delete(json, k)
@@ -199,13 +202,15 @@ func removeNonSigningFields(json map[string]any) map[string]any {
 }
 
 // Decode decodes a hex string in the canonical binary format into a JSON transaction object.
-func Decode(hexEncoded string) (map[string]any, error) {
+// The embedded definitions are used unless WithDefinitions is passed.
+func Decode(hexEncoded string, opts ...Option) (map[string]any, error) {
 	b, err := hex.DecodeString(hexEncoded)
 	if err != nil {
 		return nil, err
 	}
-	p := serdes.NewBinaryParser(b, definitions.Get())
-	st := types.NewSTObject(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(definitions.Get())))
+	o := newOptions(opts)
+	p := serdes.NewBinaryParser(b, o.defs())
+	st := types.NewSTObjectWithDefinitions(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(o.defs())), o.definitions)
 	m, err := st.ToJSON(p)
 	if err != nil {
 		return nil, err
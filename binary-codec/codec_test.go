@@ -1,6 +1,9 @@
 package binarycodec
 
 import (
+	"crypto/sha512"
+	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/Peersyst/xrpl-go/binary-codec/types"
@@ -228,6 +231,30 @@ func TestEncode(t *testing.T) {
 			output:      "340000018446744073",
 			expectedErr: nil,
 		},
+		{
+			// Hash192 is type 21, so the header is the field code followed by the type code.
+			description: "serialize MPTokenIssuanceID - Hash192",
+			input:       map[string]any{"MPTokenIssuanceID": "000004C463C52827307480341125DA0577DEFC38405B0E3E"},
+			output:      "0115000004C463C52827307480341125DA0577DEFC38405B0E3E",
+			expectedErr: nil,
+		},
+		{
+			description: "serialize MPT fields",
+			input: map[string]any{
+				"TransactionType": "AMMClawback",
+				"AssetScale":      2,
+				"Holder":          "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			},
+			output:      "12001F8B14B5F762798A53D543A014CAF8B297CFF8F2F937E8051002",
+			expectedErr: nil,
+		},
+		{
+			// rippled serializes the MPT amount fields as base 10 in JSON.
+			description: "serialize MaximumAmount - base 10 UInt64",
+			input:       map[string]any{"MaximumAmount": "9223372036854775807", "OutstandingAmount": "1000"},
+			output:      "30187FFFFFFFFFFFFFFF301900000000000003E8",
+			expectedErr: nil,
+		},
 		{
 			description: "serialize LedgerEntryType example - UInt8",
 			input:       map[string]any{"LedgerEntryType": "RippleState"},
@@ -304,6 +331,22 @@ func TestEncode(t *testing.T) {
 			output:      "EA7C0F04C4D46544659A2D58525043686174E1",
 			expectedErr: nil,
 		},
+		{
+			// Each step is a type byte followed by its fields, paths are separated by
+			// 0xFF and the path set ends with 0x00, as rippled serializes an STPathSet.
+			description: "serialize paths ending with an issued currency",
+			input: map[string]any{"Paths": []any{
+				[]any{
+					map[string]any{"currency": "USD", "issuer": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"},
+				},
+				[]any{
+					map[string]any{"account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"},
+					map[string]any{"currency": "USD", "issuer": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"},
+				},
+			}},
+			output:      "0112300000000000000000000000005553440000000000B5F762798A53D543A014CAF8B297CFF8F2F937E8FF01B5F762798A53D543A014CAF8B297CFF8F2F937E8300000000000000000000000005553440000000000B5F762798A53D543A014CAF8B297CFF8F2F937E800",
+			expectedErr: nil,
+		},
 		{
 			description: "invalid pathset",
 			input: map[string]any{"Paths": []any{
@@ -387,6 +430,18 @@ func TestDecode(t *testing.T) {
 			output:      map[string]any{"OwnerNode": "000000044B82FA09"},
 			expectedErr: nil,
 		},
+		{
+			description: "deserialize MPTokenIssuanceID - Hash192",
+			input:       "0115000004C463C52827307480341125DA0577DEFC38405B0E3E",
+			output:      map[string]any{"MPTokenIssuanceID": "000004C463C52827307480341125DA0577DEFC38405B0E3E"},
+			expectedErr: nil,
+		},
+		{
+			description: "deserialize MaximumAmount - base 10 UInt64",
+			input:       "30187FFFFFFFFFFFFFFF301900000000000003E8",
+			output:      map[string]any{"MaximumAmount": "9223372036854775807", "OutstandingAmount": "1000"},
+			expectedErr: nil,
+		},
 		{
 			description: "deserialize Uint16 LedgerEntryType",
 			input:       "110072",
@@ -429,6 +484,20 @@ func TestDecode(t *testing.T) {
 			output:      map[string]any{"Digest": "73734B611DDA23D3F5F62E20A173B78AB8406AC5015094DA53F53D39B9EDB06C"},
 			expectedErr: nil,
 		},
+		{
+			description: "deserialize paths ending with an issued currency",
+			input:       "0112300000000000000000000000005553440000000000B5F762798A53D543A014CAF8B297CFF8F2F937E8FF01B5F762798A53D543A014CAF8B297CFF8F2F937E8300000000000000000000000005553440000000000B5F762798A53D543A014CAF8B297CFF8F2F937E800",
+			output: map[string]any{"Paths": []any{
+				[]any{
+					map[string]any{"currency": "USD", "issuer": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "type": 48, "type_hex": "0000000000000030"},
+				},
+				[]any{
+					map[string]any{"account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "type": 1, "type_hex": "0000000000000001"},
+					map[string]any{"currency": "USD", "issuer": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "type": 48, "type_hex": "0000000000000030"},
+				},
+			}},
+			expectedErr: nil,
+		},
 	}
 
 	for _, tc := range tt {
@@ -446,6 +515,36 @@ func TestDecode(t *testing.T) {
 
 }
 
+// A Payment with paths stepping through XRP, as validated on mainnet in transaction
+// B521424226FC100A2A802FE20476A5F8426FD3F720176DC5CCCE0D75738CC208.
+const mainnetPaymentWithPaths = "1200002200000000240000034A201B009717BE61400000000098968068400000000000000C69D4564B964A845AC0000000000000000000000000555344000000000069D33B18D53385F8A3185516C2EDA5DEDB8AC5C673210379F17CFA0FFD7518181594BE69FE9A10471D6DE1F4055C6D2746AFD6CF89889E74473045022100D55ED1953F860ADC1BC5CD993ABB927F48156ACA31C64737865F4F4FF6D015A80220630704D2BD09C8E99F26090C25F11B28F5D96A1350454402C2CED92B39FFDBAF811469D33B18D53385F8A3185516C2EDA5DEDB8AC5C6831469D33B18D53385F8A3185516C2EDA5DEDB8AC5C6F9EA7C06636C69656E747D077274312E312E31E1F1011201F3B1997562FD742B54D4EBDEA1D6AEA3D4906B8F100000000000000000000000000000000000000000FF014B4E9C06F24296074F7BC48F92A97916C6DC5EA901DD39C650A96EDA48334E70CC4A85B8B2E8502CD310000000000000000000000000000000000000000000"
+
+func TestDecode_MainnetPaymentWithPaths(t *testing.T) {
+	blob, err := hex.DecodeString(mainnetPaymentWithPaths)
+	require.NoError(t, err)
+	// The transaction ID is the first half of the SHA-512 of the prefixed blob.
+	hash := sha512.Sum512(append([]byte("TXN\x00"), blob...))
+	require.Equal(t, "B521424226FC100A2A802FE20476A5F8426FD3F720176DC5CCCE0D75738CC208", strings.ToUpper(hex.EncodeToString(hash[:32])))
+
+	tx, err := Decode(mainnetPaymentWithPaths)
+	require.NoError(t, err)
+	require.Equal(t, []any{
+		[]any{
+			map[string]any{"account": "rPDXxSZcuVL3ZWoyU82bcde3zwvmShkRyF", "type": 1, "type_hex": "0000000000000001"},
+			map[string]any{"currency": "XRP", "type": 16, "type_hex": "0000000000000010"},
+		},
+		[]any{
+			map[string]any{"account": "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn", "type": 1, "type_hex": "0000000000000001"},
+			map[string]any{"account": "rMwjYedjc7qqtKYVLiAccJSmCwih4LnE2q", "type": 1, "type_hex": "0000000000000001"},
+			map[string]any{"currency": "XRP", "type": 16, "type_hex": "0000000000000010"},
+		},
+	}, tx["Paths"])
+
+	encoded, err := Encode(tx)
+	require.NoError(t, err)
+	require.Equal(t, mainnetPaymentWithPaths, encoded)
+}
+
 func TestEncodeForMultisigning(t *testing.T) {
 	tt := []struct {
 		description string
@@ -559,6 +658,15 @@ func TestEncodeForSigningClaim(t *testing.T) {
 			output:      "434C4D0043904CBFCDCEC530B4037871F86EE90BF799DF8D2E0EA564BC8A3F332E4F5FB100000000000003E8",
 			expectedErr: nil,
 		},
+		{
+			description: "successfully encode claim - large XRP amount",
+			input: map[string]any{
+				"Channel": "43904CBFCDCEC530B4037871F86EE90BF799DF8D2E0EA564BC8A3F332E4F5FB1",
+				"Amount":  "100000000000000000",
+			},
+			output:      "434C4D0043904CBFCDCEC530B4037871F86EE90BF799DF8D2E0EA564BC8A3F332E4F5FB1016345785D8A0000",
+			expectedErr: nil,
+		},
 		{
 			description: "fail to encode claim - no channel",
 			input: map[string]any{
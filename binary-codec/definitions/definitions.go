@@ -37,90 +37,126 @@ type definitionsDoc struct {
 	TransactionTypes   map[string]int32 `json:"TRANSACTION_TYPES"`
 }
 
-// Loads JSON from the definitions file and converts it to a preferred format.
+// Loads JSON from the embedded definitions file and converts it to a preferred format.
 // The definitions file contains information required for the XRP Ledger's
 // canonical binary serialization format:
 // `Serialization <https://xrpl.org/serialization.html>`_
 func loadDefinitions() {
+	d, err := FromJSON(docBytes)
+	if err != nil {
+		panic(err)
+	}
+	definitions = d
+}
 
+// FromJSON builds a Definitions instance from a JSON document in the format of the
+// embedded definitions.json, which is also the result of the server_definitions method.
+// It lets the binary codec encode fields and transaction types that a network enabled
+// after this library was released.
+func FromJSON(data []byte) (*Definitions, error) {
 	var jh codec.JsonHandle
 
 	jh.MapKeyAsString = true
 	jh.SignedInteger = true
 
-	dec := codec.NewDecoderBytes(docBytes, &jh)
-	var data definitionsDoc
-	dec.MustDecode(&data)
+	dec := codec.NewDecoderBytes(data, &jh)
+	var doc definitionsDoc
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Types) == 0 || len(doc.Fields) == 0 {
+		return nil, ErrIncompleteDefinitions
+	}
 
-	definitions = &Definitions{
-		Types:              data.Types,
-		Fields:             data.Fields,
-		LedgerEntryTypes:   data.LedgerEntryTypes,
-		TransactionResults: data.TransactionResults,
-		TransactionTypes:   data.TransactionTypes,
+	d := &Definitions{
+		Types:              doc.Types,
+		Fields:             doc.Fields,
+		LedgerEntryTypes:   doc.LedgerEntryTypes,
+		TransactionResults: doc.TransactionResults,
+		TransactionTypes:   doc.TransactionTypes,
 	}
 
-	addFieldHeadersAndOrdinals()
-	createFieldIDNameMap()
-	initializePermissions()
+	d.addFieldHeadersAndOrdinals()
+	d.createFieldIDNameMap()
+	d.initializePermissions()
+
+	return d, nil
 }
 
-func convertToFieldInstanceMap(m [][]interface{}) map[string]*FieldInstance {
+func convertToFieldInstanceMap(m [][]interface{}) (map[string]*FieldInstance, error) {
 	nm := make(map[string]*FieldInstance, len(m))
 
 	for _, j := range m {
-		k := j[0].(string)
-		fi, _ := castFieldInfo(j[1])
+		if len(j) != 2 {
+			return nil, ErrUnableToCastFieldInfo
+		}
+		k, ok := j[0].(string)
+		if !ok {
+			return nil, ErrUnableToCastFieldInfo
+		}
+		fi, err := castFieldInfo(j[1])
+		if err != nil {
+			return nil, err
+		}
 		nm[k] = &FieldInstance{
 			FieldName: k,
 			FieldInfo: &fi,
 			Ordinal:   fi.Nth,
 		}
 	}
-	return nm
+	return nm, nil
 }
 
 func castFieldInfo(v interface{}) (FieldInfo, error) {
-	if fi, ok := v.(map[string]interface{}); ok {
-		return FieldInfo{
-			// TODO: Check if this is still needed
-			//nolint:gosec // G115: Potential hardcoded credentials (gosec)
-			Nth:            int32(fi["nth"].(int64)),
-			IsVLEncoded:    fi["isVLEncoded"].(bool),
-			IsSerialized:   fi["isSerialized"].(bool),
-			IsSigningField: fi["isSigningField"].(bool),
-			Type:           fi["type"].(string),
-		}, nil
+	fi, ok := v.(map[string]interface{})
+	if !ok {
+		return FieldInfo{}, ErrUnableToCastFieldInfo
+	}
+	nth, okNth := fi["nth"].(int64)
+	isVLEncoded, okVL := fi["isVLEncoded"].(bool)
+	isSerialized, okSerialized := fi["isSerialized"].(bool)
+	isSigningField, okSigning := fi["isSigningField"].(bool)
+	typeName, okType := fi["type"].(string)
+	if !okNth || !okVL || !okSerialized || !okSigning || !okType {
+		return FieldInfo{}, ErrUnableToCastFieldInfo
 	}
-	return FieldInfo{}, ErrUnableToCastFieldInfo
+	return FieldInfo{
+		// TODO: Check if this is still needed
+		//nolint:gosec // G115: Potential hardcoded credentials (gosec)
+		Nth:            int32(nth),
+		IsVLEncoded:    isVLEncoded,
+		IsSerialized:   isSerialized,
+		IsSigningField: isSigningField,
+		Type:           typeName,
+	}, nil
 }
 
-func addFieldHeadersAndOrdinals() {
-	for k := range definitions.Fields {
-		t, _ := definitions.GetTypeCodeByTypeName(definitions.Fields[k].Type)
+func (d *Definitions) addFieldHeadersAndOrdinals() {
+	for k := range d.Fields {
+		t, _ := d.GetTypeCodeByTypeName(d.Fields[k].Type)
 
-		if fi, ok := definitions.Fields[k]; ok {
+		if fi, ok := d.Fields[k]; ok {
 			fi.FieldHeader = &FieldHeader{
 				TypeCode:  t,
-				FieldCode: definitions.Fields[k].Nth,
+				FieldCode: d.Fields[k].Nth,
 			}
-			fi.Ordinal = (t<<16 | definitions.Fields[k].Nth)
+			fi.Ordinal = (t<<16 | d.Fields[k].Nth)
 		}
 	}
 }
 
-func createFieldIDNameMap() {
-	definitions.FieldIDNameMap = make(map[FieldHeader]string, len(definitions.Fields))
-	for k := range definitions.Fields {
-		fh, _ := definitions.GetFieldHeaderByFieldName(k)
+func (d *Definitions) createFieldIDNameMap() {
+	d.FieldIDNameMap = make(map[FieldHeader]string, len(d.Fields))
+	for k := range d.Fields {
+		fh, _ := d.GetFieldHeaderByFieldName(k)
 
-		definitions.FieldIDNameMap[*fh] = k
+		d.FieldIDNameMap[*fh] = k
 	}
 }
 
 // Initializes granular permissions and delegatable permissions mappings for account permission delegation.
-func initializePermissions() {
-	definitions.GranularPermissions = map[string]int32{
+func (d *Definitions) initializePermissions() {
+	d.GranularPermissions = map[string]int32{
 		"TrustlineAuthorize":     65537,
 		"TrustlineFreeze":        65538,
 		"TrustlineUnfreeze":      65539,
@@ -135,13 +171,13 @@ func initializePermissions() {
 		"MPTokenIssuanceUnlock":  65548,
 	}
 
-	definitions.DelegatablePermissions = make(map[string]int32)
+	d.DelegatablePermissions = make(map[string]int32)
 
-	for name, value := range definitions.GranularPermissions {
-		definitions.DelegatablePermissions[name] = value
+	for name, value := range d.GranularPermissions {
+		d.DelegatablePermissions[name] = value
 	}
 
-	for txType, value := range definitions.TransactionTypes {
-		definitions.DelegatablePermissions[txType] = value + 1
+	for txType, value := range d.TransactionTypes {
+		d.DelegatablePermissions[txType] = value + 1
 	}
 }
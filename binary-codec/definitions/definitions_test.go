@@ -1,6 +1,7 @@
 package definitions
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -64,3 +65,78 @@ func TestGet(t *testing.T) {
 	loadDefinitions()
 	require.Equal(t, definitions, Get())
 }
+
+// customDefinitionsJSON returns the embedded definitions with an extra
+// transaction type and an extra field, as a newer server would report them.
+func customDefinitionsJSON(t *testing.T) []byte {
+	t.Helper()
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(docBytes, &doc))
+
+	doc["TRANSACTION_TYPES"].(map[string]any)["CustomTx"] = 250
+	doc["FIELDS"] = append(doc["FIELDS"].([]any), []any{"CustomField", map[string]any{
+		"nth":            99,
+		"isVLEncoded":    false,
+		"isSerialized":   true,
+		"isSigningField": true,
+		"type":           "UInt32",
+	}})
+
+	data, err := json.Marshal(doc)
+	require.NoError(t, err)
+	return data
+}
+
+func TestFromJSON(t *testing.T) {
+	d, err := FromJSON(customDefinitionsJSON(t))
+	require.NoError(t, err)
+
+	require.Equal(t, int32(250), d.TransactionTypes["CustomTx"])
+	require.Equal(t, int32(251), d.DelegatablePermissions["CustomTx"])
+	require.Equal(t, &FieldHeader{TypeCode: 2, FieldCode: 99}, d.Fields["CustomField"].FieldHeader)
+	require.Equal(t, int32(2<<16|99), d.Fields["CustomField"].Ordinal)
+
+	name, err := d.GetFieldNameByFieldHeader(FieldHeader{TypeCode: 2, FieldCode: 99})
+	require.NoError(t, err)
+	require.Equal(t, "CustomField", name)
+
+	// The embedded definitions are left untouched.
+	_, err = Get().GetFieldInstanceByFieldName("CustomField")
+	require.Error(t, err)
+	_, err = Get().GetFieldNameByFieldHeader(FieldHeader{TypeCode: 2, FieldCode: 99})
+	require.Error(t, err)
+}
+
+func TestFromJSON_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		err  error
+	}{
+		{
+			name: "fail - invalid JSON",
+			data: `{"TYPES":`,
+		},
+		{
+			name: "fail - missing fields",
+			data: `{"TYPES":{"UInt32":2}}`,
+			err:  ErrIncompleteDefinitions,
+		},
+		{
+			name: "fail - invalid field info",
+			data: `{"TYPES":{"UInt32":2},"FIELDS":[["Sequence",{"nth":"4"}]]}`,
+			err:  ErrUnableToCastFieldInfo,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := FromJSON([]byte(tt.data))
+			require.Nil(t, d)
+			require.Error(t, err)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -10,6 +10,8 @@ var (
 
 	// ErrUnableToCastFieldInfo is returned when the field info cannot be cast.
 	ErrUnableToCastFieldInfo = errors.New("unable to cast to field info")
+	// ErrIncompleteDefinitions is returned when a definitions document has no types or fields.
+	ErrIncompleteDefinitions = errors.New("definitions must include TYPES and FIELDS")
 )
 
 // Dynamic errors
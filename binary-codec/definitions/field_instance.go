@@ -42,6 +42,10 @@ func (fi *fieldInstanceMap) CodecEncodeSelf(_ *codec.Encoder) {}
 func (fi *fieldInstanceMap) CodecDecodeSelf(d *codec.Decoder) {
 	var x [][]interface{}
 	d.MustDecode(&x)
-	y := convertToFieldInstanceMap(x)
+	y, err := convertToFieldInstanceMap(x)
+	if err != nil {
+		// Decoder.Decode recovers the panic and returns the error.
+		panic(err)
+	}
 	*fi = y
 }
@@ -0,0 +1,36 @@
+package binarycodec
+
+import "github.com/Peersyst/xrpl-go/binary-codec/definitions"
+
+// Option configures a single call to Encode, Decode or one of the EncodeFor functions.
+type Option func(*options)
+
+type options struct {
+	definitions *definitions.Definitions
+}
+
+// WithDefinitions encodes and decodes with d instead of the embedded definitions,
+// for example with definitions built from the server_definitions method of a
+// network that enabled fields or transaction types this library does not know yet.
+// A nil d keeps the embedded definitions.
+func WithDefinitions(d *definitions.Definitions) Option {
+	return func(o *options) {
+		o.definitions = d
+	}
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// defs returns the definitions selected by the options.
+func (o *options) defs() *definitions.Definitions {
+	if o.definitions == nil {
+		return definitions.Get()
+	}
+	return o.definitions
+}
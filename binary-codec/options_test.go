@@ -0,0 +1,141 @@
+package binarycodec
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/stretchr/testify/require"
+)
+
+// customDefinitions returns the embedded definitions with an extra transaction
+// type and extra fields, as a newer server would report them.
+func customDefinitions(t *testing.T) *definitions.Definitions {
+	t.Helper()
+	data, err := os.ReadFile("definitions/definitions.json")
+	require.NoError(t, err)
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(data, &doc))
+	doc["TRANSACTION_TYPES"].(map[string]any)["CustomTx"] = 250
+	doc["FIELDS"] = append(doc["FIELDS"].([]any), []any{"CustomField", map[string]any{
+		"nth":            99,
+		"isVLEncoded":    false,
+		"isSerialized":   true,
+		"isSigningField": true,
+		"type":           "UInt32",
+	}}, []any{"CustomUnsignedField", map[string]any{
+		"nth":            100,
+		"isVLEncoded":    false,
+		"isSerialized":   true,
+		"isSigningField": false,
+		"type":           "UInt32",
+	}}, []any{"CustomNumber", map[string]any{
+		"nth":            1,
+		"isVLEncoded":    false,
+		"isSerialized":   true,
+		"isSigningField": true,
+		"type":           "Number",
+	}}, []any{"CustomInt32", map[string]any{
+		"nth":            1,
+		"isVLEncoded":    false,
+		"isSerialized":   true,
+		"isSigningField": true,
+		"type":           "Int32",
+	}}, []any{"CustomInt64", map[string]any{
+		"nth":            1,
+		"isVLEncoded":    false,
+		"isSerialized":   true,
+		"isSigningField": true,
+		"type":           "Int64",
+	}})
+
+	data, err = json.Marshal(doc)
+	require.NoError(t, err)
+	d, err := definitions.FromJSON(data)
+	require.NoError(t, err)
+	return d
+}
+
+func customTx() map[string]any {
+	return map[string]any{
+		"TransactionType":     "CustomTx",
+		"Account":             "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys",
+		"Fee":                 "10",
+		"Sequence":            uint32(1),
+		"CustomField":         uint32(42),
+		"CustomUnsignedField": uint32(7),
+		"Memos": []any{
+			map[string]any{"Memo": map[string]any{"MemoData": "AB"}},
+		},
+	}
+}
+
+func TestWithDefinitions(t *testing.T) {
+	d := customDefinitions(t)
+
+	_, err := Encode(customTx())
+	require.Error(t, err)
+
+	blob, err := Encode(customTx(), WithDefinitions(d))
+	require.NoError(t, err)
+
+	_, err = Decode(blob)
+	require.Error(t, err)
+
+	decoded, err := Decode(blob, WithDefinitions(d))
+	require.NoError(t, err)
+	require.Equal(t, "CustomTx", decoded["TransactionType"])
+	require.Equal(t, uint32(42), decoded["CustomField"])
+	require.Equal(t, uint32(7), decoded["CustomUnsignedField"])
+
+	reencoded, err := Encode(decoded, WithDefinitions(d))
+	require.NoError(t, err)
+	require.Equal(t, blob, reencoded)
+}
+
+func TestWithDefinitions_EncodeForSigning(t *testing.T) {
+	d := customDefinitions(t)
+
+	signing, err := EncodeForSigning(customTx(), WithDefinitions(d))
+	require.NoError(t, err)
+
+	tx := customTx()
+	delete(tx, "CustomUnsignedField")
+	blob, err := Encode(tx, WithDefinitions(d))
+	require.NoError(t, err)
+	require.Equal(t, txSigPrefix+blob, signing)
+
+	multisigning, err := EncodeForMultisigning(customTx(), "rMBzp8CgpE441cp5PVyA9rpVV7oT8hP3ys", WithDefinitions(d))
+	require.NoError(t, err)
+	// CustomField is field 99 of type UInt32, and CustomUnsignedField is dropped.
+	require.Contains(t, multisigning, "20630000002A")
+	require.NotContains(t, multisigning, "206400000007")
+}
+
+func TestWithDefinitions_NumberTypes(t *testing.T) {
+	d := customDefinitions(t)
+
+	tx := customTx()
+	tx["CustomNumber"] = "-12.5"
+	tx["CustomInt32"] = int32(-7)
+	tx["CustomInt64"] = "-9000000000"
+
+	blob, err := Encode(tx, WithDefinitions(d))
+	require.NoError(t, err)
+	// Field 1 of the Number, Int32 and Int64 types.
+	require.Contains(t, blob, "91FFFB8F21B207E000FFFFFFF2")
+	require.Contains(t, blob, "A1FFFFFFF9")
+	require.Contains(t, blob, "B1FFFFFFFDE78EE600")
+
+	decoded, err := Decode(blob, WithDefinitions(d))
+	require.NoError(t, err)
+	require.Equal(t, "-12.5", decoded["CustomNumber"])
+	require.Equal(t, int32(-7), decoded["CustomInt32"])
+	require.Equal(t, "-9000000000", decoded["CustomInt64"])
+
+	reencoded, err := Encode(decoded, WithDefinitions(d))
+	require.NoError(t, err)
+	require.Equal(t, blob, reencoded)
+}
@@ -41,7 +41,7 @@ func (s *BinarySerializer) WriteFieldAndValue(fi definitions.FieldInstance, valu
 	s.put(h)
 
 	if fi.IsVLEncoded {
-		vl, err := encodeVariableLength(len(value))
+		vl, err := EncodeVariableLength(len(value))
 		if err != nil {
 			return err
 		}
@@ -56,7 +56,8 @@ func (s *BinarySerializer) WriteFieldAndValue(fi definitions.FieldInstance, valu
 	return nil
 }
 
-func encodeVariableLength(length int) ([]byte, error) {
+// EncodeVariableLength encodes the length prefix of a variable-length field.
+func EncodeVariableLength(length int) ([]byte, error) {
 	if length <= 192 {
 		return []byte{byte(length)}, nil
 	}
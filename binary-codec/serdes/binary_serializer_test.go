@@ -51,7 +51,7 @@ func TestBinarySerializer_EncodeVariableLength(t *testing.T) {
 			s := strings.Repeat("A2", tc.len)
 			b, _ := hex.DecodeString(s)
 			require.Equal(t, tc.len, len(b))
-			actual, err := encodeVariableLength(len(b))
+			actual, err := EncodeVariableLength(len(b))
 			if tc.expectedErr != nil {
 				require.Error(t, err, tc.expectedErr.Error())
 				require.Nil(t, actual)
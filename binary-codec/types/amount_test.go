@@ -715,7 +715,7 @@ func TestDeserializeMPTIssuanceID(t *testing.T) {
 				0x12, 0x34, 0x56, 0x78, 0x90, 0xAB, 0xCD, 0xEF,
 				0x12, 0x34, 0x56, 0x78, 0x90, 0xAB, 0xCD, 0xEF,
 			},
-			expectedOutput: "1234567890abcdef1234567890abcdef1234567890abcdef",
+			expectedOutput: "1234567890ABCDEF1234567890ABCDEF1234567890ABCDEF",
 			expErr:         nil,
 		},
 		{
@@ -741,7 +741,7 @@ func TestDeserializeMPTIssuanceID(t *testing.T) {
 				0xCC, 0xDD, 0xEE, 0xFF, 0xAA, 0xBB, 0xCC, 0xDD,
 				0xEE, 0xFF, 0xAA, 0xBB, 0xCC, 0xDD, 0xEE, 0xFF,
 			},
-			expectedOutput: "aabbccddeeffaabbccddeeffaabbccddeeffaabbccddeeff",
+			expectedOutput: "AABBCCDDEEFFAABBCCDDEEFFAABBCCDDEEFFAABBCCDDEEFF",
 			expErr:         nil,
 		},
 	}
@@ -778,7 +778,7 @@ func TestDeserializeMPTAmount(t *testing.T) {
 			},
 			expectedOutput: map[string]any{
 				"value":           "1000000",
-				"mpt_issuance_id": "1234567890abcdef1234567890abcdef1234567890abcdef",
+				"mpt_issuance_id": "1234567890ABCDEF1234567890ABCDEF1234567890ABCDEF",
 			},
 			expErr: nil,
 		},
@@ -798,7 +798,7 @@ func TestDeserializeMPTAmount(t *testing.T) {
 			},
 			expectedOutput: map[string]any{
 				"value":           "-1000000",
-				"mpt_issuance_id": "1234567890abcdef1234567890abcdef1234567890abcdef",
+				"mpt_issuance_id": "1234567890ABCDEF1234567890ABCDEF1234567890ABCDEF",
 			},
 			expErr: nil,
 		},
@@ -1088,7 +1088,7 @@ func TestAmount_ToJson(t *testing.T) {
 			},
 			expected: map[string]any{
 				"value":           "1000000",
-				"mpt_issuance_id": "1234567890abcdef1234567890abcdef1234567890abcdef",
+				"mpt_issuance_id": "1234567890ABCDEF1234567890ABCDEF1234567890ABCDEF",
 			},
 			expPass: true,
 			err:     nil,
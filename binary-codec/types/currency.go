@@ -21,12 +21,15 @@ func (c *Currency) FromJSON(json any) ([]byte, error) {
 	return nil, ErrInvalidCurrency
 }
 
+// ToJSON reads a currency code of the optional length, 20 bytes by default, and returns
+// its string form.
 func (c *Currency) ToJSON(p interfaces.BinaryParser, opts ...int) (any, error) {
-	if len(opts) == 0 {
-		return nil, ErrMissingCurrencyLengthOption
+	length := 20
+	if len(opts) > 0 {
+		length = opts[0]
 	}
 
-	currencyBytes, err := p.ReadBytes(opts[0])
+	currencyBytes, err := p.ReadBytes(length)
 	if err != nil {
 		return nil, err
 	}
@@ -28,6 +28,18 @@ func TestCurrency_ToJSON(t *testing.T) {
 				return &Currency{}, mock
 			},
 		},
+		{
+			name:     "pass - default length",
+			expected: "XRP",
+			opts:     nil,
+			err:      nil,
+			setup: func(t *testing.T) (*Currency, *testutil.MockBinaryParser) {
+				ctrl := gomock.NewController(t)
+				mock := testutil.NewMockBinaryParser(ctrl)
+				mock.EXPECT().ReadBytes(20).Return(XRPBytes, nil)
+				return &Currency{}, mock
+			},
+		},
 		{
 			name:     "pass - 3 letter currency code",
 			expected: "USD",
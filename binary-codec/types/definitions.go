@@ -0,0 +1,17 @@
+package types
+
+import "github.com/Peersyst/xrpl-go/binary-codec/definitions"
+
+// definitionsRef holds the definitions a type resolves field, transaction type,
+// ledger entry type and permission names with. The zero value uses the
+// embedded definitions.
+type definitionsRef struct {
+	definitions *definitions.Definitions
+}
+
+func (r definitionsRef) defs() *definitions.Definitions {
+	if r.definitions == nil {
+		return definitions.Get()
+	}
+	return r.definitions
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/types/interfaces"
+)
+
+var ErrInvalidInt32 = errors.New("invalid Int32, value should be an integer between -2147483648 and 2147483647")
+
+// Int32 represents a 32-bit signed integer.
+type Int32 struct{}
+
+// FromJSON converts a JSON value into a serialized byte slice representing a 32-bit signed integer.
+// The input value can be any Go integer type or a json.Number within the Int32 range.
+func (i *Int32) FromJSON(value any) ([]byte, error) {
+	n, err := toInt64(value)
+	if err != nil || n < math.MinInt32 || n > math.MaxInt32 {
+		return nil, ErrInvalidInt32
+	}
+	return binary.BigEndian.AppendUint32(nil, uint32(int32(n))), nil
+}
+
+// ToJSON takes a BinaryParser and optional parameters, and converts the serialized byte data
+// back into a JSON integer value. This method assumes the parser contains data representing
+// a 32-bit signed integer. If the parsing fails, an error is returned.
+func (i *Int32) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
+	b, err := p.ReadBytes(4)
+	if err != nil {
+		return nil, err
+	}
+	return int32(binary.BigEndian.Uint32(b)), nil
+}
+
+// toInt64 converts an integer JSON value into an int64.
+func toInt64(value any) (int64, error) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), nil
+	case int8:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint8:
+		return int64(v), nil
+	case uint16:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case json.Number:
+		return strconv.ParseInt(v.String(), 10, 64)
+	default:
+		return 0, errNotValidJSON
+	}
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/binary-codec/serdes"
+	"github.com/Peersyst/xrpl-go/binary-codec/types/interfaces"
+	"github.com/Peersyst/xrpl-go/binary-codec/types/testutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt32_FromJson(t *testing.T) {
+	tt := []struct {
+		name        string
+		input       any
+		expected    []byte
+		expectedErr error
+	}{
+		{
+			name:     "pass - positive int32",
+			input:    int32(100),
+			expected: []byte{0, 0, 0, 100},
+		},
+		{
+			name:     "pass - negative int",
+			input:    -1,
+			expected: []byte{0xFF, 0xFF, 0xFF, 0xFF},
+		},
+		{
+			name:     "pass - min int32",
+			input:    int64(-2147483648),
+			expected: []byte{0x80, 0, 0, 0},
+		},
+		{
+			name:     "pass - json number",
+			input:    json.Number("2147483647"),
+			expected: []byte{0x7F, 0xFF, 0xFF, 0xFF},
+		},
+		{
+			name:        "fail - out of range",
+			input:       int64(2147483648),
+			expectedErr: ErrInvalidInt32,
+		},
+		{
+			name:        "fail - string",
+			input:       "1",
+			expectedErr: ErrInvalidInt32,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			class := &Int32{}
+			actual, err := class.FromJSON(tc.input)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestInt32_ToJson(t *testing.T) {
+	defs := definitions.Get()
+
+	tt := []struct {
+		name        string
+		malleate    func(t *testing.T) interfaces.BinaryParser
+		expected    int32
+		expectedErr error
+	}{
+		{
+			name: "fail - parser has no data",
+			malleate: func(t *testing.T) interfaces.BinaryParser {
+				parserMock := testutil.NewMockBinaryParser(gomock.NewController(t))
+				parserMock.EXPECT().ReadBytes(gomock.Any()).Return([]byte{}, errors.New("binary parser has no data"))
+				return parserMock
+			},
+			expectedErr: errors.New("binary parser has no data"),
+		},
+		{
+			name: "pass - positive int32",
+			malleate: func(t *testing.T) interfaces.BinaryParser {
+				return serdes.NewBinaryParser([]byte{0, 0, 0, 100}, defs)
+			},
+			expected: 100,
+		},
+		{
+			name: "pass - negative int32",
+			malleate: func(t *testing.T) interfaces.BinaryParser {
+				return serdes.NewBinaryParser([]byte{0x80, 0, 0, 0}, defs)
+			},
+			expected: -2147483648,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			class := &Int32{}
+			actual, err := class.ToJSON(tc.malleate(t))
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"strconv"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/types/interfaces"
+)
+
+var ErrInvalidInt64 = errors.New("invalid Int64, value should be an integer or a decimal string representation of an Int64")
+
+// Int64 represents a 64-bit signed integer.
+type Int64 struct{}
+
+// FromJSON converts a JSON value into a serialized byte slice representing a 64-bit signed integer.
+// The input value can be any Go integer type, a json.Number or a decimal string.
+func (i *Int64) FromJSON(value any) ([]byte, error) {
+	var (
+		n   int64
+		err error
+	)
+	if s, ok := value.(string); ok {
+		n, err = strconv.ParseInt(s, 10, 64)
+	} else {
+		n, err = toInt64(value)
+	}
+	if err != nil {
+		return nil, ErrInvalidInt64
+	}
+	return binary.BigEndian.AppendUint64(nil, uint64(n)), nil
+}
+
+// ToJSON takes a BinaryParser and optional parameters, and converts the serialized byte data
+// back into a JSON string value holding the decimal representation of the integer, since it
+// may not fit in a JSON number. If the parsing fails, an error is returned.
+func (i *Int64) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
+	b, err := p.ReadBytes(8)
+	if err != nil {
+		return nil, err
+	}
+	return strconv.FormatInt(int64(binary.BigEndian.Uint64(b)), 10), nil
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/binary-codec/serdes"
+	"github.com/Peersyst/xrpl-go/binary-codec/types/interfaces"
+	"github.com/Peersyst/xrpl-go/binary-codec/types/testutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt64_FromJson(t *testing.T) {
+	tt := []struct {
+		name        string
+		input       any
+		expected    []byte
+		expectedErr error
+	}{
+		{
+			name:     "pass - int",
+			input:    1,
+			expected: []byte{0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:     "pass - negative int64",
+			input:    int64(-2),
+			expected: []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE},
+		},
+		{
+			name:     "pass - decimal string",
+			input:    "9223372036854775807",
+			expected: []byte{0x7F, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF},
+		},
+		{
+			name:     "pass - negative decimal string",
+			input:    "-9223372036854775808",
+			expected: []byte{0x80, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:        "fail - out of range string",
+			input:       "9223372036854775808",
+			expectedErr: ErrInvalidInt64,
+		},
+		{
+			name:        "fail - hex string",
+			input:       "0A",
+			expectedErr: ErrInvalidInt64,
+		},
+		{
+			name:        "fail - float",
+			input:       1.5,
+			expectedErr: ErrInvalidInt64,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			class := &Int64{}
+			actual, err := class.FromJSON(tc.input)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestInt64_ToJson(t *testing.T) {
+	defs := definitions.Get()
+
+	tt := []struct {
+		name        string
+		malleate    func(t *testing.T) interfaces.BinaryParser
+		expected    string
+		expectedErr error
+	}{
+		{
+			name: "fail - parser has no data",
+			malleate: func(t *testing.T) interfaces.BinaryParser {
+				parserMock := testutil.NewMockBinaryParser(gomock.NewController(t))
+				parserMock.EXPECT().ReadBytes(gomock.Any()).Return([]byte{}, errors.New("binary parser has no data"))
+				return parserMock
+			},
+			expectedErr: errors.New("binary parser has no data"),
+		},
+		{
+			name: "pass - positive int64",
+			malleate: func(t *testing.T) interfaces.BinaryParser {
+				return serdes.NewBinaryParser([]byte{0, 0, 0, 0, 0, 0, 0x01, 0x00}, defs)
+			},
+			expected: "256",
+		},
+		{
+			name: "pass - negative int64",
+			malleate: func(t *testing.T) interfaces.BinaryParser {
+				return serdes.NewBinaryParser([]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE}, defs)
+			},
+			expected: "-2",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			class := &Int64{}
+			actual, err := class.ToJSON(tc.malleate(t))
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
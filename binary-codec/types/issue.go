@@ -83,14 +83,18 @@ func (i *Issue) FromJSON(json any) ([]byte, error) {
 // ToJSON converts an AccountID byte slice back to a classic address string.
 // It uses the addresscodec package to encode the byte slice.
 // If the input is not a valid AccountID byte slice, it returns an error.
+// The optional length is the length of the currency, or MPTIssuanceIDBytesLength for an
+// MPT issue. Without it, the issue is read as a 20 byte currency followed by its issuer,
+// which is how Issue fields appear in a serialized object.
 func (i *Issue) ToJSON(p interfaces.BinaryParser, opts ...int) (any, error) {
-	if len(opts) == 0 {
-		return nil, ErrMissingIssueLengthOption
+	length := 20
+	if len(opts) > 0 {
+		length = opts[0]
 	}
 
 	currencyCodec := &Currency{}
 
-	if i.length == MPTIssuanceIDBytesLength || opts[0] == MPTIssuanceIDBytesLength {
+	if i.length == MPTIssuanceIDBytesLength || length == MPTIssuanceIDBytesLength {
 		b, err := p.ReadBytes(MPTIssuanceIDBytesLength)
 		if err != nil {
 			return nil, err
@@ -103,7 +107,7 @@ func (i *Issue) ToJSON(p interfaces.BinaryParser, opts ...int) (any, error) {
 		}, nil
 	}
 
-	currencyStr, err := currencyCodec.ToJSON(p, opts...)
+	currencyStr, err := currencyCodec.ToJSON(p, length)
 	if err != nil {
 		return nil, err
 	}
@@ -135,6 +135,28 @@ func TestIssue_ToJson(t *testing.T) {
 				return &Issue{}, mock
 			},
 		},
+		{
+			name: "pass - issue object without length option",
+			expected: map[string]any{
+				"currency": "USD",
+				"issuer":   "rG1QQv2nh2gr7RCZ1P8YYcBUKCCN633jCn",
+			},
+			opts: nil,
+			err:  nil,
+			setup: func(t *testing.T) (*Issue, *testutil.MockBinaryParser) {
+				ctrl := gomock.NewController(t)
+				mock := testutil.NewMockBinaryParser(ctrl)
+				mock.EXPECT().ReadBytes(20).Return([]byte{
+					0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+					0, 0, 85, 83, 68, 0, 0, 0, 0, 0,
+				}, nil)
+				mock.EXPECT().ReadBytes(20).Return([]byte{
+					174, 18, 58, 133, 86, 243, 207, 145, 21, 71,
+					17, 55, 106, 251, 15, 137, 79, 131, 43, 61,
+				}, nil)
+				return &Issue{}, mock
+			},
+		},
 		{
 			name: "pass - valid xrp issue object",
 			expected: map[string]any{
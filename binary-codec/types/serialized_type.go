@@ -22,19 +22,34 @@ type SerializedType interface {
 // the appropriate methods of that type to be called.
 // If the input string does not match a known type, the function returns nil.
 func GetSerializedType(t string) SerializedType {
+	return getSerializedType(t, nil)
+}
+
+// getSerializedType is like GetSerializedType, but the returned type resolves
+// names with d. A nil d uses the embedded definitions.
+func getSerializedType(t string, d *definitions.Definitions) SerializedType {
+	ref := definitionsRef{definitions: d}
 	switch t {
 	case "UInt8":
-		return &UInt8{}
+		return &UInt8{ref}
 	case "UInt16":
-		return &UInt16{}
+		return &UInt16{ref}
 	case "UInt32":
 		return &UInt32{}
 	case "UInt64":
 		return &UInt64{}
+	case "Int32":
+		return &Int32{}
+	case "Int64":
+		return &Int64{}
+	case "Number":
+		return &STNumber{}
 	case "Hash128":
 		return NewHash128()
 	case "Hash160":
 		return NewHash160()
+	case "Hash192":
+		return NewHash192()
 	case "Hash256":
 		return NewHash256()
 	case "AccountID":
@@ -46,9 +61,9 @@ func GetSerializedType(t string) SerializedType {
 	case "Blob":
 		return &Blob{}
 	case "STObject":
-		return NewSTObject(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(definitions.Get())))
+		return NewSTObjectWithDefinitions(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(ref.defs())), d)
 	case "STArray":
-		return &STArray{}
+		return &STArray{ref}
 	case "PathSet":
 		return &PathSet{}
 	case "XChainBridge":
@@ -60,3 +75,12 @@ func GetSerializedType(t string) SerializedType {
 	}
 	return nil
 }
+
+// getFieldSerializedType is like getSerializedType, but returns the type of the given
+// field, for the fields whose JSON form differs from the rest of their type.
+func getFieldSerializedType(fi definitions.FieldInstance, d *definitions.Definitions) SerializedType {
+	if fi.Type == "UInt64" && base10UInt64Fields[fi.FieldName] {
+		return &UInt64{base10: true}
+	}
+	return getSerializedType(fi.Type, d)
+}
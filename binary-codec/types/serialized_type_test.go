@@ -34,6 +34,21 @@ func TestGetSerializedType(t *testing.T) {
 			input:    "UInt64",
 			expected: &UInt64{},
 		},
+		{
+			name:     "pass - int32",
+			input:    "Int32",
+			expected: &Int32{},
+		},
+		{
+			name:     "pass - int64",
+			input:    "Int64",
+			expected: &Int64{},
+		},
+		{
+			name:     "pass - number",
+			input:    "Number",
+			expected: &STNumber{},
+		},
 		{
 			name:     "pass - hash128",
 			input:    "Hash128",
@@ -44,6 +59,11 @@ func TestGetSerializedType(t *testing.T) {
 			input:    "Hash160",
 			expected: NewHash160(),
 		},
+		{
+			name:     "pass - hash192",
+			input:    "Hash192",
+			expected: NewHash192(),
+		},
 		{
 			name:     "pass - hash256",
 			input:    "Hash256",
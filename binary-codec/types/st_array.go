@@ -3,7 +3,6 @@ package types
 import (
 	"errors"
 
-	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
 	"github.com/Peersyst/xrpl-go/binary-codec/serdes"
 	"github.com/Peersyst/xrpl-go/binary-codec/types/interfaces"
 )
@@ -14,7 +13,9 @@ const (
 )
 
 // STArray represents an array of STObject instances.
-type STArray struct{}
+type STArray struct {
+	definitionsRef
+}
 
 var ErrNotSTObjectInSTArray = errors.New("not STObject in STArray. Array fields must be STObjects")
 
@@ -38,7 +39,7 @@ func (t *STArray) FromJSON(json any) ([]byte, error) {
 
 	var sink []byte
 	for _, v := range json.([]any) {
-		st := NewSTObject(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(definitions.Get())))
+		st := NewSTObjectWithDefinitions(serdes.NewBinarySerializer(serdes.NewFieldIDCodec(t.defs())), t.definitions)
 		b, err := st.FromJSON(v)
 		if err != nil {
 			return nil, err
@@ -71,7 +72,7 @@ func (t *STArray) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
 			break
 		}
 		fn := fi.FieldName
-		st := GetSerializedType(fi.Type)
+		st := getSerializedType(fi.Type, t.definitions)
 		res, err := st.ToJSON(p)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,206 @@
+package types
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/types/interfaces"
+)
+
+const (
+	// The number of significant digits of a normalized STNumber mantissa.
+	numberDigits = 16
+	// The smallest and largest mantissa of a normalized, non-zero STNumber.
+	numberMinMantissa uint64 = 1_000_000_000_000_000
+	numberMaxMantissa uint64 = 9_999_999_999_999_999
+	// The range of the exponent of a normalized, non-zero STNumber. Smaller
+	// values round to zero and larger values overflow.
+	numberMinExponent = -32768
+	numberMaxExponent = 32768
+	// The exponent of zero, which has a zero mantissa.
+	numberZeroExponent int32 = math.MinInt32
+	// The size in bytes of a serialized STNumber: a 64-bit mantissa followed by
+	// a 32-bit exponent.
+	numberLength = 12
+)
+
+var (
+	ErrInvalidNumber  = errors.New("invalid Number, value should be a decimal or scientific notation string, or an integer")
+	ErrNumberOverflow = errors.New("number exponent is out of range")
+
+	numberRegex = regexp.MustCompile(`^([-+]?)([0-9]+)(?:\.([0-9]+))?(?:[eE]([+-]?[0-9]+))?$`)
+)
+
+// STNumber represents an arbitrary precision decimal number, stored as a signed
+// 64-bit mantissa and a signed 32-bit exponent. Non-zero values are normalized so
+// that the mantissa has exactly 16 significant digits.
+type STNumber struct{}
+
+// FromJSON converts a JSON value into a serialized byte slice representing a number.
+// The input value can be a decimal or scientific notation string such as "1.5" or "-25e-3",
+// a json.Number, or an integer. Values with more than 16 significant digits are rounded half
+// to even. If the value is not a number or its exponent is too large, an error is returned.
+func (n *STNumber) FromJSON(value any) ([]byte, error) {
+	s, err := numberString(value)
+	if err != nil {
+		return nil, err
+	}
+	mantissa, exponent, err := parseNumber(s)
+	if err != nil {
+		return nil, err
+	}
+	buf := binary.BigEndian.AppendUint64(nil, uint64(mantissa))
+	return binary.BigEndian.AppendUint32(buf, uint32(exponent)), nil
+}
+
+// ToJSON takes a BinaryParser and optional parameters, and converts the serialized byte data
+// back into a JSON string value. Numbers with an exponent between -25 and -5 are written in
+// decimal notation, and other numbers as "<mantissa>e<exponent>", the way rippled does.
+func (n *STNumber) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
+	b, err := p.ReadBytes(numberLength)
+	if err != nil {
+		return nil, err
+	}
+	mantissa := int64(binary.BigEndian.Uint64(b[:8]))
+	exponent := int32(binary.BigEndian.Uint32(b[8:]))
+	return formatNumber(mantissa, exponent), nil
+}
+
+// numberString returns the string representation of a JSON number value.
+func numberString(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), nil
+	default:
+		i, err := toInt64(value)
+		if err != nil {
+			return "", ErrInvalidNumber
+		}
+		return strconv.FormatInt(i, 10), nil
+	}
+}
+
+// parseNumber parses a decimal or scientific notation string into a normalized
+// mantissa and exponent.
+func parseNumber(s string) (int64, int32, error) {
+	m := numberRegex.FindStringSubmatch(s)
+	if m == nil {
+		return 0, 0, ErrInvalidNumber
+	}
+
+	exponent := 0
+	if m[4] != "" {
+		e, err := strconv.Atoi(m[4])
+		if err != nil {
+			return 0, 0, ErrNumberOverflow
+		}
+		exponent = e
+	}
+	exponent -= len(m[3])
+
+	mantissa, ok := new(big.Int).SetString(m[2]+m[3], 10)
+	if !ok {
+		return 0, 0, ErrInvalidNumber
+	}
+	return normalizeNumber(m[1] == "-", mantissa, exponent)
+}
+
+// normalizeNumber scales mantissa to 16 significant digits, rounding half to even,
+// and adjusts exponent to keep the value. Values too small to represent become zero.
+func normalizeNumber(negative bool, mantissa *big.Int, exponent int) (int64, int32, error) {
+	if mantissa.Sign() == 0 {
+		return 0, numberZeroExponent, nil
+	}
+
+	if digits := len(mantissa.String()); digits > numberDigits {
+		shift := digits - numberDigits
+		divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(shift)), nil)
+		quotient, remainder := new(big.Int).QuoRem(mantissa, divisor, new(big.Int))
+
+		// Round half to even by comparing twice the remainder with the divisor.
+		switch remainder.Lsh(remainder, 1).Cmp(divisor) {
+		case 1:
+			quotient.Add(quotient, big.NewInt(1))
+		case 0:
+			if quotient.Bit(0) == 1 {
+				quotient.Add(quotient, big.NewInt(1))
+			}
+		}
+		mantissa = quotient
+		exponent += shift
+
+		// Rounding 9999999999999999.5 up carries into a 17th digit.
+		if mantissa.Uint64() > numberMaxMantissa {
+			mantissa.Quo(mantissa, big.NewInt(10))
+			exponent++
+		}
+	}
+
+	m := mantissa.Uint64()
+	for m < numberMinMantissa {
+		m *= 10
+		exponent--
+	}
+
+	if exponent < numberMinExponent {
+		return 0, numberZeroExponent, nil
+	}
+	if exponent > numberMaxExponent {
+		return 0, 0, ErrNumberOverflow
+	}
+
+	if negative {
+		return -int64(m), int32(exponent), nil
+	}
+	return int64(m), int32(exponent), nil
+}
+
+// formatNumber returns the string representation rippled uses for a number.
+func formatNumber(mantissa int64, exponent int32) string {
+	if mantissa == 0 {
+		return "0"
+	}
+
+	sign := ""
+	abs := uint64(mantissa)
+	if mantissa < 0 {
+		sign = "-"
+		abs = -abs
+	}
+	digits := strconv.FormatUint(abs, 10)
+
+	if exponent != 0 && (exponent < -25 || exponent > -5) {
+		return sign + digits + "e" + strconv.Itoa(int(exponent))
+	}
+
+	intPart, fracPart := "", ""
+	point := len(digits) + int(exponent)
+	switch {
+	case point <= 0:
+		fracPart = strings.Repeat("0", -point) + digits
+	case point >= len(digits):
+		intPart = digits + strings.Repeat("0", point-len(digits))
+	default:
+		intPart, fracPart = digits[:point], digits[point:]
+	}
+
+	intPart = strings.TrimLeft(intPart, "0")
+	if intPart == "" {
+		intPart = "0"
+	}
+	fracPart = strings.TrimRight(fracPart, "0")
+	if fracPart == "" {
+		return sign + intPart
+	}
+	return sign + intPart + "." + fracPart
+}
@@ -0,0 +1,146 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/binary-codec/serdes"
+	"github.com/Peersyst/xrpl-go/binary-codec/types/testutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSTNumber_FromJson(t *testing.T) {
+	tt := []struct {
+		name        string
+		input       any
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:     "pass - one",
+			input:    "1",
+			expected: "00038D7EA4C68000FFFFFFF1",
+		},
+		{
+			name:     "pass - negative one",
+			input:    "-1",
+			expected: "FFFC72815B398000FFFFFFF1",
+		},
+		{
+			name:     "pass - zero",
+			input:    "0",
+			expected: "000000000000000080000000",
+		},
+		{
+			name:     "pass - integer",
+			input:    1,
+			expected: "00038D7EA4C68000FFFFFFF1",
+		},
+		{
+			name:     "pass - scientific notation",
+			input:    "1e-15",
+			expected: "00038D7EA4C68000FFFFFFE2",
+		},
+		{
+			name:     "pass - underflow rounds to zero",
+			input:    "1e-40000",
+			expected: "000000000000000080000000",
+		},
+		{
+			name:        "fail - overflow",
+			input:       "1e40000",
+			expectedErr: ErrNumberOverflow,
+		},
+		{
+			name:        "fail - not a number",
+			input:       "abc",
+			expectedErr: ErrInvalidNumber,
+		},
+		{
+			name:        "fail - missing fraction digits",
+			input:       "1.",
+			expectedErr: ErrInvalidNumber,
+		},
+		{
+			name:        "fail - invalid type",
+			input:       true,
+			expectedErr: ErrInvalidNumber,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			class := &STNumber{}
+			actual, err := class.FromJSON(tc.input)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, strings.ToUpper(hex.EncodeToString(actual)))
+		})
+	}
+}
+
+func TestSTNumber_ToJson(t *testing.T) {
+	t.Run("fail - parser has no data", func(t *testing.T) {
+		parserMock := testutil.NewMockBinaryParser(gomock.NewController(t))
+		parserMock.EXPECT().ReadBytes(numberLength).Return([]byte{}, errors.New("binary parser has no data"))
+
+		class := &STNumber{}
+		_, err := class.ToJSON(parserMock)
+		require.EqualError(t, err, "binary parser has no data")
+	})
+
+	t.Run("pass - not normalized", func(t *testing.T) {
+		// 25 with exponent -1.
+		b, err := hex.DecodeString("0000000000000019FFFFFFFF")
+		require.NoError(t, err)
+
+		class := &STNumber{}
+		actual, err := class.ToJSON(serdes.NewBinaryParser(b, definitions.Get()))
+		require.NoError(t, err)
+		require.Equal(t, "25e-1", actual)
+	})
+}
+
+func TestSTNumber_RoundTrip(t *testing.T) {
+	tt := []struct {
+		name     string
+		input    any
+		expected string
+	}{
+		{name: "integer", input: "1", expected: "1"},
+		{name: "large integer", input: "123000", expected: "123000"},
+		{name: "negative decimal", input: "-1.5", expected: "-1.5"},
+		{name: "leading zeros", input: "+000.2500", expected: "0.25"},
+		{name: "zero", input: "-0.00", expected: "0"},
+		{name: "small decimal", input: "0.000000001", expected: "0.000000001"},
+		{name: "very small number", input: "1e-20", expected: "1000000000000000e-35"},
+		{name: "very large number", input: "1E20", expected: "1000000000000000e5"},
+		{name: "json number", input: json.Number("2.5"), expected: "2.5"},
+		{name: "float", input: 0.125, expected: "0.125"},
+		{name: "round up", input: "123456789012345678", expected: "1234567890123457e2"},
+		{name: "round half to even down", input: "12345678901234565", expected: "1234567890123456e1"},
+		{name: "round half to even up", input: "12345678901234575", expected: "1234567890123458e1"},
+		{name: "round carries into exponent", input: "99999999999999995", expected: "1000000000000000e2"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			class := &STNumber{}
+			b, err := class.FromJSON(tc.input)
+			require.NoError(t, err)
+			require.Len(t, b, numberLength)
+
+			actual, err := class.ToJSON(serdes.NewBinaryParser(b, definitions.Get()))
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 // and the associated value is the field's value. This structure allows us to represent nested
 // and complex structures of the Ripple protocol.
 type STObject struct {
+	definitionsRef
 	binarySerializer interfaces.BinarySerializer
 }
 
@@ -19,6 +20,13 @@ func NewSTObject(bs interfaces.BinarySerializer) *STObject {
 	return &STObject{binarySerializer: bs}
 }
 
+// NewSTObjectWithDefinitions returns a new STObject with the given binary serializer
+// that resolves field and type names with d instead of the embedded definitions.
+// The serializer should use the same definitions.
+func NewSTObjectWithDefinitions(bs interfaces.BinarySerializer, d *definitions.Definitions) *STObject {
+	return &STObject{definitionsRef: definitionsRef{definitions: d}, binarySerializer: bs}
+}
+
 // FromJSON converts a JSON object into a serialized byte slice.
 // It works by converting the JSON object into a map of field instances (which include the field definition
 // and value), and then serializing each field instance.
@@ -27,7 +35,7 @@ func (t *STObject) FromJSON(json any) ([]byte, error) {
 	if _, ok := json.(map[string]any); !ok {
 		return nil, errNotValidJSON
 	}
-	fimap, err := createFieldInstanceMapFromJson(json.(map[string]any))
+	fimap, err := createFieldInstanceMapFromJson(t.defs(), json.(map[string]any))
 
 	if err != nil {
 		return nil, err
@@ -40,7 +48,7 @@ func (t *STObject) FromJSON(json any) ([]byte, error) {
 			continue
 		}
 
-		st := GetSerializedType(v.Type)
+		st := getFieldSerializedType(v, t.definitions)
 		b, err := st.FromJSON(fimap[v])
 		if err != nil {
 			return nil, err
@@ -70,7 +78,7 @@ func (t *STObject) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
 			break
 		}
 
-		st := GetSerializedType(fi.Type)
+		st := getFieldSerializedType(*fi, t.definitions)
 
 		var res any
 		if fi.IsVLEncoded {
@@ -89,7 +97,7 @@ func (t *STObject) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
 				return nil, err
 			}
 		}
-		res, err = enumToStr(fi.FieldName, res)
+		res, err = enumToStr(t.defs(), fi.FieldName, res)
 		if err != nil {
 			return nil, err
 		}
@@ -106,17 +114,17 @@ func (t *STObject) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
 // Special handling for PermissionValue fields: converts string permission names to numeric values.
 //
 //lint:ignore U1000 // ignore this for now
-func createFieldInstanceMapFromJson(json map[string]any) (map[definitions.FieldInstance]any, error) {
+func createFieldInstanceMapFromJson(d *definitions.Definitions, json map[string]any) (map[definitions.FieldInstance]any, error) {
 	m := make(map[definitions.FieldInstance]any, len(json))
 
 	for k, v := range json {
-		fi, err := definitions.Get().GetFieldInstanceByFieldName(k)
+		fi, err := d.GetFieldInstanceByFieldName(k)
 
 		if err != nil {
 			return nil, err
 		}
 
-		v, err = parseSpecialFields(k, v)
+		v, err = parseSpecialFields(d, k, v)
 		if err != nil {
 			return nil, err
 		}
@@ -127,10 +135,10 @@ func createFieldInstanceMapFromJson(json map[string]any) (map[definitions.FieldI
 }
 
 // parseSpecialFields is a helper function that handles special fields that need type parsing.
-func parseSpecialFields(k string, v any) (any, error) {
+func parseSpecialFields(d *definitions.Definitions, k string, v any) (any, error) {
 	if k == "PermissionValue" {
 		if strValue, ok := v.(string); ok {
-			permissionValue, err := definitions.Get().GetDelegatablePermissionValueByName(strValue)
+			permissionValue, err := d.GetDelegatablePermissionValueByName(strValue)
 			if err != nil {
 				return nil, err
 			}
@@ -166,24 +174,24 @@ func getSortedKeys(m map[definitions.FieldInstance]any) []definitions.FieldInsta
 // and returns a string representation of the value if the field is an enumerated type
 // (i.e., TransactionType, TransactionResult, LedgerEntryType, PermissionValue).
 // If the field is not an enumerated type, the original value is returned.
-func enumToStr(fieldName string, value any) (any, error) {
+func enumToStr(d *definitions.Definitions, fieldName string, value any) (any, error) {
 	switch fieldName {
 	case "TransactionType":
 		// TODO: Check if this is still needed
 		//nolint:gosec // G115: Potential hardcoded credentials (gosec)
-		return definitions.Get().GetTransactionTypeNameByTransactionTypeCode(int32(value.(int)))
+		return d.GetTransactionTypeNameByTransactionTypeCode(int32(value.(int)))
 	case "TransactionResult":
 		// TODO: Check if this is still needed
 		//nolint:gosec // G115: Potential hardcoded credentials (gosec)
-		return definitions.Get().GetTransactionResultNameByTransactionResultTypeCode(int32(value.(int)))
+		return d.GetTransactionResultNameByTransactionResultTypeCode(int32(value.(int)))
 	case "LedgerEntryType":
 		// TODO: Check if this is still needed
 		//nolint:gosec // G115: Potential hardcoded credentials (gosec)
-		return definitions.Get().GetLedgerEntryTypeNameByLedgerEntryTypeCode(int32(value.(int)))
+		return d.GetLedgerEntryTypeNameByLedgerEntryTypeCode(int32(value.(int)))
 	case "PermissionValue":
 		// Convert permission value to permission name if available, otherwise return numeric value
 		//nolint:gosec // G115: Potential hardcoded credentials (gosec)
-		if name, err := definitions.Get().GetDelegatablePermissionNameByValue(int32(value.(uint32))); err == nil {
+		if name, err := d.GetDelegatablePermissionNameByValue(int32(value.(uint32))); err == nil {
 			return name, nil
 		}
 		return value, nil
@@ -4,12 +4,13 @@ import (
 	"bytes"
 	"encoding/binary"
 
-	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
 	"github.com/Peersyst/xrpl-go/binary-codec/types/interfaces"
 )
 
 // UInt16 represents a 16-bit unsigned integer.
-type UInt16 struct{}
+type UInt16 struct {
+	definitionsRef
+}
 
 // FromJSON converts a JSON value into a serialized byte slice representing a 16-bit unsigned integer.
 // If the input value is a string, it's assumed to be a transaction type or ledger entry type name, and the
@@ -17,9 +18,9 @@ type UInt16 struct{}
 func (u *UInt16) FromJSON(value any) ([]byte, error) {
 
 	if _, ok := value.(string); ok {
-		tc, err := definitions.Get().GetTransactionTypeCodeByTransactionTypeName(value.(string))
+		tc, err := u.defs().GetTransactionTypeCodeByTransactionTypeName(value.(string))
 		if err != nil {
-			tc, err = definitions.Get().GetLedgerEntryTypeCodeByLedgerEntryTypeName(value.(string))
+			tc, err = u.defs().GetLedgerEntryTypeCodeByLedgerEntryTypeName(value.(string))
 			if err != nil {
 				return nil, err
 			}
@@ -2,38 +2,62 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
-	"regexp"
+	"math"
+	"strconv"
 	"strings"
 
 	"github.com/Peersyst/xrpl-go/binary-codec/types/interfaces"
 )
 
 // UInt64 represents a 64-bit unsigned integer.
-type UInt64 struct{}
+// Its JSON value is a hex string, except for the fields holding MPT amounts, which use
+// a decimal string.
+type UInt64 struct {
+	base10 bool
+}
 
 var ErrInvalidUInt64String = errors.New("invalid UInt64 string, value should be a string representation of a UInt64")
 
+// base10UInt64Fields are the UInt64 fields whose JSON value is a decimal string.
+var base10UInt64Fields = map[string]bool{
+	"MaximumAmount":     true,
+	"OutstandingAmount": true,
+	"MPTAmount":         true,
+	"LockedAmount":      true,
+}
+
 // FromJSON converts a JSON value into a serialized byte slice representing a 64-bit unsigned integer.
-// The input value is assumed to be a string representation of an integer. If the serialization fails, an error is returned.
+// The input value is assumed to be a string representation of an integer, or a JSON number. If the
+// serialization fails, an error is returned.
 func (u *UInt64) FromJSON(value any) ([]byte, error) {
 
 	var buf = new(bytes.Buffer)
 
 	if _, ok := value.(string); !ok {
-		return nil, ErrInvalidUInt64String
+		n, err := toUint64(value)
+		if err != nil {
+			return nil, ErrInvalidUInt64String
+		}
+		return binary.BigEndian.AppendUint64(nil, n), nil
 	}
 
-	if !isNumeric(value.(string)) {
-		hex, err := hex.DecodeString(value.(string))
+	if u.base10 {
+		n, err := strconv.ParseUint(value.(string), 10, 64)
 		if err != nil {
-			return nil, err
+			return nil, ErrInvalidUInt64String
 		}
-		buf.Write(hex)
-		return buf.Bytes(), nil
+		return binary.BigEndian.AppendUint64(nil, n), nil
 	}
-	value = strings.Repeat("0", 16-len(value.(string))) + value.(string) // right justify the string
+
+	// Hex values shorter than 16 digits are right justified.
+	if len(value.(string)) > 16 {
+		return nil, ErrInvalidUInt64String
+	}
+	value = strings.Repeat("0", 16-len(value.(string))) + value.(string)
 	decoded, err := hex.DecodeString(value.(string))
 	if err != nil {
 		return nil, err
@@ -51,11 +75,33 @@ func (u *UInt64) ToJSON(p interfaces.BinaryParser, _ ...int) (any, error) {
 	if err != nil {
 		return nil, err
 	}
+	if u.base10 {
+		return strconv.FormatUint(binary.BigEndian.Uint64(b), 10), nil
+	}
 	return strings.ToUpper(hex.EncodeToString(b)), nil
 }
 
-// isNumeric checks if a string only contains numerical values.
-func isNumeric(s string) bool {
-	match, _ := regexp.MatchString("^[0-9]+$", s)
-	return match
+// toUint64 converts a non-negative JSON number or Go integer into a uint64.
+func toUint64(value any) (uint64, error) {
+	switch v := value.(type) {
+	case uint64:
+		return v, nil
+	case uint:
+		return uint64(v), nil
+	case json.Number:
+		return strconv.ParseUint(v.String(), 10, 64)
+	case float64:
+		if v < 0 || v != math.Trunc(v) || v >= math.MaxUint64 {
+			return 0, errNotValidJSON
+		}
+		return uint64(v), nil
+	}
+	n, err := toInt64(value)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, errNotValidJSON
+	}
+	return uint64(n), nil
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -21,11 +22,29 @@ func TestUint64_FromJson(t *testing.T) {
 		expectedErr error
 	}{
 		{
-			name:        "fail - value is not a string",
-			input:       1,
+			name:        "fail - value is not a string or a number",
+			input:       true,
 			expected:    nil,
 			expectedErr: ErrInvalidUInt64String,
 		},
+		{
+			name:        "fail - negative number",
+			input:       -1,
+			expected:    nil,
+			expectedErr: ErrInvalidUInt64String,
+		},
+		{
+			name:        "pass - valid uint64 number",
+			input:       740,
+			expected:    []byte{0, 0, 0, 0, 0, 0, 2, 228},
+			expectedErr: nil,
+		},
+		{
+			name:        "pass - valid uint64 json number",
+			input:       json.Number("740"),
+			expected:    []byte{0, 0, 0, 0, 0, 0, 2, 228},
+			expectedErr: nil,
+		},
 		{
 			name:        "fail - invalid hex string",
 			input:       "invalid",
@@ -144,3 +163,20 @@ func TestUint64_ToJson(t *testing.T) {
 	}
 
 }
+
+func TestUint64_Base10(t *testing.T) {
+	fi, err := definitions.Get().GetFieldInstanceByFieldName("MaximumAmount")
+	require.NoError(t, err)
+	class := getFieldSerializedType(*fi, nil)
+
+	b, err := class.FromJSON("1000")
+	require.NoError(t, err)
+	require.Equal(t, []byte{0, 0, 0, 0, 0, 0, 3, 232}, b)
+
+	actual, err := class.ToJSON(serdes.NewBinaryParser(b, definitions.Get()))
+	require.NoError(t, err)
+	require.Equal(t, "1000", actual)
+
+	_, err = class.FromJSON("FF")
+	require.ErrorIs(t, err, ErrInvalidUInt64String)
+}
@@ -58,13 +58,15 @@ func (x *XChainBridge) FromJSON(json any) ([]byte, error) {
 }
 
 // ToJSON converts a byte slice representation of an XChainBridge object to its json representation.
+// It reads the optional length, or the 80 bytes of the four accounts of the bridge by default.
 // It returns an error if the bytes are not valid or if the classic addresses are not valid.
 func (x *XChainBridge) ToJSON(p interfaces.BinaryParser, opts ...int) (any, error) {
-	if opts == nil {
-		return nil, ErrNoLengthPrefix
+	length := 80
+	if len(opts) > 0 {
+		length = opts[0]
 	}
 
-	bytes, err := p.ReadBytes(opts[0])
+	bytes, err := p.ReadBytes(length)
 	if err != nil {
 		return nil, errReadBytes
 	}
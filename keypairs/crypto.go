@@ -30,5 +30,9 @@ func getCryptoImplementationFromKey(k string) interfaces.KeypairCryptoAlg {
 	if secp256k1 := crypto.SECP256K1(); prefix[0] == secp256k1.Prefix() {
 		return secp256k1
 	}
+	// Compressed SECP256K1 public keys start with 0x02 or 0x03.
+	if prefix[0] == 0x02 || prefix[0] == 0x03 {
+		return crypto.SECP256K1()
+	}
 	return nil
 }
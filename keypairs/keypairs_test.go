@@ -245,6 +245,14 @@ func TestValidate(t *testing.T) {
 			expected:    true,
 			expectedErr: nil,
 		},
+		{
+			name:        "pass - valid message with SECP256K1 key",
+			inputMsg:    "test message",
+			inputPubKey: "023D5005A90AE49BEA3BECECCE9B3AB0CA89F60DF3191A54BCCDBF39F47F4AD610",
+			inputSig:    "304402202701872D33B41250556E7859587AD6B39F12F453C69D3F7CC0105E4BF9D1417C02203453B4A4907C7C4C56998FC1E25D207AB71802C008F010B40F9A0F6079EFC9E4",
+			expected:    true,
+			expectedErr: nil,
+		},
 	}
 
 	for _, tc := range tt {
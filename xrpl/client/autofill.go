@@ -0,0 +1,507 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	commonconstants "github.com/Peersyst/xrpl-go/xrpl/common"
+	"github.com/Peersyst/xrpl-go/xrpl/currency"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// Autofill fills in the missing fields in a transaction.
+func (c *Core) Autofill(tx *transaction.FlatTransaction) error {
+	return c.AutofillWithContext(context.Background(), tx)
+}
+
+// AutofillWithContext is like Autofill but uses ctx for cancellation and deadlines.
+func (c *Core) AutofillWithContext(ctx context.Context, tx *transaction.FlatTransaction) error {
+	if err := c.setValidTransactionAddresses(tx); err != nil {
+		return err
+	}
+
+	err := c.setTransactionFlags(tx)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := (*tx)["NetworkID"]; !ok {
+		if c.networkID() != 0 {
+			(*tx)["NetworkID"] = c.networkID()
+		}
+	}
+	if _, ok := (*tx)["Sequence"]; !ok {
+		err := c.setTransactionNextValidSequenceNumber(ctx, tx)
+		if err != nil {
+			return err
+		}
+	}
+	if _, ok := (*tx)["Fee"]; !ok {
+		err := c.calculateFeePerTransactionType(ctx, tx, 0)
+		if err != nil {
+			return err
+		}
+	}
+	if _, ok := (*tx)["LastLedgerSequence"]; !ok {
+		err := c.setLastLedgerSequence(ctx, tx)
+		if err != nil {
+			return err
+		}
+	}
+	if txType, ok := (*tx)["TransactionType"].(string); ok {
+		if acc, ok := (*tx)["Account"].(types.Address); txType == transaction.AccountDeleteTx.String() && ok {
+			err := c.checkAccountDeleteBlockers(ctx, acc)
+			if err != nil {
+				return err
+			}
+		}
+		if txType == transaction.PaymentTx.String() {
+			err := c.checkPaymentAmounts(tx)
+			if err != nil {
+				return err
+			}
+		}
+		if txType == transaction.BatchTx.String() {
+			err := c.autofillRawTransactions(ctx, tx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// AutofillMultisigned fills in the missing fields in a multisigned transaction.
+// This function is used to fill in the missing fields in a multisigned transaction.
+// It fills in the missing fields in the transaction and calculates the fee per number of signers.
+func (c *Core) AutofillMultisigned(tx *transaction.FlatTransaction, nSigners uint64) error {
+	return c.AutofillMultisignedWithContext(context.Background(), tx, nSigners)
+}
+
+// AutofillMultisignedWithContext is like AutofillMultisigned but uses ctx for cancellation and deadlines.
+func (c *Core) AutofillMultisignedWithContext(ctx context.Context, tx *transaction.FlatTransaction, nSigners uint64) error {
+	err := c.AutofillWithContext(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	err = c.calculateFeePerTransactionType(ctx, tx, nSigners)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Core) autofillRawTransactions(ctx context.Context, tx *transaction.FlatTransaction) error {
+	needsNetworkID, err := c.txNeedsNetworkID(ctx)
+	if err != nil {
+		return err
+	}
+
+	rawTxs, ok := (*tx)["RawTransactions"].([]map[string]any)
+	if !ok {
+		return ErrRawTransactionsFieldIsNotAnArray
+	}
+
+	accountSeq := make(map[string]uint32, len(rawTxs))
+
+	for _, rawTx := range rawTxs {
+		innerRawTx, ok := rawTx["RawTransaction"].(map[string]any)
+		if !ok {
+			return ErrRawTransactionFieldIsNotAnObject
+		}
+
+		// Validate `Fee` field
+		if innerRawTx["Fee"] == nil {
+			innerRawTx["Fee"] = "0"
+		} else if innerRawTx["Fee"] != "0" {
+			return types.ErrBatchInnerTransactionInvalid
+		}
+
+		// Validate `SigningPubKey` field
+		if innerRawTx["SigningPubKey"] == nil {
+			innerRawTx["SigningPubKey"] = ""
+		} else if innerRawTx["SigningPubKey"] != "" {
+			return ErrSigningPubKeyFieldMustBeEmpty
+		}
+
+		// Validate `TxnSignature` field
+		if innerRawTx["TxnSignature"] != nil {
+			return ErrTxnSignatureFieldMustBeEmpty
+		}
+		if innerRawTx["Signers"] != nil {
+			return ErrSignersFieldMustBeEmpty
+		}
+
+		// Validate `NetworkID` field
+		if innerRawTx["NetworkID"] == nil && needsNetworkID {
+			innerRawTx["NetworkID"] = c.networkID()
+		}
+
+		// Validate `Sequence` field
+		if innerRawTx["Sequence"] == nil && innerRawTx["TicketSequence"] == nil {
+
+			acc, ok := innerRawTx["Account"].(string)
+			if !ok {
+				return ErrAccountFieldIsNotAString
+			}
+
+			if accountSeq[acc] != 0 {
+				innerRawTx["Sequence"] = accountSeq[acc]
+				accountSeq[acc]++
+			} else {
+				accountInfo, err := c.getAccountInfo(ctx, &account.InfoRequest{
+					Account: types.Address(acc),
+				})
+				if err != nil {
+					return err
+				}
+				var seq uint32
+				if innerRawTx["Account"] == (*tx)["Account"] {
+					seq = accountInfo.AccountData.Sequence + 1
+				} else {
+					seq = accountInfo.AccountData.Sequence
+				}
+				accountSeq[acc] = seq + 1
+				innerRawTx["Sequence"] = seq
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sets valid addresses for the transaction.
+func (c *Core) setValidTransactionAddresses(tx *transaction.FlatTransaction) error {
+	// Validate if "Account" address is an xAddress
+	if err := c.validateTransactionAddress(tx, "Account", "SourceTag"); err != nil {
+		return err
+	}
+
+	if _, ok := (*tx)["Destination"]; ok {
+		if err := c.validateTransactionAddress(tx, "Destination", "DestinationTag"); err != nil {
+			return err
+		}
+	}
+
+	// DepositPreuaht
+	c.convertTransactionAddressToClassicAddress(tx, "Authorize")
+	c.convertTransactionAddressToClassicAddress(tx, "Unauthorize")
+	// EscrowCancel, EscrowFinish
+	c.convertTransactionAddressToClassicAddress(tx, "Owner")
+	// SetRegularKey
+	c.convertTransactionAddressToClassicAddress(tx, "RegularKey")
+
+	return nil
+}
+
+// TODO: Implement this when IsValidXAddress is implemented
+func (c *Core) getClassicAccountAndTag(address string) (string, uint32) {
+	return address, 0
+}
+
+func (c *Core) convertTransactionAddressToClassicAddress(tx *transaction.FlatTransaction, fieldName string) {
+	if address, ok := (*tx)[fieldName].(string); ok {
+		classicAddress, _ := c.getClassicAccountAndTag(address)
+		(*tx)[fieldName] = classicAddress
+	}
+}
+
+func (c *Core) validateTransactionAddress(tx *transaction.FlatTransaction, addressField, tagField string) error {
+	classicAddress, tag := c.getClassicAccountAndTag((*tx)[addressField].(string))
+	(*tx)[addressField] = classicAddress
+
+	if tag != uint32(0) {
+		if txTag, ok := (*tx)[tagField].(uint32); ok && txTag != tag {
+			return fmt.Errorf("the %s, if present, must be equal to the tag of the %s", addressField, tagField)
+		}
+		(*tx)[tagField] = tag
+	}
+
+	return nil
+}
+
+// Sets the next valid sequence number for a given transaction.
+func (c *Core) setTransactionNextValidSequenceNumber(ctx context.Context, tx *transaction.FlatTransaction) error {
+	if _, ok := (*tx)["Account"].(string); !ok {
+		return errors.New("missing Account in transaction")
+	}
+	res, err := c.getAccountInfo(ctx, &account.InfoRequest{
+		Account:     types.Address((*tx)["Account"].(string)),
+		LedgerIndex: common.LedgerTitle("current"),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	(*tx)["Sequence"] = uint32(res.AccountData.Sequence)
+	return nil
+}
+
+// Calculates the current transaction fee for the ledger.
+// Note: This is a public API that can be called directly.
+func (c *Core) getFeeXrp(ctx context.Context, cushion float32) (string, error) {
+	res, err := c.getServerInfo(ctx, &server.InfoRequest{})
+	if err != nil {
+		return "", err
+	}
+
+	if res.Info.ValidatedLedger.BaseFeeXRP == 0 {
+		return "", errors.New("getFeeXrp: could not get BaseFeeXrp from ServerInfo")
+	}
+
+	loadFactor := res.Info.LoadFactor
+	if res.Info.LoadFactor == 0 {
+		loadFactor = 1
+	}
+
+	fee := res.Info.ValidatedLedger.BaseFeeXRP * float32(loadFactor) * cushion
+
+	if fee > c.cfg.MaxFeeXRP {
+		fee = c.cfg.MaxFeeXRP
+	}
+
+	// Round fee to NUM_DECIMAL_PLACES
+	roundedFee := float32(math.Round(float64(fee)*math.Pow10(int(currency.MaxFractionLength)))) / float32(math.Pow10(int(currency.MaxFractionLength)))
+
+	// Convert the rounded fee back to a string with NUM_DECIMAL_PLACES
+	return fmt.Sprintf("%.*f", currency.MaxFractionLength, roundedFee), nil
+}
+
+// Calculates the fee per transaction type.
+//
+// Enhanced implementation that replicates xrpl.js calculateFeePerTransactionType logic,
+// including special cases for EscrowFinish, AccountDelete, AMMCreate, Batch, and multi-signing.
+func (c *Core) calculateFeePerTransactionType(ctx context.Context, tx *transaction.FlatTransaction, nSigners uint64) error {
+	// Get base network fee
+	netFeeXRP, err := c.getFeeXrp(ctx, c.cfg.FeeCushion)
+	if err != nil {
+		return err
+	}
+
+	netFeeDrops, err := currency.XrpToDrops(netFeeXRP)
+	if err != nil {
+		return err
+	}
+
+	// Convert to uint64 for calculations
+	baseFeeUint, err := strconv.ParseUint(netFeeDrops, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	baseFee := baseFeeUint
+
+	// Get transaction type
+	transactionType := ""
+	if txType, ok := (*tx)["TransactionType"]; ok {
+		if str, ok := txType.(string); ok {
+			transactionType = str
+		}
+	}
+
+	// Check if this is a special transaction cost type
+	isSpecialTxCost := transactionType == "AccountDelete" || transactionType == "AMMCreate"
+
+	switch transactionType {
+	case "EscrowFinish":
+		if fulfillment, ok := (*tx)["Fulfillment"]; ok && fulfillment != nil {
+			if fulfillmentStr, ok := fulfillment.(string); ok && fulfillmentStr != "" {
+				fulfillmentBytesSize := (len(fulfillmentStr) + 1) / 2 // Math.ceil(length / 2)
+				if fulfillmentBytesSize < 0 {
+					return fmt.Errorf("invalid fulfillment length")
+				}
+				// BaseFee × (33 + floor(Fulfillment size in bytes / 16)), as rippled computes it
+				chunks := uint64(fulfillmentBytesSize) / 16
+				baseFee = baseFeeUint * (33 + chunks)
+			}
+		}
+	case "AccountDelete", "AMMCreate":
+		reserveFee, err := c.fetchOwnerReserveFee(ctx)
+		if err != nil {
+			return err
+		}
+		baseFee = reserveFee
+	case "Batch":
+		rawTxFees, err := c.calculateBatchFees(ctx, tx)
+		if err != nil {
+			return err
+		}
+		baseFee = baseFeeUint*2 + rawTxFees
+	}
+
+	// Multi-signed Transaction: BaseFee × (1 + Number of Signatures Provided)
+	if nSigners > 0 {
+		signersFee := baseFeeUint * nSigners
+		baseFee += signersFee
+	}
+
+	// Apply max fee limit (but not for special transaction cost types)
+	var totalFee uint64
+	if isSpecialTxCost {
+		totalFee = baseFee
+	} else {
+		maxFeeDrops, err := currency.XrpToDrops(fmt.Sprintf("%.6f", c.cfg.MaxFeeXRP))
+		if err != nil {
+			return err
+		}
+		maxFeeUint, err := strconv.ParseUint(maxFeeDrops, 10, 64)
+		if err != nil {
+			return err
+		}
+		if baseFee < maxFeeUint {
+			totalFee = baseFee
+		} else {
+			totalFee = maxFeeUint
+		}
+	}
+
+	(*tx)["Fee"] = strconv.FormatUint(totalFee, 10)
+	return nil
+}
+
+// fetchOwnerReserveFee fetches the owner reserve fee from the server state.
+// Replicates the JavaScript fetchOwnerReserveFee function.
+func (c *Core) fetchOwnerReserveFee(ctx context.Context) (uint64, error) {
+	response, err := c.getServerState(ctx, &server.StateRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	reserveInc := response.State.ValidatedLedger.ReserveInc
+	if reserveInc == 0 {
+		return 0, errors.New("could not fetch Owner Reserve")
+	}
+
+	return uint64(reserveInc), nil
+}
+
+// calculateBatchFees calculates the total fees for all inner transactions in a Batch.
+// Replicates the JavaScript logic for Batch transaction fee calculation.
+func (c *Core) calculateBatchFees(ctx context.Context, tx *transaction.FlatTransaction) (uint64, error) {
+	var totalFees uint64
+
+	// Get RawTransactions from the batch transaction
+	rawTransactions, ok := (*tx)["RawTransactions"].([]map[string]any)
+	if !ok {
+		return 0, errors.New("RawTransactions field missing from Batch transaction")
+	}
+
+	// Iterate through each raw transaction
+	for _, rawTx := range rawTransactions {
+		// Extract the actual transaction from the wrapper
+		innerTx, ok := rawTx["RawTransaction"].(map[string]any)
+		if !ok {
+			return 0, errors.New("RawTransaction field missing from wrapper")
+		}
+
+		// Calculate fee for this inner transaction (no multi-signing for inner transactions)
+		innerTxFlat := transaction.FlatTransaction(innerTx)
+		err := c.calculateFeePerTransactionType(ctx, &innerTxFlat, 0)
+		if err != nil {
+			return 0, err
+		}
+
+		// Extract the calculated fee
+		feeStr, ok := innerTx["Fee"].(string)
+		if !ok {
+			return 0, errors.New("fee field missing after calculation")
+		}
+
+		innerTx["Fee"] = "0"
+
+		// Convert fee string to uint64 and add to total
+		feeUint, err := strconv.ParseUint(feeStr, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse fee '%s': %w", feeStr, err)
+		}
+
+		totalFees += feeUint
+	}
+
+	return totalFees, nil
+}
+
+// Sets the latest validated ledger sequence for the transaction.
+// Modifies the `LastLedgerSequence` field in the tx.
+func (c *Core) setLastLedgerSequence(ctx context.Context, tx *transaction.FlatTransaction) error {
+	index, err := c.getLedgerIndex(ctx)
+	if err != nil {
+		return err
+	}
+
+	(*tx)["LastLedgerSequence"] = index.Uint32() + commonconstants.LedgerOffset
+	return err
+}
+
+// Checks for any blockers that prevent the deletion of an account.
+// Returns nil if there are no blockers, otherwise returns an error.
+func (c *Core) checkAccountDeleteBlockers(ctx context.Context, address types.Address) error {
+	accObjects, err := c.getAccountObjects(ctx, &account.ObjectsRequest{
+		Account:              address,
+		LedgerIndex:          common.LedgerTitle("validated"),
+		DeletionBlockersOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(accObjects.AccountObjects) > 0 {
+		return errors.New("account %s cannot be deleted; there are Escrows, PayChannels, RippleStates, or Checks associated with the account")
+	}
+	return nil
+}
+
+func (c *Core) checkPaymentAmounts(tx *transaction.FlatTransaction) error {
+	if _, ok := (*tx)["DeliverMax"]; ok {
+		if _, ok := (*tx)["Amount"]; !ok {
+			(*tx)["Amount"] = (*tx)["DeliverMax"]
+		} else if (*tx)["Amount"] != (*tx)["DeliverMax"] {
+			return errors.New("payment transaction: Amount and DeliverMax fields must be identical when both are provided")
+		}
+	}
+	return nil
+}
+
+// Sets a transaction's flags to its numeric representation.
+// TODO: Add flag support for AMMDeposit, AMMWithdraw,
+// NFTTOkenCreateOffer, NFTokenMint, OfferCreate, XChainModifyBridge (not supported).
+func (c *Core) setTransactionFlags(tx *transaction.FlatTransaction) error {
+	flags, ok := (*tx)["Flags"].(uint32)
+	if !ok && flags > 0 {
+		(*tx)["Flags"] = int(0)
+		return nil
+	}
+
+	_, ok = (*tx)["TransactionType"].(string)
+	if !ok {
+		return errors.New("transaction type is missing in transaction")
+	}
+
+	return nil
+}
+
+// txNeedsNetworkID determines if the transaction required a networkID to be valid.
+// Transaction needs networkID if later than restricted ID and build version is >= 1.11.0
+func (c *Core) txNeedsNetworkID(ctx context.Context) (bool, error) {
+	if c.networkID() != 0 && c.networkID() > RestrictedNetworks {
+		res, err := c.getServerInfo(ctx, &server.InfoRequest{})
+		if err != nil {
+			return false, err
+		}
+
+		if res.Info.BuildVersion != "" {
+			return isNotLaterRippledVersion(RequiredNetworkIDVersion, res.Info.BuildVersion), nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,173 @@
+// Package client contains the transport-agnostic logic shared by the rpc and
+// websocket clients: transaction autofill, fee calculation and submission.
+package client
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+
+	commonconstants "github.com/Peersyst/xrpl-go/xrpl/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+// Request is a request that can be sent to an XRPL server by any transport.
+type Request interface {
+	Method() string
+	Validate() error
+	APIVersion() int
+	SetAPIVersion(apiVersion int)
+}
+
+// Response is a server response whose result can be decoded into a typed value.
+type Response interface {
+	GetResult(v any) error
+}
+
+// RequestFunc sends a request through a transport and returns its response.
+type RequestFunc func(ctx context.Context, req Request) (Response, error)
+
+// Config holds the settings used to autofill and submit transactions.
+type Config struct {
+	// Maximum number of ledgers polled while waiting for a transaction to be validated
+	MaxRetries int
+	// Delay between polls while waiting for a transaction to be validated
+	RetryDelay time.Duration
+
+	// Multiplier applied to the network fee
+	FeeCushion float32
+	// Maximum fee in XRP set on a transaction
+	MaxFeeXRP float32
+
+	FaucetProvider commonconstants.FaucetProvider
+
+	// Definitions used to encode and decode transactions. Nil uses the
+	// definitions embedded in the binary codec.
+	Definitions *definitions.Definitions
+
+	// NetworkID points to the NetworkID field of the client, so changes to it
+	// apply to later autofills. Nil means no network ID.
+	NetworkID *uint32
+}
+
+// Core implements the autofill, fee and submission logic on top of a transport.
+// Clients embed it to share a single implementation of those methods.
+type Core struct {
+	request     RequestFunc
+	cfg         Config
+	definitions atomic.Pointer[definitions.Definitions]
+}
+
+// NewCore returns a Core sending its requests with request.
+func NewCore(request RequestFunc, cfg Config) *Core {
+	c := &Core{
+		request: request,
+		cfg:     cfg,
+	}
+	c.definitions.Store(cfg.Definitions)
+	return c
+}
+
+// Definitions returns the definitions the client encodes and decodes
+// transactions with, or nil if it uses the embedded ones.
+func (c *Core) Definitions() *definitions.Definitions {
+	return c.definitions.Load()
+}
+
+// SetDefinitions sets the definitions the client encodes and decodes
+// transactions with, for example the ones returned by GetServerDefinitions.
+// A nil d restores the definitions embedded in the binary codec.
+func (c *Core) SetDefinitions(d *definitions.Definitions) {
+	c.definitions.Store(d)
+}
+
+// networkID returns the network ID of the client, or 0 if it has none.
+func (c *Core) networkID() uint32 {
+	if c.cfg.NetworkID == nil {
+		return 0
+	}
+	return *c.cfg.NetworkID
+}
+
+// codecOptions returns the binary codec options matching the client definitions.
+func (c *Core) codecOptions() []binarycodec.Option {
+	return []binarycodec.Option{binarycodec.WithDefinitions(c.definitions.Load())}
+}
+
+// FaucetProvider returns the faucet provider for the client.
+func (c *Core) FaucetProvider() commonconstants.FaucetProvider {
+	return c.cfg.FaucetProvider
+}
+
+// FundWallet funds a wallet with the client's faucet provider.
+func (c *Core) FundWallet(wallet *wallet.Wallet) error {
+	if wallet.ClassicAddress == "" {
+		return ErrCannotFundWalletWithoutClassicAddress
+	}
+
+	err := c.cfg.FaucetProvider.FundWallet(wallet.ClassicAddress)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// query sends req and decodes its result into v.
+func (c *Core) query(ctx context.Context, req Request, v any) error {
+	res, err := c.request(ctx, req)
+	if err != nil {
+		return err
+	}
+	return res.GetResult(v)
+}
+
+func (c *Core) getAccountInfo(ctx context.Context, req *account.InfoRequest) (*account.InfoResponse, error) {
+	var res account.InfoResponse
+	if err := c.query(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Core) getAccountObjects(ctx context.Context, req *account.ObjectsRequest) (*account.ObjectsResponse, error) {
+	var res account.ObjectsResponse
+	if err := c.query(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Core) getServerInfo(ctx context.Context, req *server.InfoRequest) (*server.InfoResponse, error) {
+	var res server.InfoResponse
+	if err := c.query(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Core) getServerState(ctx context.Context, req *server.StateRequest) (*server.StateResponse, error) {
+	var res server.StateResponse
+	if err := c.query(ctx, req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// getLedgerIndex returns the index of the latest validated ledger.
+func (c *Core) getLedgerIndex(ctx context.Context) (common.LedgerIndex, error) {
+	var res ledger.Response
+	if err := c.query(ctx, &ledger.Request{
+		LedgerIndex: common.LedgerTitle("validated"),
+	}, &res); err != nil {
+		return 0, err
+	}
+	return res.LedgerIndex, nil
+}
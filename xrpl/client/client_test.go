@@ -0,0 +1,196 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	commonconstants "github.com/Peersyst/xrpl-go/xrpl/common"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+// testResponse decodes its result from the JSON representation, as sent by a server.
+type testResponse struct {
+	result any
+}
+
+func (r *testResponse) GetResult(v any) error {
+	b, err := json.Marshal(r.result)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// newTestCore returns a Core answering its requests with messages, in order.
+// A message with an "error" field fails the request with that error.
+func newTestCore(messages []map[string]any) *Core {
+	i := 0
+	return NewCore(func(_ context.Context, req Request) (Response, error) {
+		if i >= len(messages) {
+			return nil, fmt.Errorf("unexpected %s request", req.Method())
+		}
+		msg := messages[i]
+		i++
+		if e, ok := msg["error"].(string); ok {
+			return nil, errors.New(e)
+		}
+		return &testResponse{result: msg["result"]}, nil
+	}, Config{
+		MaxRetries: commonconstants.DefaultMaxRetries,
+		RetryDelay: time.Millisecond,
+		FeeCushion: commonconstants.DefaultFeeCushion,
+		MaxFeeXRP:  commonconstants.DefaultMaxFeeXRP,
+	})
+}
+
+type testFaucetProvider struct {
+	funded []string
+}
+
+func (f *testFaucetProvider) FundWallet(address types.Address) error {
+	f.funded = append(f.funded, string(address))
+	return nil
+}
+
+func TestCore_FundWallet(t *testing.T) {
+	fp := &testFaucetProvider{}
+	c := NewCore(nil, Config{FaucetProvider: fp})
+
+	require.ErrorIs(t, c.FundWallet(&wallet.Wallet{}), ErrCannotFundWalletWithoutClassicAddress)
+	require.NoError(t, c.FundWallet(&wallet.Wallet{ClassicAddress: "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"}))
+	require.Equal(t, []string{"rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf"}, fp.funded)
+}
+
+func TestCore_getSignedTx(t *testing.T) {
+	c := newTestCore(nil)
+
+	t.Run("unsigned transaction without wallet", func(t *testing.T) {
+		_, err := c.getSignedTx(context.Background(), transaction.FlatTransaction{
+			"TransactionType": "Payment",
+		}, false, nil)
+		require.ErrorIs(t, err, ErrMissingWallet)
+	})
+}
+
+func TestCore_waitForTransaction(t *testing.T) {
+	t.Run("validated in time", func(t *testing.T) {
+		c := newTestCore([]map[string]any{
+			{"result": map[string]any{"ledger_index": 100}},
+			{"result": map[string]any{"hash": "ABCD", "ledger_index": 101, "validated": true}},
+		})
+
+		res, err := c.waitForTransaction(context.Background(), "ABCD", 101)
+		require.NoError(t, err)
+		require.Equal(t, &requests.TxResponse{Hash: "ABCD", LedgerIndex: 101, Validated: true}, res)
+	})
+
+	t.Run("expired before being found", func(t *testing.T) {
+		c := newTestCore([]map[string]any{
+			{"result": map[string]any{"ledger_index": 102}},
+		})
+
+		_, err := c.waitForTransaction(context.Background(), "ABCD", 101)
+		require.ErrorIs(t, err, ErrTransactionNotFound)
+	})
+
+	t.Run("request error", func(t *testing.T) {
+		c := newTestCore([]map[string]any{
+			{"error": "noNetwork"},
+		})
+
+		_, err := c.waitForTransaction(context.Background(), "ABCD", 101)
+		require.EqualError(t, err, "noNetwork")
+	})
+}
+
+// customDefinitions returns the embedded definitions with an extra transaction type.
+func customDefinitions(t *testing.T) *definitions.Definitions {
+	t.Helper()
+	data, err := os.ReadFile("../../binary-codec/definitions/definitions.json")
+	require.NoError(t, err)
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(data, &doc))
+	doc["TRANSACTION_TYPES"].(map[string]any)["CustomTx"] = 250
+
+	data, err = json.Marshal(doc)
+	require.NoError(t, err)
+	d, err := definitions.FromJSON(data)
+	require.NoError(t, err)
+	return d
+}
+
+func TestCore_SetDefinitions(t *testing.T) {
+	w, err := wallet.FromSeed("sEdTM1uX8pu2do5XvTnutH6HsouMaM2", "")
+	require.NoError(t, err)
+	tx := func() transaction.FlatTransaction {
+		return transaction.FlatTransaction{
+			"TransactionType": "CustomTx",
+			"Account":         w.ClassicAddress.String(),
+			"Fee":             "10",
+			"Sequence":        uint32(1),
+		}
+	}
+
+	c := newTestCore(nil)
+	require.Nil(t, c.Definitions())
+	_, err = c.getSignedTx(context.Background(), tx(), false, &w)
+	require.Error(t, err)
+
+	d := customDefinitions(t)
+	c.SetDefinitions(d)
+	require.Equal(t, d, c.Definitions())
+
+	blob, err := c.getSignedTx(context.Background(), tx(), false, &w)
+	require.NoError(t, err)
+	decoded, err := binarycodec.Decode(blob, binarycodec.WithDefinitions(d))
+	require.NoError(t, err)
+	require.Equal(t, "CustomTx", decoded["TransactionType"])
+
+	c.SetDefinitions(nil)
+	_, err = c.getSignedTx(context.Background(), tx(), false, &w)
+	require.Error(t, err)
+}
+
+func TestCore_SubmitTxBlobAndWait(t *testing.T) {
+	w, err := wallet.FromSeed("sEdTM1uX8pu2do5XvTnutH6HsouMaM2", "")
+	require.NoError(t, err)
+	blob, _, err := w.Sign(transaction.FlatTransaction{
+		"TransactionType":    "AccountSet",
+		"Account":            w.ClassicAddress.String(),
+		"Fee":                "10",
+		"Sequence":           uint32(1),
+		"LastLedgerSequence": uint32(100),
+	})
+	require.NoError(t, err)
+
+	c := newTestCore([]map[string]any{
+		{"result": map[string]any{"engine_result": "tefPAST_SEQ"}},
+	})
+
+	_, err = c.SubmitTxBlobAndWait(blob, false)
+	require.ErrorIs(t, err, ErrTransactionFailedToSubmit)
+	var clientErr *ClientError
+	require.ErrorAs(t, err, &clientErr)
+	require.Equal(t, "transaction failed to submit with engine result: tefPAST_SEQ", clientErr.Error())
+}
+
+func TestCore_NetworkID(t *testing.T) {
+	var networkID uint32
+	c := NewCore(nil, Config{NetworkID: &networkID})
+	require.Zero(t, c.networkID())
+
+	networkID = 21338
+	require.Equal(t, uint32(21338), c.networkID())
+}
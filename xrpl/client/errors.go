@@ -0,0 +1,51 @@
+package client
+
+import "errors"
+
+// Static errors
+var (
+	ErrMissingTxSignatureOrSigningPubKey      = errors.New("transaction must have a TxnSignature or SigningPubKey set")
+	ErrSignerDataIsEmpty                      = errors.New("signer data is empty")
+	ErrCannotFundWalletWithoutClassicAddress  = errors.New("cannot fund wallet without classic address")
+	ErrMissingLastLedgerSequenceInTransaction = errors.New("missing LastLedgerSequence in transaction")
+	ErrMissingWallet                          = errors.New("wallet must be provided when submitting an unsigned transaction")
+	ErrTransactionFailedToSubmit              = errors.New("transaction failed to submit with engine result")
+	ErrTransactionNotFound                    = errors.New("transaction not found")
+	// ErrSlowDown is wrapped by the errors of the requests rippled rejects
+	// because the client sends them too fast.
+	ErrSlowDown = errors.New("slowDown")
+
+	ErrRawTransactionsFieldIsNotAnArray = errors.New("RawTransactions field is not an array")
+	ErrRawTransactionFieldIsNotAnObject = errors.New("RawTransaction field is not an object")
+
+	ErrSigningPubKeyFieldMustBeEmpty = errors.New("SigningPubKey field must be empty")
+	ErrTxnSignatureFieldMustBeEmpty  = errors.New("TxnSignature field must be empty")
+	ErrSignersFieldMustBeEmpty       = errors.New("Signers field must be empty")
+	ErrAccountFieldIsNotAString      = errors.New("Account field is not a string")
+)
+
+// ErrorForCode returns the static error matching the XRPL error code of a
+// response, or nil if there is none.
+func ErrorForCode(code string) error {
+	if code == ErrSlowDown.Error() {
+		return ErrSlowDown
+	}
+	return nil
+}
+
+// Dynamic errors
+
+// ClientError is an error reported by a client. Err, when set, is the static
+// error it wraps, so callers can match it with errors.Is.
+type ClientError struct {
+	ErrorString string
+	Err         error
+}
+
+func (e *ClientError) Error() string {
+	return e.ErrorString
+}
+
+func (e *ClientError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"iter"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	commonconstants "github.com/Peersyst/xrpl-go/xrpl/common"
+	ledgerentry "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	accounttypes "github.com/Peersyst/xrpl-go/xrpl/queries/account/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/channel"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/clio"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	ledgertypes "github.com/Peersyst/xrpl-go/xrpl/queries/ledger/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/nft"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/oracle"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/path"
+	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/utility"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+// XRPLClient is implemented by both the rpc and the websocket clients, so code can
+// query the ledger and submit transactions without depending on a transport.
+type XRPLClient interface {
+	// Transactions
+	Autofill(tx *transaction.FlatTransaction) error
+	AutofillWithContext(ctx context.Context, tx *transaction.FlatTransaction) error
+	AutofillMultisigned(tx *transaction.FlatTransaction, nSigners uint64) error
+	AutofillMultisignedWithContext(ctx context.Context, tx *transaction.FlatTransaction, nSigners uint64) error
+	SubmitTxBlob(txBlob string, failHard bool) (*requests.SubmitResponse, error)
+	SubmitTxBlobWithContext(ctx context.Context, txBlob string, failHard bool) (*requests.SubmitResponse, error)
+	SubmitTxBlobAndWait(txBlob string, failHard bool) (*requests.TxResponse, error)
+	SubmitTxBlobAndWaitWithContext(ctx context.Context, txBlob string, failHard bool) (*requests.TxResponse, error)
+	SubmitTx(tx transaction.FlatTransaction, opts *SubmitOptions) (*requests.SubmitResponse, error)
+	SubmitTxWithContext(ctx context.Context, tx transaction.FlatTransaction, opts *SubmitOptions) (*requests.SubmitResponse, error)
+	SubmitTxAndWait(tx transaction.FlatTransaction, opts *SubmitOptions) (*requests.TxResponse, error)
+	SubmitTxAndWaitWithContext(ctx context.Context, tx transaction.FlatTransaction, opts *SubmitOptions) (*requests.TxResponse, error)
+	SubmitMultisigned(txBlob string, failHard bool) (*requests.SubmitMultisignedResponse, error)
+	SubmitMultisignedWithContext(ctx context.Context, txBlob string, failHard bool) (*requests.SubmitMultisignedResponse, error)
+
+	// Faucet
+	FaucetProvider() commonconstants.FaucetProvider
+	FundWallet(wallet *wallet.Wallet) error
+
+	// Binary codec definitions
+	Definitions() *definitions.Definitions
+	SetDefinitions(d *definitions.Definitions)
+
+	// Paginated queries
+	AccountLines(ctx context.Context, req *account.LinesRequest, opts ...PageOption) iter.Seq2[accounttypes.TrustLine, error]
+	AccountChannels(ctx context.Context, req *account.ChannelsRequest, opts ...PageOption) iter.Seq2[accounttypes.ChannelResult, error]
+	AccountObjects(ctx context.Context, req *account.ObjectsRequest, opts ...PageOption) iter.Seq2[ledgerentry.FlatLedgerObject, error]
+	AccountOffers(ctx context.Context, req *account.OffersRequest, opts ...PageOption) iter.Seq2[accounttypes.OfferResult, error]
+	AccountNFTs(ctx context.Context, req *account.NFTsRequest, opts ...PageOption) iter.Seq2[accounttypes.NFT, error]
+	AccountTransactions(ctx context.Context, req *account.TransactionsRequest, opts ...PageOption) iter.Seq2[account.Transaction, error]
+	LedgerData(ctx context.Context, req *ledger.DataRequest, opts ...PageOption) iter.Seq2[ledgertypes.State, error]
+	BookOffers(ctx context.Context, req *path.BookOffersRequest, opts ...PageOption) iter.Seq2[pathtypes.BookOffer, error]
+
+	// Account queries
+	GetAccountInfo(req *account.InfoRequest) (*account.InfoResponse, error)
+	GetAccountInfoWithContext(ctx context.Context, req *account.InfoRequest) (*account.InfoResponse, error)
+	GetAccountChannels(req *account.ChannelsRequest) (*account.ChannelsResponse, error)
+	GetAccountChannelsWithContext(ctx context.Context, req *account.ChannelsRequest) (*account.ChannelsResponse, error)
+	GetAccountObjects(req *account.ObjectsRequest) (*account.ObjectsResponse, error)
+	GetAccountObjectsWithContext(ctx context.Context, req *account.ObjectsRequest) (*account.ObjectsResponse, error)
+	GetAccountLines(req *account.LinesRequest) (*account.LinesResponse, error)
+	GetAccountLinesWithContext(ctx context.Context, req *account.LinesRequest) (*account.LinesResponse, error)
+	GetXrpBalance(address types.Address) (string, error)
+	GetXrpBalanceWithContext(ctx context.Context, address types.Address) (string, error)
+	GetAccountNFTs(req *account.NFTsRequest) (*account.NFTsResponse, error)
+	GetAccountNFTsWithContext(ctx context.Context, req *account.NFTsRequest) (*account.NFTsResponse, error)
+	GetAccountCurrencies(req *account.CurrenciesRequest) (*account.CurrenciesResponse, error)
+	GetAccountCurrenciesWithContext(ctx context.Context, req *account.CurrenciesRequest) (*account.CurrenciesResponse, error)
+	GetAccountOffers(req *account.OffersRequest) (*account.OffersResponse, error)
+	GetAccountOffersWithContext(ctx context.Context, req *account.OffersRequest) (*account.OffersResponse, error)
+	GetAccountTransactions(req *account.TransactionsRequest) (*account.TransactionsResponse, error)
+	GetAccountTransactionsWithContext(ctx context.Context, req *account.TransactionsRequest) (*account.TransactionsResponse, error)
+	GetGatewayBalances(req *account.GatewayBalancesRequest) (*account.GatewayBalancesResponse, error)
+	GetGatewayBalancesWithContext(ctx context.Context, req *account.GatewayBalancesRequest) (*account.GatewayBalancesResponse, error)
+
+	// Channel queries
+	GetChannelVerify(req *channel.VerifyRequest) (*channel.VerifyResponse, error)
+	GetChannelVerifyWithContext(ctx context.Context, req *channel.VerifyRequest) (*channel.VerifyResponse, error)
+
+	// Ledger queries
+	GetLedgerIndex() (common.LedgerIndex, error)
+	GetLedgerIndexWithContext(ctx context.Context) (common.LedgerIndex, error)
+	GetClosedLedger() (*ledger.ClosedResponse, error)
+	GetClosedLedgerWithContext(ctx context.Context) (*ledger.ClosedResponse, error)
+	GetCurrentLedger() (*ledger.CurrentResponse, error)
+	GetCurrentLedgerWithContext(ctx context.Context) (*ledger.CurrentResponse, error)
+	GetLedgerData(req *ledger.DataRequest) (*ledger.DataResponse, error)
+	GetLedgerDataWithContext(ctx context.Context, req *ledger.DataRequest) (*ledger.DataResponse, error)
+	GetLedgerEntry(req *ledger.EntryRequest) (*ledger.EntryResponse, error)
+	GetLedgerEntryWithContext(ctx context.Context, req *ledger.EntryRequest) (*ledger.EntryResponse, error)
+	GetLedger(req *ledger.Request) (*ledger.Response, error)
+	GetLedgerWithContext(ctx context.Context, req *ledger.Request) (*ledger.Response, error)
+
+	// NFT queries
+	GetNFTBuyOffers(req *nft.NFTokenBuyOffersRequest) (*nft.NFTokenBuyOffersResponse, error)
+	GetNFTBuyOffersWithContext(ctx context.Context, req *nft.NFTokenBuyOffersRequest) (*nft.NFTokenBuyOffersResponse, error)
+	GetNFTSellOffers(req *nft.NFTokenSellOffersRequest) (*nft.NFTokenSellOffersResponse, error)
+	GetNFTSellOffersWithContext(ctx context.Context, req *nft.NFTokenSellOffersRequest) (*nft.NFTokenSellOffersResponse, error)
+
+	// Path queries
+	GetBookOffers(req *path.BookOffersRequest) (*path.BookOffersResponse, error)
+	GetBookOffersWithContext(ctx context.Context, req *path.BookOffersRequest) (*path.BookOffersResponse, error)
+	GetDepositAuthorized(req *path.DepositAuthorizedRequest) (*path.DepositAuthorizedResponse, error)
+	GetDepositAuthorizedWithContext(ctx context.Context, req *path.DepositAuthorizedRequest) (*path.DepositAuthorizedResponse, error)
+	GetAMMInfo(req *path.AMMInfoRequest) (*path.AMMInfoResponse, error)
+	GetAMMInfoWithContext(ctx context.Context, req *path.AMMInfoRequest) (*path.AMMInfoResponse, error)
+	FindPathCreate(req *path.FindCreateRequest) (*path.FindResponse, error)
+	FindPathCreateWithContext(ctx context.Context, req *path.FindCreateRequest) (*path.FindResponse, error)
+	FindPathClose(req *path.FindCloseRequest) (*path.FindResponse, error)
+	FindPathCloseWithContext(ctx context.Context, req *path.FindCloseRequest) (*path.FindResponse, error)
+	FindPathStatus(req *path.FindStatusRequest) (*path.FindResponse, error)
+	FindPathStatusWithContext(ctx context.Context, req *path.FindStatusRequest) (*path.FindResponse, error)
+	GetRipplePathFind(req *path.RipplePathFindRequest) (*path.RipplePathFindResponse, error)
+	GetRipplePathFindWithContext(ctx context.Context, req *path.RipplePathFindRequest) (*path.RipplePathFindResponse, error)
+
+	// Server queries
+	GetServerInfo(req *server.InfoRequest) (*server.InfoResponse, error)
+	GetServerInfoWithContext(ctx context.Context, req *server.InfoRequest) (*server.InfoResponse, error)
+	GetAllFeatures(req *server.FeatureAllRequest) (*server.FeatureAllResponse, error)
+	GetAllFeaturesWithContext(ctx context.Context, req *server.FeatureAllRequest) (*server.FeatureAllResponse, error)
+	GetFeature(req *server.FeatureOneRequest) (*server.FeatureResponse, error)
+	GetFeatureWithContext(ctx context.Context, req *server.FeatureOneRequest) (*server.FeatureResponse, error)
+	GetFee(req *server.FeeRequest) (*server.FeeResponse, error)
+	GetFeeWithContext(ctx context.Context, req *server.FeeRequest) (*server.FeeResponse, error)
+	GetManifest(req *server.ManifestRequest) (*server.ManifestResponse, error)
+	GetManifestWithContext(ctx context.Context, req *server.ManifestRequest) (*server.ManifestResponse, error)
+	GetServerState(req *server.StateRequest) (*server.StateResponse, error)
+	GetServerStateWithContext(ctx context.Context, req *server.StateRequest) (*server.StateResponse, error)
+	GetServerDefinitions(req *server.DefinitionsRequest) (*server.DefinitionsResponse, error)
+	GetServerDefinitionsWithContext(ctx context.Context, req *server.DefinitionsRequest) (*server.DefinitionsResponse, error)
+
+	// Transaction queries
+	GetTx(req *requests.TxRequest) (*requests.TxResponse, error)
+	GetTxWithContext(ctx context.Context, req *requests.TxRequest) (*requests.TxResponse, error)
+
+	// Oracle queries
+	GetAggregatePrice(req *oracle.GetAggregatePriceRequest) (*oracle.GetAggregatePriceResponse, error)
+	GetAggregatePriceWithContext(ctx context.Context, req *oracle.GetAggregatePriceRequest) (*oracle.GetAggregatePriceResponse, error)
+
+	// Utility queries
+	Ping(req *utility.PingRequest) (*utility.PingResponse, error)
+	PingWithContext(ctx context.Context, req *utility.PingRequest) (*utility.PingResponse, error)
+	GetRandom(req *utility.RandomRequest) (*utility.RandomResponse, error)
+	GetRandomWithContext(ctx context.Context, req *utility.RandomRequest) (*utility.RandomResponse, error)
+
+	// Clio queries
+	GetNFTInfo(req *clio.NFTInfoRequest) (*clio.NFTInfoResponse, error)
+	GetNFTInfoWithContext(ctx context.Context, req *clio.NFTInfoRequest) (*clio.NFTInfoResponse, error)
+	GetNFTHistory(req *clio.NFTHistoryRequest) (*clio.NFTHistoryResponse, error)
+	GetNFTHistoryWithContext(ctx context.Context, req *clio.NFTHistoryRequest) (*clio.NFTHistoryResponse, error)
+	GetNFTsByIssuer(req *clio.NFTsByIssuerRequest) (*clio.NFTsByIssuerResponse, error)
+	GetNFTsByIssuerWithContext(ctx context.Context, req *clio.NFTsByIssuerRequest) (*clio.NFTsByIssuerResponse, error)
+}
@@ -0,0 +1,249 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"strconv"
+	"time"
+
+	commonconstants "github.com/Peersyst/xrpl-go/xrpl/common"
+	ledgerentry "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	accounttypes "github.com/Peersyst/xrpl-go/xrpl/queries/account/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	ledgertypes "github.com/Peersyst/xrpl-go/xrpl/queries/ledger/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/path"
+	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
+)
+
+const defaultRateLimitRetries = 3
+
+type pageConfig struct {
+	maxItems         int
+	pageDelay        time.Duration
+	rateLimitRetries int
+}
+
+// PageOption configures the iterators over paginated queries.
+type PageOption func(c *pageConfig)
+
+// WithMaxItems stops the iteration after n items. The page size is still set by the
+// Limit field of the request. Default: 0, no limit.
+func WithMaxItems(n int) PageOption {
+	return func(c *pageConfig) {
+		c.maxItems = n
+	}
+}
+
+// WithPageDelay waits d between page requests, to stay below the rate limit of the
+// server. Default: 0, pages are requested as soon as the previous one is consumed.
+func WithPageDelay(d time.Duration) PageOption {
+	return func(c *pageConfig) {
+		c.pageDelay = d
+	}
+}
+
+// WithRateLimitRetries sets how many times a page is requested again, with an
+// exponential backoff starting at the client retry delay, when the server answers
+// that the client is sending requests too fast. Default: 3.
+func WithRateLimitRetries(n int) PageOption {
+	return func(c *pageConfig) {
+		c.rateLimitRetries = n
+	}
+}
+
+// pageFunc fetches the page starting at marker, returning its items and the marker
+// of the next page, nil on the last one.
+type pageFunc[T any] func(ctx context.Context, marker any) ([]T, any, error)
+
+// paginate returns an iterator over the items of every page returned by fetch,
+// starting at marker. The iteration stops after the last page, on the first error,
+// or when ctx is done, yielding the error as the last pair.
+func paginate[T any](ctx context.Context, c *Core, marker any, opts []PageOption, fetch pageFunc[T]) iter.Seq2[T, error] {
+	cfg := pageConfig{rateLimitRetries: defaultRateLimitRetries}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(yield func(T, error) bool) {
+		var zero T
+		marker := marker
+		count := 0
+		for page := 0; ; page++ {
+			if page > 0 && cfg.pageDelay > 0 {
+				if err := commonconstants.SleepWithContext(ctx, cfg.pageDelay); err != nil {
+					yield(zero, err)
+					return
+				}
+			}
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			items, next, err := fetchPage(ctx, c, cfg.rateLimitRetries, marker, fetch)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+				count++
+				if cfg.maxItems > 0 && count >= cfg.maxItems {
+					return
+				}
+			}
+			if next == nil {
+				return
+			}
+			marker = next
+		}
+	}
+}
+
+// fetchPage calls fetch, backing off and retrying up to retries times while the
+// server reports the client is rate limited.
+func fetchPage[T any](ctx context.Context, c *Core, retries int, marker any, fetch pageFunc[T]) ([]T, any, error) {
+	delay := c.cfg.RetryDelay
+	for attempt := 0; ; attempt++ {
+		items, next, err := fetch(ctx, marker)
+		if err == nil || !errors.Is(err, ErrSlowDown) || attempt >= retries {
+			return items, next, err
+		}
+		if err := commonconstants.SleepWithContext(ctx, delay); err != nil {
+			return nil, nil, err
+		}
+		delay *= 2
+	}
+}
+
+// AccountLines returns an iterator over the trust lines of an account, requesting
+// the following pages as the iteration goes on. req is not modified.
+//
+// The following pages are read from the ledger the first page was read from.
+func (c *Core) AccountLines(ctx context.Context, req *account.LinesRequest, opts ...PageOption) iter.Seq2[accounttypes.TrustLine, error] {
+	r := *req
+	return paginate(ctx, c, r.Marker, opts, func(ctx context.Context, marker any) ([]accounttypes.TrustLine, any, error) {
+		r.Marker = marker
+		var res account.LinesResponse
+		if err := c.query(ctx, &r, &res); err != nil {
+			return nil, nil, err
+		}
+		if r.LedgerHash == "" && res.LedgerIndex != 0 {
+			r.LedgerIndex = res.LedgerIndex
+		}
+		return res.Lines, res.Marker, nil
+	})
+}
+
+// AccountChannels returns an iterator over the payment channels of an account,
+// requesting the following pages as the iteration goes on. req is not modified.
+func (c *Core) AccountChannels(ctx context.Context, req *account.ChannelsRequest, opts ...PageOption) iter.Seq2[accounttypes.ChannelResult, error] {
+	r := *req
+	return paginate(ctx, c, r.Marker, opts, func(ctx context.Context, marker any) ([]accounttypes.ChannelResult, any, error) {
+		r.Marker = marker
+		var res account.ChannelsResponse
+		if err := c.query(ctx, &r, &res); err != nil {
+			return nil, nil, err
+		}
+		return res.Channels, res.Marker, nil
+	})
+}
+
+// AccountObjects returns an iterator over the ledger objects owned by an account,
+// requesting the following pages as the iteration goes on. req is not modified.
+//
+// The following pages are read from the ledger the first page was read from.
+func (c *Core) AccountObjects(ctx context.Context, req *account.ObjectsRequest, opts ...PageOption) iter.Seq2[ledgerentry.FlatLedgerObject, error] {
+	r := *req
+	return paginate(ctx, c, r.Marker, opts, func(ctx context.Context, marker any) ([]ledgerentry.FlatLedgerObject, any, error) {
+		r.Marker = marker
+		var res account.ObjectsResponse
+		if err := c.query(ctx, &r, &res); err != nil {
+			return nil, nil, err
+		}
+		if r.LedgerHash == "" && res.LedgerIndex != 0 {
+			r.LedgerIndex = res.LedgerIndex
+		}
+		return res.AccountObjects, res.Marker, nil
+	})
+}
+
+// AccountOffers returns an iterator over the offers placed by an account,
+// requesting the following pages as the iteration goes on. req is not modified.
+func (c *Core) AccountOffers(ctx context.Context, req *account.OffersRequest, opts ...PageOption) iter.Seq2[accounttypes.OfferResult, error] {
+	r := *req
+	return paginate(ctx, c, r.Marker, opts, func(ctx context.Context, marker any) ([]accounttypes.OfferResult, any, error) {
+		r.Marker = marker
+		var res account.OffersResponse
+		if err := c.query(ctx, &r, &res); err != nil {
+			return nil, nil, err
+		}
+		return res.Offers, res.Marker, nil
+	})
+}
+
+// AccountNFTs returns an iterator over the NFTs owned by an account, requesting
+// the following pages as the iteration goes on. req is not modified.
+func (c *Core) AccountNFTs(ctx context.Context, req *account.NFTsRequest, opts ...PageOption) iter.Seq2[accounttypes.NFT, error] {
+	r := *req
+	return paginate(ctx, c, r.Marker, opts, func(ctx context.Context, marker any) ([]accounttypes.NFT, any, error) {
+		r.Marker = marker
+		var res account.NFTsResponse
+		if err := c.query(ctx, &r, &res); err != nil {
+			return nil, nil, err
+		}
+		return res.AccountNFTs, res.Marker, nil
+	})
+}
+
+// AccountTransactions returns an iterator over the transactions of an account,
+// requesting the following pages as the iteration goes on. req is not modified.
+func (c *Core) AccountTransactions(ctx context.Context, req *account.TransactionsRequest, opts ...PageOption) iter.Seq2[account.Transaction, error] {
+	r := *req
+	return paginate(ctx, c, r.Marker, opts, func(ctx context.Context, marker any) ([]account.Transaction, any, error) {
+		r.Marker = marker
+		var res account.TransactionsResponse
+		if err := c.query(ctx, &r, &res); err != nil {
+			return nil, nil, err
+		}
+		return res.Transactions, res.Marker, nil
+	})
+}
+
+// LedgerData returns an iterator over the state objects of a ledger, requesting
+// the following pages as the iteration goes on. req is not modified.
+//
+// The following pages are read from the ledger the first page was read from.
+func (c *Core) LedgerData(ctx context.Context, req *ledger.DataRequest, opts ...PageOption) iter.Seq2[ledgertypes.State, error] {
+	r := *req
+	return paginate(ctx, c, r.Marker, opts, func(ctx context.Context, marker any) ([]ledgertypes.State, any, error) {
+		r.Marker = marker
+		var res ledger.DataResponse
+		if err := c.query(ctx, &r, &res); err != nil {
+			return nil, nil, err
+		}
+		if index, err := strconv.ParseUint(res.LedgerIndex, 10, 32); r.LedgerHash == "" && err == nil {
+			r.LedgerIndex = common.LedgerIndex(index)
+		}
+		return res.State, res.Marker, nil
+	})
+}
+
+// BookOffers returns an iterator over the offers of an order book, requesting the
+// following pages as the iteration goes on. req is not modified.
+func (c *Core) BookOffers(ctx context.Context, req *path.BookOffersRequest, opts ...PageOption) iter.Seq2[pathtypes.BookOffer, error] {
+	r := *req
+	return paginate(ctx, c, r.Marker, opts, func(ctx context.Context, marker any) ([]pathtypes.BookOffer, any, error) {
+		r.Marker = marker
+		var res path.BookOffersResponse
+		if err := c.query(ctx, &r, &res); err != nil {
+			return nil, nil, err
+		}
+		return res.Offers, res.Marker, nil
+	})
+}
@@ -0,0 +1,269 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	"github.com/stretchr/testify/require"
+)
+
+// newPagingCore returns a Core answering with pages, in order, and recording the
+// marker of every request it receives.
+func newPagingCore(pages []map[string]any, markers *[]any) *Core {
+	i := 0
+	return NewCore(func(_ context.Context, req Request) (Response, error) {
+		switch r := req.(type) {
+		case *account.LinesRequest:
+			*markers = append(*markers, r.Marker)
+		case *ledger.DataRequest:
+			*markers = append(*markers, r.Marker)
+		}
+		if i >= len(pages) {
+			return nil, errors.New("no more pages")
+		}
+		page := pages[i]
+		i++
+		if e, ok := page["error"].(string); ok {
+			return nil, &ClientError{ErrorString: e, Err: ErrorForCode(e)}
+		}
+		return &testResponse{result: page}, nil
+	}, Config{RetryDelay: time.Millisecond})
+}
+
+func trustLine(currency string) map[string]any {
+	return map[string]any{"account": "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", "currency": currency, "balance": "10"}
+}
+
+func collectLines(t *testing.T, c *Core, ctx context.Context, req *account.LinesRequest, opts ...PageOption) ([]string, error) {
+	t.Helper()
+	var currencies []string
+	for line, err := range c.AccountLines(ctx, req, opts...) {
+		if err != nil {
+			return currencies, err
+		}
+		currencies = append(currencies, line.Currency)
+	}
+	return currencies, nil
+}
+
+func TestCore_AccountLines(t *testing.T) {
+	pages := []map[string]any{
+		{"lines": []any{trustLine("USD"), trustLine("EUR")}, "marker": "m1"},
+		{"lines": []any{trustLine("GBP")}, "marker": map[string]any{"ledger": 10, "seq": 2}},
+		{"lines": []any{trustLine("JPY")}},
+	}
+
+	t.Run("follows markers", func(t *testing.T) {
+		var markers []any
+		c := newPagingCore(pages, &markers)
+		req := &account.LinesRequest{Account: "rGWrZyQqhTp9Xu7G5Pkayo7bXjH4k4QYpf", Limit: 2}
+
+		currencies, err := collectLines(t, c, context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, []string{"USD", "EUR", "GBP", "JPY"}, currencies)
+		require.Equal(t, []any{nil, "m1", map[string]any{"ledger": float64(10), "seq": float64(2)}}, markers)
+		require.Nil(t, req.Marker)
+	})
+
+	t.Run("resumes from the request marker", func(t *testing.T) {
+		var markers []any
+		c := newPagingCore(pages[2:], &markers)
+
+		currencies, err := collectLines(t, c, context.Background(), &account.LinesRequest{Marker: "m2"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"JPY"}, currencies)
+		require.Equal(t, []any{"m2"}, markers)
+	})
+
+	t.Run("max items", func(t *testing.T) {
+		var markers []any
+		c := newPagingCore(pages, &markers)
+
+		currencies, err := collectLines(t, c, context.Background(), &account.LinesRequest{}, WithMaxItems(3))
+		require.NoError(t, err)
+		require.Equal(t, []string{"USD", "EUR", "GBP"}, currencies)
+		require.Len(t, markers, 2)
+	})
+
+	t.Run("break stops requesting pages", func(t *testing.T) {
+		var markers []any
+		c := newPagingCore(pages, &markers)
+
+		for range c.AccountLines(context.Background(), &account.LinesRequest{}) {
+			break
+		}
+		require.Len(t, markers, 1)
+	})
+
+	t.Run("retries when rate limited", func(t *testing.T) {
+		var markers []any
+		c := newPagingCore([]map[string]any{
+			{"error": "slowDown"},
+			{"lines": []any{trustLine("USD")}},
+		}, &markers)
+
+		currencies, err := collectLines(t, c, context.Background(), &account.LinesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []string{"USD"}, currencies)
+		require.Len(t, markers, 2)
+	})
+
+	t.Run("gives up after the rate limit retries", func(t *testing.T) {
+		var markers []any
+		c := newPagingCore([]map[string]any{
+			{"error": "slowDown"},
+			{"error": "slowDown"},
+		}, &markers)
+
+		_, err := collectLines(t, c, context.Background(), &account.LinesRequest{}, WithRateLimitRetries(1))
+		require.EqualError(t, err, "slowDown")
+		require.Len(t, markers, 2)
+	})
+
+	t.Run("request error", func(t *testing.T) {
+		var markers []any
+		c := newPagingCore([]map[string]any{
+			pages[0],
+			{"error": "actNotFound"},
+		}, &markers)
+
+		currencies, err := collectLines(t, c, context.Background(), &account.LinesRequest{})
+		require.EqualError(t, err, "actNotFound")
+		require.Equal(t, []string{"USD", "EUR"}, currencies)
+	})
+
+	t.Run("context cancelled", func(t *testing.T) {
+		var markers []any
+		c := newPagingCore(pages, &markers)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var currencies []string
+		var err error
+		for line, lineErr := range c.AccountLines(ctx, &account.LinesRequest{}) {
+			if lineErr != nil {
+				err = lineErr
+				break
+			}
+			currencies = append(currencies, line.Currency)
+			cancel()
+		}
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, []string{"USD", "EUR"}, currencies)
+		require.Len(t, markers, 1)
+	})
+
+	t.Run("page delay", func(t *testing.T) {
+		var markers []any
+		c := newPagingCore(pages, &markers)
+
+		start := time.Now()
+		_, err := collectLines(t, c, context.Background(), &account.LinesRequest{}, WithPageDelay(10*time.Millisecond))
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	})
+}
+
+// newPinningCore returns a Core answering every request with a page read from
+// ledger 100, with a marker on the first one, and recording the ledger_index of
+// every request it receives.
+func newPinningCore(page map[string]any, ledgers *[]common.LedgerSpecifier) *Core {
+	i := 0
+	return NewCore(func(_ context.Context, req Request) (Response, error) {
+		switch r := req.(type) {
+		case *account.LinesRequest:
+			*ledgers = append(*ledgers, r.LedgerIndex)
+		case *account.ObjectsRequest:
+			*ledgers = append(*ledgers, r.LedgerIndex)
+		case *ledger.DataRequest:
+			*ledgers = append(*ledgers, r.LedgerIndex)
+		}
+		result := map[string]any{}
+		for k, v := range page {
+			result[k] = v
+		}
+		if i == 0 {
+			result["marker"] = "m1"
+		}
+		i++
+		return &testResponse{result: result}, nil
+	}, Config{})
+}
+
+func TestCore_PinsLedgerIndex(t *testing.T) {
+	tt := []struct {
+		name string
+		page map[string]any
+		run  func(c *Core) error
+	}{
+		{
+			name: "AccountLines",
+			page: map[string]any{"ledger_index": 100, "lines": []any{trustLine("USD")}},
+			run: func(c *Core) error {
+				_, err := collectLines(t, c, context.Background(), &account.LinesRequest{LedgerIndex: common.Validated})
+				return err
+			},
+		},
+		{
+			name: "AccountObjects",
+			page: map[string]any{"ledger_index": 100, "account_objects": []any{map[string]any{"LedgerEntryType": "Check"}}},
+			run: func(c *Core) error {
+				for _, err := range c.AccountObjects(context.Background(), &account.ObjectsRequest{LedgerIndex: common.Validated}) {
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+		{
+			name: "LedgerData",
+			page: map[string]any{"ledger_index": "100", "state": []any{map[string]any{"index": "A"}}},
+			run: func(c *Core) error {
+				for _, err := range c.LedgerData(context.Background(), &ledger.DataRequest{LedgerIndex: common.Validated}) {
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var ledgers []common.LedgerSpecifier
+			require.NoError(t, tc.run(newPinningCore(tc.page, &ledgers)))
+			require.Equal(t, []common.LedgerSpecifier{common.Validated, common.LedgerIndex(100)}, ledgers)
+		})
+	}
+
+	t.Run("keeps the ledger hash", func(t *testing.T) {
+		var ledgers []common.LedgerSpecifier
+		c := newPinningCore(map[string]any{"ledger_index": "100", "state": []any{}}, &ledgers)
+		for _, err := range c.LedgerData(context.Background(), &ledger.DataRequest{LedgerHash: "ABCD"}) {
+			require.NoError(t, err)
+		}
+		require.Equal(t, []common.LedgerSpecifier{nil, nil}, ledgers)
+	})
+}
+
+func TestCore_LedgerData(t *testing.T) {
+	var markers []any
+	c := newPagingCore([]map[string]any{
+		{"ledger_index": "100", "state": []any{map[string]any{"index": "A"}, map[string]any{"index": "B"}}, "marker": "m1"},
+		{"ledger_index": "100", "state": []any{map[string]any{"index": "C"}}},
+	}, &markers)
+
+	var indexes []string
+	for state, err := range c.LedgerData(context.Background(), &ledger.DataRequest{}) {
+		require.NoError(t, err)
+		indexes = append(indexes, state.Index)
+	}
+	require.Equal(t, []string{"A", "B", "C"}, indexes)
+	require.Equal(t, []any{nil, "m1"}, markers)
+}
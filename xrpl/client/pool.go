@@ -0,0 +1,314 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+)
+
+var (
+	ErrNoClioEndpoint     = errors.New("no Clio endpoint configured for a Clio-only method")
+	ErrAllEndpointsFailed = errors.New("all endpoints failed")
+	ErrEndpointLagging    = errors.New("endpoint is behind the other endpoints")
+	ErrEndpointNotSynced  = errors.New("endpoint is not synced with the network")
+)
+
+// EndpointKind is the server software behind a pool endpoint.
+type EndpointKind int
+
+const (
+	RippledEndpoint EndpointKind = iota
+	ClioEndpoint
+)
+
+func (k EndpointKind) String() string {
+	if k == ClioEndpoint {
+		return "clio"
+	}
+	return "rippled"
+}
+
+// Endpoint is a server the client can send requests to.
+type Endpoint struct {
+	URL  string
+	Kind EndpointKind
+}
+
+// EndpointStatus is the last known health of a pool endpoint.
+type EndpointStatus struct {
+	Endpoint
+	// Whether the endpoint receives requests before the unhealthy ones.
+	Healthy bool
+	// The latest validated ledger index reported by the endpoint.
+	LedgerIndex common.LedgerIndex
+	// The error that made the endpoint unhealthy, if any.
+	Err error
+	// When the endpoint was last checked.
+	CheckedAt time.Time
+
+	// When a request last marked the endpoint unhealthy.
+	failedAt time.Time
+}
+
+// clioOnlyMethods are the methods only Clio servers implement.
+var clioOnlyMethods = map[string]struct{}{
+	"nft_info":       {},
+	"nft_history":    {},
+	"nfts_by_issuer": {},
+}
+
+// IsClioOnlyMethod reports whether method is only implemented by Clio servers.
+func IsClioOnlyMethod(method string) bool {
+	_, ok := clioOnlyMethods[method]
+	return ok
+}
+
+// syncedServerStates are the rippled server states in which it follows the network.
+var syncedServerStates = map[string]struct{}{
+	"full":       {},
+	"proposing":  {},
+	"validating": {},
+}
+
+// ValidatedLedgerIndex returns the latest validated ledger of an endpoint from its
+// server_info response, or an error if the endpoint is not synced with the network.
+func ValidatedLedgerIndex(kind EndpointKind, res Response) (common.LedgerIndex, error) {
+	var info struct {
+		Info struct {
+			ServerState     string `json:"server_state"`
+			ValidatedLedger struct {
+				Seq uint32 `json:"seq"`
+			} `json:"validated_ledger"`
+		} `json:"info"`
+	}
+	if err := res.GetResult(&info); err != nil {
+		return 0, err
+	}
+	// Clio doesn't report a server state of its own.
+	if kind == RippledEndpoint {
+		if _, ok := syncedServerStates[info.Info.ServerState]; !ok {
+			return 0, fmt.Errorf("%w: server state %q", ErrEndpointNotSynced, info.Info.ServerState)
+		}
+	}
+	if info.Info.ValidatedLedger.Seq == 0 {
+		return 0, fmt.Errorf("%w: no validated ledger", ErrEndpointNotSynced)
+	}
+	return common.LedgerIndex(info.Info.ValidatedLedger.Seq), nil
+}
+
+// CheckFunc checks the health of an endpoint and returns its latest validated ledger.
+type CheckFunc func(ctx context.Context, e Endpoint) (common.LedgerIndex, error)
+
+// EndpointPool tracks the health of the endpoints of a client and picks the ones
+// each request is sent to. It is shared by the rpc and websocket clients, which
+// provide the check of a single endpoint. All methods are safe for concurrent use.
+type EndpointPool struct {
+	maxLedgerLag        uint32
+	healthCheckInterval time.Duration
+
+	mu        sync.Mutex
+	statuses  []EndpointStatus
+	checkedAt time.Time
+	// Rotates the healthy endpoints between requests
+	next int
+
+	// Serializes health checks. Periodic checks run in the background, so
+	// requests never wait for one.
+	checkMu sync.Mutex
+}
+
+// NewEndpointPool creates a pool of endpoints, all assumed healthy until checked.
+// An endpoint is unhealthy when it falls more than maxLedgerLag ledgers behind the
+// most advanced one. Checks run at most every healthCheckInterval, never if zero.
+func NewEndpointPool(endpoints []Endpoint, maxLedgerLag uint32, healthCheckInterval time.Duration) *EndpointPool {
+	statuses := make([]EndpointStatus, len(endpoints))
+	for i, e := range endpoints {
+		statuses[i] = EndpointStatus{Endpoint: e, Healthy: true}
+	}
+	return &EndpointPool{
+		maxLedgerLag:        maxLedgerLag,
+		healthCheckInterval: healthCheckInterval,
+		statuses:            statuses,
+	}
+}
+
+// Statuses returns a copy of the endpoint statuses.
+func (p *EndpointPool) Statuses() []EndpointStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	statuses := make([]EndpointStatus, len(p.statuses))
+	copy(statuses, p.statuses)
+	return statuses
+}
+
+// Status returns the status of the endpoint with the given url.
+func (p *EndpointPool) Status(url string) (EndpointStatus, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, s := range p.statuses {
+		if s.URL == url {
+			return s, true
+		}
+	}
+	return EndpointStatus{}, false
+}
+
+// HealthCheckInterval returns how often the endpoints are checked.
+func (p *EndpointPool) HealthCheckInterval() time.Duration {
+	return p.healthCheckInterval
+}
+
+// checkDue reports whether the endpoints should be checked again.
+func (p *EndpointPool) checkDue() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.healthCheckInterval > 0 && time.Since(p.checkedAt) >= p.healthCheckInterval
+}
+
+// Candidates returns the endpoints a request for method is tried on, in order.
+// Healthy endpoints come first, rotating on every request to balance the load,
+// followed by the unhealthy ones as a last resort.
+func (p *EndpointPool) Candidates(method string) ([]Endpoint, error) {
+	return p.candidates(method, true)
+}
+
+// Preferred returns the endpoints a connection for method is opened to, in order.
+// Healthy endpoints come first, in the order they were configured, followed by the
+// unhealthy ones as a last resort.
+func (p *EndpointPool) Preferred(method string) ([]Endpoint, error) {
+	return p.candidates(method, false)
+}
+
+func (p *EndpointPool) candidates(method string, rotate bool) ([]Endpoint, error) {
+	clioOnly := IsClioOnlyMethod(method)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var healthy, unhealthy []Endpoint
+	for _, s := range p.statuses {
+		if clioOnly && s.Kind != ClioEndpoint {
+			continue
+		}
+		if s.Healthy {
+			healthy = append(healthy, s.Endpoint)
+		} else {
+			unhealthy = append(unhealthy, s.Endpoint)
+		}
+	}
+	if clioOnly && len(healthy)+len(unhealthy) == 0 {
+		return nil, ErrNoClioEndpoint
+	}
+
+	candidates := make([]Endpoint, 0, len(healthy)+len(unhealthy))
+	if rotate && len(healthy) > 0 {
+		p.next++
+		start := p.next % len(healthy)
+		candidates = append(candidates, healthy[start:]...)
+		candidates = append(candidates, healthy[:start]...)
+	} else {
+		candidates = append(candidates, healthy...)
+	}
+	return append(candidates, unhealthy...), nil
+}
+
+// MarkUnhealthy records that a request to the endpoint failed with err.
+// The endpoint stays unhealthy until the next health check.
+func (p *EndpointPool) MarkUnhealthy(url string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i := range p.statuses {
+		if p.statuses[i].URL == url {
+			p.statuses[i].Healthy = false
+			p.statuses[i].Err = err
+			p.statuses[i].failedAt = time.Now()
+		}
+	}
+}
+
+// Check checks the health of every endpoint with check right away and returns
+// their statuses. Checks never run concurrently.
+func (p *EndpointPool) Check(ctx context.Context, check CheckFunc) []EndpointStatus {
+	p.checkMu.Lock()
+	defer p.checkMu.Unlock()
+
+	p.check(ctx, check)
+	return p.Statuses()
+}
+
+// CheckInBackground starts a health check of the endpoints if one is due and none
+// is running. The caller doesn't wait for it.
+func (p *EndpointPool) CheckInBackground(check CheckFunc) {
+	if !p.checkDue() || !p.checkMu.TryLock() {
+		return
+	}
+	go func() {
+		defer p.checkMu.Unlock()
+
+		// Re-check under the lock, another check may have just finished.
+		if p.checkDue() {
+			p.check(context.Background(), check)
+		}
+	}()
+}
+
+// check runs check on every endpoint concurrently and updates their statuses.
+func (p *EndpointPool) check(ctx context.Context, check CheckFunc) {
+	startedAt := time.Now()
+	statuses := p.Statuses()
+
+	var wg sync.WaitGroup
+	for i := range statuses {
+		wg.Add(1)
+		go func(s *EndpointStatus) {
+			defer wg.Done()
+			s.LedgerIndex, s.Err = check(ctx, s.Endpoint)
+			s.Healthy = s.Err == nil
+			s.CheckedAt = time.Now()
+		}(&statuses[i])
+	}
+	wg.Wait()
+
+	p.update(statuses, startedAt)
+}
+
+// update replaces the endpoint statuses with the result of a health check started
+// at startedAt, marking the endpoints that fell more than maxLedgerLag ledgers behind
+// as unhealthy. Endpoints a request marked unhealthy after the check started stay
+// unhealthy, since the check may have reached them before they failed.
+func (p *EndpointPool) update(statuses []EndpointStatus, startedAt time.Time) {
+	var latest common.LedgerIndex
+	for _, s := range statuses {
+		if s.Healthy && s.LedgerIndex > latest {
+			latest = s.LedgerIndex
+		}
+	}
+	for i := range statuses {
+		s := &statuses[i]
+		if s.Healthy && uint32(latest-s.LedgerIndex) > p.maxLedgerLag {
+			s.Healthy = false
+			s.Err = fmt.Errorf("%w: ledger %d, latest %d", ErrEndpointLagging, s.LedgerIndex, latest)
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i, current := range p.statuses {
+		if !current.Healthy && current.failedAt.After(startedAt) {
+			statuses[i].Healthy = false
+			statuses[i].Err = current.Err
+			statuses[i].failedAt = current.failedAt
+		}
+	}
+	p.statuses = statuses
+	p.checkedAt = time.Now()
+}
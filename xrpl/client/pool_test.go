@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/stretchr/testify/require"
+)
+
+var errEndpointDown = errors.New("endpoint down")
+
+func TestEndpointPool_Candidates(t *testing.T) {
+	p := NewEndpointPool([]Endpoint{
+		{URL: "a"},
+		{URL: "b"},
+		{URL: "c", Kind: ClioEndpoint},
+	}, 3, 0)
+	p.MarkUnhealthy("a", errEndpointDown)
+
+	preferred, err := p.Preferred("")
+	require.NoError(t, err)
+	require.Equal(t, []Endpoint{{URL: "b"}, {URL: "c", Kind: ClioEndpoint}, {URL: "a"}}, preferred)
+
+	candidates, err := p.Candidates("")
+	require.NoError(t, err)
+	require.Equal(t, []Endpoint{{URL: "c", Kind: ClioEndpoint}, {URL: "b"}, {URL: "a"}}, candidates)
+
+	clioOnly, err := p.Preferred("nft_info")
+	require.NoError(t, err)
+	require.Equal(t, []Endpoint{{URL: "c", Kind: ClioEndpoint}}, clioOnly)
+
+	_, err = NewEndpointPool([]Endpoint{{URL: "a"}}, 3, 0).Candidates("nfts_by_issuer")
+	require.ErrorIs(t, err, ErrNoClioEndpoint)
+}
+
+func TestEndpointPool_Check(t *testing.T) {
+	ledgers := map[string]common.LedgerIndex{"a": 100, "b": 96, "c": 103}
+	p := NewEndpointPool([]Endpoint{{URL: "a"}, {URL: "b"}, {URL: "c"}, {URL: "d"}}, 3, 0)
+
+	statuses := p.Check(context.Background(), func(_ context.Context, e Endpoint) (common.LedgerIndex, error) {
+		if index, ok := ledgers[e.URL]; ok {
+			return index, nil
+		}
+		return 0, errEndpointDown
+	})
+
+	require.True(t, statuses[0].Healthy)
+	require.False(t, statuses[1].Healthy)
+	require.ErrorIs(t, statuses[1].Err, ErrEndpointLagging)
+	require.True(t, statuses[2].Healthy)
+	require.False(t, statuses[3].Healthy)
+	require.ErrorIs(t, statuses[3].Err, errEndpointDown)
+}
+
+func TestEndpointPool_CheckKeepsFailuresDuringCheck(t *testing.T) {
+	p := NewEndpointPool([]Endpoint{{URL: "a"}, {URL: "b"}}, 3, 0)
+
+	statuses := p.Check(context.Background(), func(_ context.Context, e Endpoint) (common.LedgerIndex, error) {
+		// A request fails on the endpoint after the check started.
+		if e.URL == "a" {
+			p.MarkUnhealthy("a", errEndpointDown)
+		}
+		return 100, nil
+	})
+
+	require.False(t, statuses[0].Healthy)
+	require.ErrorIs(t, statuses[0].Err, errEndpointDown)
+	require.True(t, statuses[1].Healthy)
+
+	// The next check makes it healthy again.
+	statuses = p.Check(context.Background(), func(context.Context, Endpoint) (common.LedgerIndex, error) {
+		return 100, nil
+	})
+	require.True(t, statuses[0].Healthy)
+}
@@ -0,0 +1,261 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	commonconstants "github.com/Peersyst/xrpl-go/xrpl/common"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+// SubmitTxBlob sends a pre-signed transaction blob to the server.
+// It decodes the blob to confirm that it contains either a signature
+// or a signing public key, and then submits it using a submission request.
+// The failHard flag determines how strictly errors are handled.
+func (c *Core) SubmitTxBlob(txBlob string, failHard bool) (*requests.SubmitResponse, error) {
+	return c.SubmitTxBlobWithContext(context.Background(), txBlob, failHard)
+}
+
+// SubmitTxBlobWithContext is like SubmitTxBlob but uses ctx for cancellation and deadlines.
+func (c *Core) SubmitTxBlobWithContext(ctx context.Context, txBlob string, failHard bool) (*requests.SubmitResponse, error) {
+	tx, err := binarycodec.Decode(txBlob, c.codecOptions()...)
+	if err != nil {
+		return nil, err
+	}
+
+	_, okTxSig := tx["TxnSignature"].(string)
+	_, okPubKey := tx["SigningPubKey"].(string)
+
+	if !okTxSig && !okPubKey {
+		return nil, ErrMissingTxSignatureOrSigningPubKey
+	}
+
+	return c.submitRequest(ctx, &requests.SubmitRequest{
+		TxBlob:   txBlob,
+		FailHard: failHard,
+	})
+}
+
+// SubmitTxBlobAndWait sends a pre-signed transaction blob to the server,
+// decodes it to retrieve the required LastLedgerSequence, submits the blob,
+// and then waits until the transaction is confirmed in a ledger. It returns
+// the transaction response if the submission is successful.
+func (c *Core) SubmitTxBlobAndWait(txBlob string, failHard bool) (*requests.TxResponse, error) {
+	return c.SubmitTxBlobAndWaitWithContext(context.Background(), txBlob, failHard)
+}
+
+// SubmitTxBlobAndWaitWithContext is like SubmitTxBlobAndWait but uses ctx for cancellation and deadlines.
+func (c *Core) SubmitTxBlobAndWaitWithContext(ctx context.Context, txBlob string, failHard bool) (*requests.TxResponse, error) {
+	tx, err := binarycodec.Decode(txBlob, c.codecOptions()...)
+	if err != nil {
+		return nil, err
+	}
+
+	lastLedgerSequence, ok := tx["LastLedgerSequence"].(uint32)
+	if !ok {
+
+		return nil, ErrMissingLastLedgerSequenceInTransaction
+
+	}
+
+	txResponse, err := c.SubmitTxBlobWithContext(ctx, txBlob, failHard)
+	if err != nil {
+		return nil, err
+	}
+
+	if txResponse.EngineResult != "tesSUCCESS" {
+		return nil, &ClientError{
+			ErrorString: fmt.Sprintf("%s: %s", ErrTransactionFailedToSubmit, txResponse.EngineResult),
+			Err:         ErrTransactionFailedToSubmit,
+		}
+	}
+
+	txHash, err := hash.SignTxBlob(txBlob, c.codecOptions()...)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.waitForTransaction(ctx, txHash, lastLedgerSequence)
+}
+
+// SubmitTx signs the transaction (if necessary) and submits it to the server
+// via a submission request. It applies the provided submit options to decide whether
+// to autofill missing fields and enforce failHard mode during submission.
+func (c *Core) SubmitTx(tx transaction.FlatTransaction, opts *SubmitOptions) (*requests.SubmitResponse, error) {
+	return c.SubmitTxWithContext(context.Background(), tx, opts)
+}
+
+// SubmitTxWithContext is like SubmitTx but uses ctx for cancellation and deadlines.
+func (c *Core) SubmitTxWithContext(ctx context.Context, tx transaction.FlatTransaction, opts *SubmitOptions) (*requests.SubmitResponse, error) {
+	txBlob, err := c.getSignedTx(ctx, tx, opts.Autofill, opts.Wallet)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.submitRequest(ctx, &requests.SubmitRequest{
+		TxBlob:   txBlob,
+		FailHard: opts.FailHard,
+	})
+}
+
+// SubmitTxAndWait prepares a transaction by ensuring it is fully signed,
+// submits it to the server, and waits for ledger confirmation.
+// It validates that the transaction's EngineResult is successful before returning
+// the transaction response.
+func (c *Core) SubmitTxAndWait(tx transaction.FlatTransaction, opts *SubmitOptions) (*requests.TxResponse, error) {
+	return c.SubmitTxAndWaitWithContext(context.Background(), tx, opts)
+}
+
+// SubmitTxAndWaitWithContext is like SubmitTxAndWait but uses ctx for cancellation and deadlines.
+func (c *Core) SubmitTxAndWaitWithContext(ctx context.Context, tx transaction.FlatTransaction, opts *SubmitOptions) (*requests.TxResponse, error) {
+	// Get the signed transaction blob.
+	txBlob, err := c.getSignedTx(ctx, tx, opts.Autofill, opts.Wallet)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delegate to SubmitTxBlobAndWait to handle submission, engine result check,
+	// ledger sequence validation, and waiting for confirmation.
+	return c.SubmitTxBlobAndWaitWithContext(ctx, txBlob, opts.FailHard)
+}
+
+func (c *Core) SubmitMultisigned(txBlob string, failHard bool) (*requests.SubmitMultisignedResponse, error) {
+	return c.SubmitMultisignedWithContext(context.Background(), txBlob, failHard)
+}
+
+// SubmitMultisignedWithContext is like SubmitMultisigned but uses ctx for cancellation and deadlines.
+func (c *Core) SubmitMultisignedWithContext(ctx context.Context, txBlob string, failHard bool) (*requests.SubmitMultisignedResponse, error) {
+	tx, err := binarycodec.Decode(txBlob, c.codecOptions()...)
+	if err != nil {
+		return nil, err
+	}
+	signers, okSigners := tx["Signers"].([]interface{})
+
+	if okSigners && len(signers) > 0 {
+		for _, sig := range signers {
+			signer := sig.(map[string]any)
+			signerData := signer["Signer"].(map[string]any)
+			if signerData["SigningPubKey"] == "" && signerData["TxnSignature"] == "" {
+				return nil, ErrSignerDataIsEmpty
+			}
+		}
+	}
+
+	return c.submitMultisignedRequest(ctx, &requests.SubmitMultisignedRequest{
+		Tx:       tx,
+		FailHard: failHard,
+	})
+}
+
+func (c *Core) submitMultisignedRequest(ctx context.Context, req *requests.SubmitMultisignedRequest) (*requests.SubmitMultisignedResponse, error) {
+	res, err := c.request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var subRes requests.SubmitMultisignedResponse
+	err = res.GetResult(&subRes)
+	if err != nil {
+		return nil, err
+	}
+	return &subRes, nil
+}
+
+func (c *Core) submitRequest(ctx context.Context, req *requests.SubmitRequest) (*requests.SubmitResponse, error) {
+	res, err := c.request(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var subRes requests.SubmitResponse
+	err = res.GetResult(&subRes)
+	if err != nil {
+		return nil, err
+	}
+	return &subRes, nil
+}
+
+func (c *Core) waitForTransaction(ctx context.Context, txHash string, lastLedgerSequence uint32) (*requests.TxResponse, error) {
+	var txResponse *requests.TxResponse
+	i := 0
+
+	for i < c.cfg.MaxRetries {
+		// Get the current ledger index
+		currentLedger, err := c.getLedgerIndex(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Check if the transaction has been included in the current ledger
+		if currentLedger.Int() >= int(lastLedgerSequence) {
+			break
+		}
+
+		// Request the transaction from the server
+		res, err := c.request(ctx, &requests.TxRequest{
+			Transaction: txHash,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		err = res.GetResult(&txResponse)
+		if err != nil {
+			return nil, err
+		}
+
+		// Check if the transaction has been included in the current ledger
+		if txResponse.LedgerIndex.Int() >= int(lastLedgerSequence) {
+			break
+		}
+
+		// Wait for the retry delay before retrying
+		if err := commonconstants.SleepWithContext(ctx, c.cfg.RetryDelay); err != nil {
+			return nil, err
+		}
+		i++
+	}
+
+	if txResponse == nil {
+		return nil, ErrTransactionNotFound
+	}
+
+	return txResponse, nil
+}
+
+// getSignedTx ensures the transaction is fully signed and returns the transaction blob.
+// If the transaction is already signed, it encodes and returns it. Otherwise, it autofills (if enabled)
+// and signs the transaction using the provided wallet.
+func (c *Core) getSignedTx(ctx context.Context, tx transaction.FlatTransaction, autofill bool, wallet *wallet.Wallet) (string, error) {
+	// Check if the transaction is already signed: both fields must be non-empty.
+	sig, sigOk := tx["TxnSignature"].(string)
+	pubKey, pubKeyOk := tx["SigningPubKey"].(string)
+	if sigOk && sig != "" && pubKeyOk && pubKey != "" {
+		blob, err := binarycodec.Encode(tx, c.codecOptions()...)
+		if err != nil {
+			return "", err
+		}
+		return blob, nil
+	}
+
+	// If not signed, ensure a wallet is provided.
+	if wallet == nil {
+		return "", ErrMissingWallet
+	}
+
+	// Optionally autofill the transaction.
+	if autofill {
+		if err := c.AutofillWithContext(ctx, &tx); err != nil {
+			return "", err
+		}
+	}
+
+	// Sign the transaction.
+	txBlob, _, err := wallet.Sign(tx, c.codecOptions()...)
+	if err != nil {
+		return "", err
+	}
+	return txBlob, nil
+}
@@ -0,0 +1,12 @@
+package client
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+// SubmitOptions configures how SubmitTx and SubmitTxAndWait prepare a transaction.
+type SubmitOptions struct {
+	Autofill bool
+	Wallet   *wallet.Wallet
+	FailHard bool
+}
@@ -16,4 +16,8 @@ const (
 
 	// 5 seconds default timeout
 	DefaultTimeout = 5 * time.Second
+
+	// Endpoint pool constants
+	DefaultMaxLedgerLag        uint32 = 3
+	DefaultHealthCheckInterval        = 30 * time.Second
 )
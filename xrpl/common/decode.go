@@ -0,0 +1,26 @@
+package common
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/mitchellh/mapstructure"
+)
+
+// CurrencyAmountHookFunc returns a mapstructure decode hook that decodes the result
+// fields typed as types.CurrencyAmount into their concrete currency amount type.
+// The rpc and websocket clients use it to decode their responses.
+func CurrencyAmountHookFunc() mapstructure.DecodeHookFuncType {
+	amountType := reflect.TypeOf((*types.CurrencyAmount)(nil)).Elem()
+	return func(_ reflect.Type, to reflect.Type, data any) (any, error) {
+		if to != amountType || data == nil {
+			return data, nil
+		}
+		raw, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		return types.UnmarshalCurrencyAmount(raw)
+	}
+}
@@ -0,0 +1,36 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrencyAmountHookFunc(t *testing.T) {
+	type offer struct {
+		TakerGets types.CurrencyAmount `json:"TakerGets"`
+		TakerPays types.CurrencyAmount `json:"TakerPays"`
+		Sequence  uint32               `json:"Sequence"`
+	}
+
+	var actual offer
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		TagName:    "json",
+		Result:     &actual,
+		DecodeHook: CurrencyAmountHookFunc(),
+	})
+	require.NoError(t, err)
+	require.NoError(t, dec.Decode(map[string]any{
+		"TakerGets": "1000",
+		"TakerPays": map[string]any{"currency": "USD", "issuer": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", "value": "1"},
+		"Sequence":  7,
+	}))
+
+	require.Equal(t, offer{
+		TakerGets: types.XRPCurrencyAmount(1000),
+		TakerPays: types.IssuedCurrencyAmount{Currency: "USD", Issuer: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Value: "1"},
+		Sequence:  7,
+	}, actual)
+}
@@ -0,0 +1,19 @@
+package common
+
+import (
+	"context"
+	"time"
+)
+
+// SleepWithContext pauses for d, returning early with the context error if ctx is done first.
+func SleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,17 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSleepWithContext(t *testing.T) {
+	require.NoError(t, SleepWithContext(context.Background(), time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, SleepWithContext(ctx, time.Hour), context.Canceled)
+}
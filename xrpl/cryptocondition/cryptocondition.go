@@ -0,0 +1,226 @@
+// Package cryptocondition generates, parses and verifies the crypto-conditions used
+// by escrows. Only the PREIMAGE-SHA-256 type, the one supported by the XRP Ledger, is
+// implemented. Conditions and fulfillments are encoded with the DER rules of the
+// crypto-conditions specification (draft-thomas-crypto-conditions-04).
+package cryptocondition
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Type is the type of a crypto-condition.
+type Type uint8
+
+const (
+	// PreimageSha256 is the type of conditions fulfilled by the preimage of a SHA-256 hash.
+	PreimageSha256 Type = 0
+)
+
+const (
+	// MaxFulfillmentLength is the maximum length, in bytes, of an encoded fulfillment
+	// accepted by the XRP Ledger.
+	MaxFulfillmentLength = 256
+	// MaxPreimageLength is the maximum length, in bytes, of a preimage whose
+	// fulfillment fits in MaxFulfillmentLength.
+	MaxPreimageLength = MaxFulfillmentLength - 6
+
+	// PreimageLength is the length, in bytes, of the preimages returned by Generate.
+	PreimageLength = 32
+
+	fingerprintLength = sha256.Size
+)
+
+const (
+	// Context-specific tags of the choices and fields of the ASN.1 types.
+	tagPreimageSha256 byte = 0xA0
+	tagPreimage       byte = 0x80
+	tagFingerprint    byte = 0x80
+	tagCost           byte = 0x81
+)
+
+// Condition is the hash of a fulfillment, set on an escrow to lock it.
+type Condition struct {
+	Type Type
+	// The SHA-256 hash of the preimage.
+	Fingerprint []byte
+	// The length of the preimage.
+	Cost uint32
+}
+
+// Fulfillment unlocks an escrow locked with its condition.
+type Fulfillment struct {
+	Type     Type
+	Preimage []byte
+}
+
+// New returns the fulfillment of a preimage.
+func New(preimage []byte) (*Fulfillment, error) {
+	if len(preimage) > MaxPreimageLength {
+		return nil, fmt.Errorf("%w: %d bytes, the maximum is %d", ErrPreimageTooLong, len(preimage), MaxPreimageLength)
+	}
+	return &Fulfillment{Type: PreimageSha256, Preimage: bytes.Clone(preimage)}, nil
+}
+
+// Generate returns the fulfillment of a random preimage of PreimageLength bytes.
+func Generate() (*Fulfillment, error) {
+	preimage := make([]byte, PreimageLength)
+	if _, err := rand.Read(preimage); err != nil {
+		return nil, err
+	}
+	return New(preimage)
+}
+
+// Condition returns the condition the fulfillment satisfies.
+func (f *Fulfillment) Condition() *Condition {
+	fingerprint := sha256.Sum256(f.Preimage)
+	return &Condition{
+		Type:        f.Type,
+		Fingerprint: fingerprint[:],
+		Cost:        uint32(len(f.Preimage)),
+	}
+}
+
+// Satisfies reports whether the fulfillment satisfies the condition.
+func (f *Fulfillment) Satisfies(c *Condition) bool {
+	return f.Condition().Equal(c)
+}
+
+// Encode returns the fulfillment as an uppercase hex string, the format of the
+// Fulfillment field of EscrowFinish transactions.
+func (f *Fulfillment) Encode() string {
+	return encodeHex(derElement(tagPreimageSha256, derElement(tagPreimage, f.Preimage)))
+}
+
+// Encode returns the condition as an uppercase hex string, the format of the Condition
+// field of EscrowCreate and EscrowFinish transactions.
+func (c *Condition) Encode() string {
+	content := append(derElement(tagFingerprint, c.Fingerprint), derElement(tagCost, derUint(c.Cost))...)
+	return encodeHex(derElement(tagPreimageSha256, content))
+}
+
+// Equal reports whether both conditions are the same.
+func (c *Condition) Equal(other *Condition) bool {
+	return other != nil &&
+		c.Type == other.Type &&
+		c.Cost == other.Cost &&
+		bytes.Equal(c.Fingerprint, other.Fingerprint)
+}
+
+// ParseCondition decodes a hex encoded condition.
+func ParseCondition(s string) (*Condition, error) {
+	data, err := decodeHex(s)
+	if err != nil {
+		return nil, err
+	}
+	content, err := parseTypeElement(data)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint, rest, err := derReadElement(content, tagFingerprint)
+	if err != nil {
+		return nil, err
+	}
+	if len(fingerprint) != fingerprintLength {
+		return nil, fmt.Errorf("%w: fingerprint of %d bytes", ErrInvalidEncoding, len(fingerprint))
+	}
+	costBytes, rest, err := derReadElement(rest, tagCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("%w: unexpected fields after the cost", ErrInvalidEncoding)
+	}
+	cost, err := parseDerUint(costBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Condition{
+		Type:        PreimageSha256,
+		Fingerprint: fingerprint,
+		Cost:        cost,
+	}, nil
+}
+
+// ParseFulfillment decodes a hex encoded fulfillment.
+func ParseFulfillment(s string) (*Fulfillment, error) {
+	data, err := decodeHex(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > MaxFulfillmentLength {
+		return nil, fmt.Errorf("%w: fulfillment of %d bytes, the maximum is %d", ErrPreimageTooLong, len(data), MaxFulfillmentLength)
+	}
+	content, err := parseTypeElement(data)
+	if err != nil {
+		return nil, err
+	}
+
+	preimage, rest, err := derReadElement(content, tagPreimage)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("%w: unexpected fields after the preimage", ErrInvalidEncoding)
+	}
+
+	return &Fulfillment{Type: PreimageSha256, Preimage: preimage}, nil
+}
+
+// Verify checks that the hex encoded fulfillment satisfies the hex encoded condition.
+func Verify(condition, fulfillment string) error {
+	c, err := ParseCondition(condition)
+	if err != nil {
+		return fmt.Errorf("invalid condition: %w", err)
+	}
+	f, err := ParseFulfillment(fulfillment)
+	if err != nil {
+		return fmt.Errorf("invalid fulfillment: %w", err)
+	}
+	if !f.Satisfies(c) {
+		return ErrConditionMismatch
+	}
+	return nil
+}
+
+// parseTypeElement reads the single element of data, which must be a
+// PREIMAGE-SHA-256 condition or fulfillment, and returns its content.
+func parseTypeElement(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: empty input", ErrInvalidEncoding)
+	}
+	// Conditions and fulfillments are a CHOICE whose context-specific, constructed
+	// tag is the type.
+	if data[0]&0xE0 != 0xA0 {
+		return nil, fmt.Errorf("%w: unexpected tag %#02x", ErrInvalidEncoding, data[0])
+	}
+	if data[0] != tagPreimageSha256 {
+		return nil, fmt.Errorf("%w: type %d", ErrUnsupportedType, data[0]&0x1F)
+	}
+	content, rest, err := derReadElement(data, tagPreimageSha256)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("%w: %d trailing bytes", ErrInvalidEncoding, len(rest))
+	}
+	return content, nil
+}
+
+func decodeHex(s string) ([]byte, error) {
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidHex, err)
+	}
+	return data, nil
+}
+
+func encodeHex(data []byte) string {
+	return strings.ToUpper(hex.EncodeToString(data))
+}
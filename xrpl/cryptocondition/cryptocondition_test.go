@@ -0,0 +1,208 @@
+package cryptocondition
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	emptyCondition   = "A0258020E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855810100"
+	emptyFulfillment = "A0028000"
+	zeroCondition    = "A025802066687AADF862BD776C8FC18B8E9F8E20089714856EE233B3902A591D0D5F2925810120"
+)
+
+var (
+	zeroFulfillment = "A0228020" + strings.Repeat("00", 32)
+	longCondition   = "A02680206D9C54DEE5660C46886F32D80E57E9DD0FFA57EE0CD2A762B036D9C8E0C3A33A810200C8"
+	longFulfillment = "A081CB8081C8" + strings.Repeat("00", 200)
+)
+
+func TestNew(t *testing.T) {
+	tt := []struct {
+		name                string
+		preimage            []byte
+		expectedCondition   string
+		expectedFulfillment string
+		expectedErr         error
+	}{
+		{
+			name:                "pass - empty preimage",
+			preimage:            []byte{},
+			expectedCondition:   emptyCondition,
+			expectedFulfillment: emptyFulfillment,
+		},
+		{
+			name:                "pass - 32 byte preimage",
+			preimage:            make([]byte, 32),
+			expectedCondition:   zeroCondition,
+			expectedFulfillment: zeroFulfillment,
+		},
+		{
+			name:                "pass - long preimage",
+			preimage:            make([]byte, 200),
+			expectedCondition:   longCondition,
+			expectedFulfillment: longFulfillment,
+		},
+		{
+			name:                "pass - longest preimage",
+			preimage:            make([]byte, MaxPreimageLength),
+			expectedFulfillment: "A081FD8081FA" + strings.Repeat("00", MaxPreimageLength),
+		},
+		{
+			name:        "fail - preimage too long",
+			preimage:    make([]byte, MaxPreimageLength+1),
+			expectedErr: ErrPreimageTooLong,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := New(tc.preimage)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedFulfillment, f.Encode())
+			require.LessOrEqual(t, len(f.Encode())/2, MaxFulfillmentLength)
+			if tc.expectedCondition != "" {
+				require.Equal(t, tc.expectedCondition, f.Condition().Encode())
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	f, err := Generate()
+	require.NoError(t, err)
+	require.Len(t, f.Preimage, PreimageLength)
+	require.NoError(t, Verify(f.Condition().Encode(), f.Encode()))
+
+	other, err := Generate()
+	require.NoError(t, err)
+	require.False(t, bytes.Equal(f.Preimage, other.Preimage))
+}
+
+func TestParseCondition(t *testing.T) {
+	tt := []struct {
+		name        string
+		input       string
+		expectedErr error
+	}{
+		{name: "pass - empty preimage", input: emptyCondition},
+		{name: "pass - lowercase", input: strings.ToLower(zeroCondition)},
+		{name: "pass - two byte cost", input: longCondition},
+		{name: "fail - not hex", input: "A025XX", expectedErr: ErrInvalidHex},
+		{name: "fail - empty", input: "", expectedErr: ErrInvalidEncoding},
+		{name: "fail - truncated", input: emptyCondition[:len(emptyCondition)-2], expectedErr: ErrInvalidEncoding},
+		{name: "fail - trailing bytes", input: emptyCondition + "00", expectedErr: ErrInvalidEncoding},
+		{name: "fail - not a condition", input: "3000", expectedErr: ErrInvalidEncoding},
+		{
+			name:        "fail - prefix type",
+			input:       "A12B8020" + strings.Repeat("00", 32) + "810101820203C0",
+			expectedErr: ErrUnsupportedType,
+		},
+		{
+			name:        "fail - short fingerprint",
+			input:       "A0058001008100",
+			expectedErr: ErrInvalidEncoding,
+		},
+		{
+			name:        "fail - cost not minimal",
+			input:       "A0268020" + strings.Repeat("00", 32) + "81020001",
+			expectedErr: ErrInvalidEncoding,
+		},
+		{
+			name:        "fail - negative cost",
+			input:       "A0258020" + strings.Repeat("00", 32) + "810180",
+			expectedErr: ErrInvalidEncoding,
+		},
+		{
+			name:        "fail - length not minimal",
+			input:       "A08125" + emptyCondition[4:],
+			expectedErr: ErrInvalidEncoding,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := ParseCondition(tc.input)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, PreimageSha256, c.Type)
+			require.Equal(t, strings.ToUpper(tc.input), c.Encode())
+		})
+	}
+}
+
+func TestParseFulfillment(t *testing.T) {
+	tt := []struct {
+		name        string
+		input       string
+		expected    []byte
+		expectedErr error
+	}{
+		{name: "pass - empty preimage", input: emptyFulfillment, expected: []byte{}},
+		{name: "pass - 32 byte preimage", input: zeroFulfillment, expected: make([]byte, 32)},
+		{name: "pass - long preimage", input: longFulfillment, expected: make([]byte, 200)},
+		{name: "fail - condition", input: emptyCondition, expectedErr: ErrInvalidEncoding},
+		{name: "fail - trailing fields", input: "A00480008000", expectedErr: ErrInvalidEncoding},
+		{name: "fail - truncated preimage", input: "A0028001", expectedErr: ErrInvalidEncoding},
+		{
+			name:        "fail - ed25519 type",
+			input:       "A4038000",
+			expectedErr: ErrUnsupportedType,
+		},
+		{
+			name:        "fail - too long",
+			input:       "A08200FF8081FC" + strings.Repeat("00", 252),
+			expectedErr: ErrPreimageTooLong,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := ParseFulfillment(tc.input)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, f.Preimage)
+			require.Equal(t, tc.input, f.Encode())
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	tt := []struct {
+		name        string
+		condition   string
+		fulfillment string
+		expectedErr error
+	}{
+		{name: "pass - empty preimage", condition: emptyCondition, fulfillment: emptyFulfillment},
+		{name: "pass - 32 byte preimage", condition: zeroCondition, fulfillment: zeroFulfillment},
+		{name: "pass - long preimage", condition: longCondition, fulfillment: longFulfillment},
+		{name: "fail - mismatch", condition: zeroCondition, fulfillment: emptyFulfillment, expectedErr: ErrConditionMismatch},
+		{name: "fail - invalid condition", condition: "A0", fulfillment: emptyFulfillment, expectedErr: ErrInvalidEncoding},
+		{name: "fail - invalid fulfillment", condition: emptyCondition, fulfillment: "zz", expectedErr: ErrInvalidHex},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Verify(tc.condition, tc.fulfillment)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package cryptocondition
+
+import "fmt"
+
+// derElement encodes a DER element with the given tag and content.
+func derElement(tag byte, content []byte) []byte {
+	out := []byte{tag}
+	switch n := len(content); {
+	case n < 0x80:
+		out = append(out, byte(n))
+	case n <= 0xFF:
+		out = append(out, 0x81, byte(n))
+	default:
+		out = append(out, 0x82, byte(n>>8), byte(n))
+	}
+	return append(out, content...)
+}
+
+// derReadElement reads a DER element with the given tag from the start of data and
+// returns its content and the bytes after it.
+func derReadElement(data []byte, tag byte) ([]byte, []byte, error) {
+	if len(data) < 2 {
+		return nil, nil, fmt.Errorf("%w: truncated element", ErrInvalidEncoding)
+	}
+	if data[0] != tag {
+		return nil, nil, fmt.Errorf("%w: expected tag %#02x, got %#02x", ErrInvalidEncoding, tag, data[0])
+	}
+
+	length, offset := int(data[1]), 2
+	if length >= 0x80 {
+		// Long form: the low bits give the number of length bytes, which must be
+		// needed and have no leading zeros.
+		n := length & 0x7F
+		if n == 0 || n > 2 || len(data) < 2+n || data[2] == 0 {
+			return nil, nil, fmt.Errorf("%w: invalid length", ErrInvalidEncoding)
+		}
+		length = 0
+		for _, b := range data[2 : 2+n] {
+			length = length<<8 | int(b)
+		}
+		if length < 0x80 {
+			return nil, nil, fmt.Errorf("%w: length is not minimally encoded", ErrInvalidEncoding)
+		}
+		offset += n
+	}
+
+	if len(data)-offset < length {
+		return nil, nil, fmt.Errorf("%w: truncated element", ErrInvalidEncoding)
+	}
+	return data[offset : offset+length], data[offset+length:], nil
+}
+
+// derUint returns the content of a DER INTEGER holding v.
+func derUint(v uint32) []byte {
+	out := []byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}
+	// Drop the leading zero bytes, keeping one if the next byte would make the
+	// integer negative.
+	for len(out) > 1 && out[0] == 0 && out[1]&0x80 == 0 {
+		out = out[1:]
+	}
+	return out
+}
+
+// parseDerUint decodes the content of a DER INTEGER holding an unsigned 32-bit value.
+func parseDerUint(content []byte) (uint32, error) {
+	if len(content) == 0 || len(content) > 5 || content[0]&0x80 != 0 {
+		return 0, fmt.Errorf("%w: invalid cost", ErrInvalidEncoding)
+	}
+	if len(content) > 1 && content[0] == 0 && content[1]&0x80 == 0 {
+		return 0, fmt.Errorf("%w: cost is not minimally encoded", ErrInvalidEncoding)
+	}
+	if len(content) == 5 && content[0] != 0 {
+		return 0, fmt.Errorf("%w: cost overflows 32 bits", ErrInvalidEncoding)
+	}
+	var v uint32
+	for _, b := range content {
+		v = v<<8 | uint32(b)
+	}
+	return v, nil
+}
@@ -0,0 +1,16 @@
+package cryptocondition
+
+import "errors"
+
+var (
+	// ErrInvalidHex is returned when a condition or fulfillment is not a hex string.
+	ErrInvalidHex = errors.New("not a valid hex string")
+	// ErrInvalidEncoding is returned when a condition or fulfillment is not valid DER.
+	ErrInvalidEncoding = errors.New("invalid DER encoding")
+	// ErrUnsupportedType is returned for condition types other than PREIMAGE-SHA-256.
+	ErrUnsupportedType = errors.New("unsupported crypto-condition type")
+	// ErrPreimageTooLong is returned when a preimage does not fit in a fulfillment.
+	ErrPreimageTooLong = errors.New("preimage is too long")
+	// ErrConditionMismatch is returned when a fulfillment does not satisfy a condition.
+	ErrConditionMismatch = errors.New("fulfillment does not satisfy the condition")
+)
@@ -0,0 +1,368 @@
+package dex
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+const (
+	// Issued currency amounts are normalized to a 16-digit mantissa.
+	minMantissa uint64 = 1_000_000_000_000_000
+	maxMantissa uint64 = 9_999_999_999_999_999
+	minExponent        = -96
+	maxExponent        = 80
+	// The exponent rippled gives to a zero issued currency amount.
+	zeroExponent = -100
+
+	// The largest XRP amount, in drops.
+	maxDrops uint64 = 100_000_000_000_000_000
+)
+
+// Issue is the currency of an amount: XRP, or a currency code and its issuer.
+type Issue struct {
+	Currency string
+	Issuer   types.Address
+}
+
+// XRP is the issue of XRP amounts.
+var XRP = Issue{Currency: "XRP"}
+
+// noIssue is the issue of qualities and transfer rates, which are ratios rather
+// than amounts of a currency but are computed as issued currency amounts.
+var noIssue = Issue{}
+
+// IsXRP reports whether the issue is XRP.
+func (i Issue) IsXRP() bool {
+	return i == XRP
+}
+
+// String returns the currency code, followed by the issuer for issued currencies.
+func (i Issue) String() string {
+	if i.IsXRP() || i.Issuer == "" {
+		return i.Currency
+	}
+	return i.Currency + "/" + i.Issuer.String()
+}
+
+// Amount is an amount of XRP or of an issued currency with the precision and
+// rounding of rippled's STAmount. XRP amounts are whole drops. Issued currency
+// amounts have a 16-digit mantissa and an exponent between -96 and 80.
+type Amount struct {
+	issue    Issue
+	mantissa uint64
+	exponent int
+	negative bool
+}
+
+// NewAmount converts a currency amount into an Amount. Issued currency values
+// with more than 16 significant digits are truncated, as rippled does. MPT
+// amounts are not supported.
+func NewAmount(amount types.CurrencyAmount) (Amount, error) {
+	switch a := amount.(type) {
+	case types.XRPCurrencyAmount:
+		if uint64(a) > maxDrops {
+			return Amount{}, fmt.Errorf("%w: %d drops", ErrAmountOverflow, uint64(a))
+		}
+		return Amount{issue: XRP, mantissa: uint64(a)}, nil
+	case types.IssuedCurrencyAmount:
+		if a.Currency == "" || a.Issuer == "" {
+			return Amount{}, ErrInvalidAmount
+		}
+		return parseIssued(Issue{Currency: a.Currency, Issuer: a.Issuer}, a.Value)
+	case nil:
+		return Amount{}, ErrInvalidAmount
+	default:
+		return Amount{}, fmt.Errorf("%w: %T", ErrUnsupportedAmount, amount)
+	}
+}
+
+// parseIssued parses the decimal value of an issued currency amount.
+func parseIssued(issue Issue, value string) (Amount, error) {
+	s := value
+	negative := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+
+	exponent := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return Amount{}, fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+		}
+		exponent = e
+		s = s[:i]
+	}
+
+	integer, fraction, hasPoint := strings.Cut(s, ".")
+	if integer == "" || (hasPoint && fraction == "") || !isDigits(integer) || !isDigits(fraction) {
+		return Amount{}, fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+	}
+	exponent -= len(fraction)
+
+	digits := strings.TrimLeft(integer+fraction, "0")
+	// Digits beyond what fits in the mantissa are dropped, which truncates the
+	// value like canonicalization would.
+	for len(digits) > 19 {
+		digits = digits[:len(digits)-1]
+		exponent++
+	}
+	var mantissa uint64
+	if digits != "" {
+		mantissa, _ = strconv.ParseUint(digits, 10, 64)
+	}
+	return newAmount(issue, mantissa, exponent, negative)
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// newAmount returns the canonical amount of mantissa×10^exponent. XRP amounts are
+// truncated to whole drops and issued currency amounts to 16 digits. Issued
+// currency amounts too small to be represented are zero.
+func newAmount(issue Issue, mantissa uint64, exponent int, negative bool) (Amount, error) {
+	if mantissa == 0 {
+		return zero(issue), nil
+	}
+
+	if issue.IsXRP() {
+		for exponent < 0 {
+			mantissa /= 10
+			exponent++
+		}
+		for exponent > 0 {
+			if mantissa > maxDrops {
+				return Amount{}, ErrAmountOverflow
+			}
+			mantissa *= 10
+			exponent--
+		}
+		if mantissa > maxDrops {
+			return Amount{}, ErrAmountOverflow
+		}
+		if mantissa == 0 {
+			return zero(issue), nil
+		}
+		return Amount{issue: issue, mantissa: mantissa, negative: negative}, nil
+	}
+
+	for mantissa < minMantissa && exponent > minExponent {
+		mantissa *= 10
+		exponent--
+	}
+	for mantissa > maxMantissa {
+		if exponent >= maxExponent {
+			return Amount{}, ErrAmountOverflow
+		}
+		mantissa /= 10
+		exponent++
+	}
+	if exponent < minExponent || mantissa < minMantissa {
+		return zero(issue), nil
+	}
+	if exponent > maxExponent {
+		return Amount{}, ErrAmountOverflow
+	}
+	return Amount{issue: issue, mantissa: mantissa, exponent: exponent, negative: negative}, nil
+}
+
+// zero returns the zero amount of an issue.
+func zero(issue Issue) Amount {
+	if issue.IsXRP() {
+		return Amount{issue: issue}
+	}
+	return Amount{issue: issue, exponent: zeroExponent}
+}
+
+// Issue returns the currency of the amount.
+func (a Amount) Issue() Issue {
+	return a.issue
+}
+
+// IsXRP reports whether the amount is an amount of XRP.
+func (a Amount) IsXRP() bool {
+	return a.issue.IsXRP()
+}
+
+// IsZero reports whether the amount is zero.
+func (a Amount) IsZero() bool {
+	return a.mantissa == 0
+}
+
+// Sign returns -1, 0 or 1 depending on whether the amount is negative, zero or
+// positive.
+func (a Amount) Sign() int {
+	switch {
+	case a.mantissa == 0:
+		return 0
+	case a.negative:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Cmp compares the values of two amounts of the same kind and returns -1, 0 or 1
+// depending on whether a is less than, equal to or greater than b.
+func (a Amount) Cmp(b Amount) int {
+	if sa, sb := a.Sign(), b.Sign(); sa != sb || sa == 0 {
+		return compare(sa, sb)
+	}
+	c := compare(a.exponent, b.exponent)
+	if c == 0 {
+		c = compare(a.mantissa, b.mantissa)
+	}
+	if a.negative {
+		return -c
+	}
+	return c
+}
+
+func compare[T int | uint64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Neg returns the amount with its sign flipped.
+func (a Amount) Neg() Amount {
+	if a.mantissa != 0 {
+		a.negative = !a.negative
+	}
+	return a
+}
+
+// Add returns a+b. Both amounts must have the same issue. Issued currency amounts
+// are aligned to the larger exponent before adding, truncating the smaller one, as
+// rippled does.
+func (a Amount) Add(b Amount) (Amount, error) {
+	if a.issue != b.issue {
+		return Amount{}, fmt.Errorf("%w: %s and %s", ErrIssueMismatch, a.issue, b.issue)
+	}
+	if b.IsZero() {
+		return a, nil
+	}
+	if a.IsZero() {
+		return b, nil
+	}
+
+	if a.IsXRP() {
+		sum := a.signed() + b.signed()
+		if sum < 0 {
+			return newAmount(a.issue, uint64(-sum), 0, true)
+		}
+		return newAmount(a.issue, uint64(sum), 0, false)
+	}
+
+	v1, e1 := a.signed(), a.exponent
+	v2, e2 := b.signed(), b.exponent
+	for e1 < e2 {
+		v1 /= 10
+		e1++
+	}
+	for e2 < e1 {
+		v2 /= 10
+		e2++
+	}
+	sum := v1 + v2
+	if sum >= -10 && sum <= 10 {
+		return zero(a.issue), nil
+	}
+	if sum < 0 {
+		return newAmount(a.issue, uint64(-sum), e1, true)
+	}
+	return newAmount(a.issue, uint64(sum), e1, false)
+}
+
+// Sub returns a-b. Both amounts must have the same issue.
+func (a Amount) Sub(b Amount) (Amount, error) {
+	return a.Add(b.Neg())
+}
+
+func (a Amount) signed() int64 {
+	if a.negative {
+		return -int64(a.mantissa)
+	}
+	return int64(a.mantissa)
+}
+
+// normalized returns the mantissa and exponent of the amount scaled so that the
+// mantissa has 16 digits, the form rippled multiplies and divides XRP amounts in.
+func (a Amount) normalized() (uint64, int) {
+	mantissa, exponent := a.mantissa, a.exponent
+	if a.IsXRP() && mantissa != 0 {
+		for mantissa < minMantissa {
+			mantissa *= 10
+			exponent--
+		}
+	}
+	return mantissa, exponent
+}
+
+// String returns the value of the amount the way rippled writes it: drops for XRP
+// amounts, and a decimal or scientific notation for issued currency amounts.
+func (a Amount) String() string {
+	sign := ""
+	if a.negative {
+		sign = "-"
+	}
+	if a.IsXRP() {
+		return sign + strconv.FormatUint(a.mantissa, 10)
+	}
+	if a.mantissa == 0 {
+		return "0"
+	}
+
+	digits := strconv.FormatUint(a.mantissa, 10)
+	if a.exponent != 0 && (a.exponent < -25 || a.exponent > -5) {
+		return sign + digits + "e" + strconv.Itoa(a.exponent)
+	}
+	if a.exponent == 0 {
+		return sign + digits
+	}
+
+	// -25 <= exponent <= -5, so the value has a fractional part.
+	point := len(digits) + a.exponent
+	var integer, fraction string
+	if point > 0 {
+		integer, fraction = digits[:point], digits[point:]
+	} else {
+		integer, fraction = "0", strings.Repeat("0", -point)+digits
+	}
+	fraction = strings.TrimRight(fraction, "0")
+	if fraction == "" {
+		return sign + integer
+	}
+	return sign + integer + "." + fraction
+}
+
+// CurrencyAmount converts the amount into a currency amount. Negative XRP
+// amounts, which XRPCurrencyAmount cannot hold, convert to zero.
+func (a Amount) CurrencyAmount() types.CurrencyAmount {
+	if a.IsXRP() {
+		if a.negative {
+			return types.XRPCurrencyAmount(0)
+		}
+		return types.XRPCurrencyAmount(a.mantissa)
+	}
+	return types.IssuedCurrencyAmount{
+		Currency: a.issue.Currency,
+		Issuer:   a.issue.Issuer,
+		Value:    a.String(),
+	}
+}
@@ -0,0 +1,123 @@
+package dex
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testIssuer types.Address = "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B"
+
+func usd(value string) types.IssuedCurrencyAmount {
+	return types.IssuedCurrencyAmount{Currency: "USD", Issuer: testIssuer, Value: value}
+}
+
+func eur(value string) types.IssuedCurrencyAmount {
+	return types.IssuedCurrencyAmount{Currency: "EUR", Issuer: testIssuer, Value: value}
+}
+
+func mustAmount(t *testing.T, amount types.CurrencyAmount) Amount {
+	t.Helper()
+	a, err := NewAmount(amount)
+	require.NoError(t, err)
+	return a
+}
+
+func TestNewAmount(t *testing.T) {
+	tt := []struct {
+		name        string
+		input       types.CurrencyAmount
+		expected    string
+		expectedErr error
+	}{
+		{name: "pass - xrp", input: types.XRPCurrencyAmount(1000000), expected: "1000000"},
+		{name: "pass - decimal", input: usd("27.05340557506234"), expected: "27.05340557506234"},
+		{name: "pass - integer", input: usd("123000"), expected: "123000"},
+		{name: "pass - negative", input: usd("-1.5"), expected: "-1.5"},
+		{name: "pass - trailing zeros", input: usd("+000.2500"), expected: "0.25"},
+		{name: "pass - zero", input: usd("0.00"), expected: "0"},
+		{name: "pass - small", input: usd("0.0000001"), expected: "0.0000001"},
+		{name: "pass - very small", input: usd("1e-20"), expected: "1000000000000000e-35"},
+		{name: "pass - large", input: usd("1E12"), expected: "1000000000000000e-3"},
+		{name: "pass - truncated to 16 digits", input: usd("1.23456789012345678"), expected: "1.234567890123456"},
+		{name: "pass - underflow", input: usd("1e-200"), expected: "0"},
+		{name: "fail - overflow", input: usd("1e200"), expectedErr: ErrAmountOverflow},
+		{name: "fail - xrp overflow", input: types.XRPCurrencyAmount(100_000_000_000_000_001), expectedErr: ErrAmountOverflow},
+		{name: "fail - not a number", input: usd("abc"), expectedErr: ErrInvalidAmount},
+		{name: "fail - missing fraction", input: usd("1."), expectedErr: ErrInvalidAmount},
+		{name: "fail - missing issuer", input: types.IssuedCurrencyAmount{Currency: "USD", Value: "1"}, expectedErr: ErrInvalidAmount},
+		{name: "fail - nil", input: nil, expectedErr: ErrInvalidAmount},
+		{name: "fail - mpt", input: types.MPTCurrencyAmount{MPTIssuanceID: "00", Value: "1"}, expectedErr: ErrUnsupportedAmount},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			a, err := NewAmount(tc.input)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, a.String())
+		})
+	}
+}
+
+func TestAmount_CurrencyAmount(t *testing.T) {
+	require.Equal(t, types.XRPCurrencyAmount(25), mustAmount(t, types.XRPCurrencyAmount(25)).CurrencyAmount())
+	require.Equal(t, usd("0.25"), mustAmount(t, usd("0.2500")).CurrencyAmount())
+}
+
+func TestAmount_Cmp(t *testing.T) {
+	tt := []struct {
+		name     string
+		a, b     types.CurrencyAmount
+		expected int
+	}{
+		{name: "equal", a: usd("1.5"), b: usd("1.50"), expected: 0},
+		{name: "less", a: usd("0.5"), b: usd("1"), expected: -1},
+		{name: "greater", a: usd("10"), b: usd("9.99"), expected: 1},
+		{name: "zero", a: usd("0"), b: usd("0.0000001"), expected: -1},
+		{name: "negative", a: usd("-2"), b: usd("-1"), expected: -1},
+		{name: "xrp", a: types.XRPCurrencyAmount(10), b: types.XRPCurrencyAmount(9), expected: 1},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, mustAmount(t, tc.a).Cmp(mustAmount(t, tc.b)))
+		})
+	}
+}
+
+func TestAmount_Add(t *testing.T) {
+	tt := []struct {
+		name        string
+		a, b        types.CurrencyAmount
+		expected    string
+		expectedErr error
+	}{
+		{name: "pass - issued", a: usd("30"), b: usd("35.48387096774194"), expected: "65.48387096774194"},
+		{name: "pass - truncates the smaller exponent", a: usd("1000"), b: usd("0.0000000000001"), expected: "1000"},
+		{name: "pass - to zero", a: usd("1"), b: usd("-1"), expected: "0"},
+		{name: "pass - negative", a: usd("1"), b: usd("-3"), expected: "-2"},
+		{name: "pass - xrp", a: types.XRPCurrencyAmount(10), b: types.XRPCurrencyAmount(5), expected: "15"},
+		{name: "fail - different issues", a: usd("1"), b: eur("1"), expectedErr: ErrIssueMismatch},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			sum, err := mustAmount(t, tc.a).Add(mustAmount(t, tc.b))
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, sum.String())
+		})
+	}
+
+	diff, err := mustAmount(t, types.XRPCurrencyAmount(10)).Sub(mustAmount(t, types.XRPCurrencyAmount(15)))
+	require.NoError(t, err)
+	require.Equal(t, "-5", diff.String())
+}
@@ -0,0 +1,195 @@
+package dex
+
+import (
+	"math/bits"
+)
+
+// The arithmetic below follows rippled's STAmount multiply, divide, mulRound and
+// divRound, so that amounts computed offline match the ones the ledger computes
+// to the last digit.
+
+const (
+	tenTo14         uint64 = 100_000_000_000_000
+	tenTo14MinusOne uint64 = tenTo14 - 1
+	tenTo17         uint64 = 100_000_000_000_000_000
+)
+
+// mulDiv returns (a*b+rounding)/c computed with 128-bit intermediates.
+func mulDiv(a, b, c, rounding uint64) (uint64, error) {
+	hi, lo := bits.Mul64(a, b)
+	var carry uint64
+	lo, carry = bits.Add64(lo, rounding, 0)
+	hi += carry
+	if hi >= c {
+		return 0, ErrAmountOverflow
+	}
+	q, _ := bits.Div64(hi, lo, c)
+	return q, nil
+}
+
+// multiplyXRP multiplies two XRP amounts into an XRP amount.
+func multiplyXRP(a, b Amount) (Amount, error) {
+	hi, lo := bits.Mul64(a.mantissa, b.mantissa)
+	if hi != 0 || lo > maxDrops {
+		return Amount{}, ErrAmountOverflow
+	}
+	return newAmount(XRP, lo, 0, a.negative != b.negative)
+}
+
+// multiply returns a*b in the given issue. Like rippled's multiply, it adds a
+// rounding term below the 16th digit and then truncates.
+func multiply(a, b Amount, issue Issue) (Amount, error) {
+	if a.IsZero() || b.IsZero() {
+		return zero(issue), nil
+	}
+	if a.IsXRP() && b.IsXRP() && issue.IsXRP() {
+		return multiplyXRP(a, b)
+	}
+
+	m1, e1 := a.normalized()
+	m2, e2 := b.normalized()
+	// Both mantissas are between 10^15 and 10^16, so dividing their product by
+	// 10^14 keeps it between 10^16 and 10^18.
+	m, err := mulDiv(m1, m2, tenTo14, 0)
+	if err != nil {
+		return Amount{}, err
+	}
+	return newAmount(issue, m+7, e1+e2+14, a.negative != b.negative)
+}
+
+// divide returns a/b in the given issue. Like rippled's divide, it adds a
+// rounding term below the 16th digit and then truncates.
+func divide(a, b Amount, issue Issue) (Amount, error) {
+	if b.IsZero() {
+		return Amount{}, ErrDivisionByZero
+	}
+	if a.IsZero() {
+		return zero(issue), nil
+	}
+
+	m1, e1 := a.normalized()
+	m2, e2 := b.normalized()
+	// Multiplying the numerator by 10^17 keeps the quotient between 10^16 and
+	// 10^18.
+	m, err := mulDiv(m1, tenTo17, m2, 0)
+	if err != nil {
+		return Amount{}, err
+	}
+	return newAmount(issue, m+5, e1-e2-17, a.negative != b.negative)
+}
+
+// mulRound returns a*b in the given issue, rounded away from zero if roundUp is
+// set and towards zero otherwise. A positive result rounded up is never zero.
+func mulRound(a, b Amount, issue Issue, roundUp bool) (Amount, error) {
+	if a.IsZero() || b.IsZero() {
+		return zero(issue), nil
+	}
+	if a.IsXRP() && b.IsXRP() && issue.IsXRP() {
+		return multiplyXRP(a, b)
+	}
+
+	m1, e1 := a.normalized()
+	m2, e2 := b.normalized()
+	negative := a.negative != b.negative
+
+	var rounding uint64
+	if negative != roundUp {
+		rounding = tenTo14MinusOne
+	}
+	m, err := mulDiv(m1, m2, tenTo14, rounding)
+	if err != nil {
+		return Amount{}, err
+	}
+	e := e1 + e2 + 14
+	if negative != roundUp {
+		m, e = canonicalizeRound(issue.IsXRP(), m, e)
+	}
+	return roundedResult(issue, m, e, negative, roundUp)
+}
+
+// divRound returns a/b in the given issue, rounded away from zero if roundUp is
+// set and towards zero otherwise. A positive result rounded up is never zero.
+func divRound(a, b Amount, issue Issue, roundUp bool) (Amount, error) {
+	if b.IsZero() {
+		return Amount{}, ErrDivisionByZero
+	}
+	if a.IsZero() {
+		return zero(issue), nil
+	}
+
+	m1, e1 := a.normalized()
+	m2, e2 := b.normalized()
+	negative := a.negative != b.negative
+
+	var rounding uint64
+	if negative != roundUp {
+		rounding = m2 - 1
+	}
+	m, err := mulDiv(m1, tenTo17, m2, rounding)
+	if err != nil {
+		return Amount{}, err
+	}
+	e := e1 - e2 - 17
+	if negative != roundUp {
+		m, e = canonicalizeRound(issue.IsXRP(), m, e)
+	}
+	return roundedResult(issue, m, e, negative, roundUp)
+}
+
+// roundedResult canonicalizes the result of mulRound or divRound, replacing a
+// positive result rounded up to zero with the smallest positive amount.
+func roundedResult(issue Issue, mantissa uint64, exponent int, negative, roundUp bool) (Amount, error) {
+	result, err := newAmount(issue, mantissa, exponent, negative)
+	if err != nil {
+		return Amount{}, err
+	}
+	if roundUp && !negative && result.IsZero() {
+		if issue.IsXRP() {
+			return newAmount(issue, 1, 0, false)
+		}
+		return newAmount(issue, minMantissa, minExponent, false)
+	}
+	return result, nil
+}
+
+// canonicalizeRound drops the digits the result cannot hold, rounding the last
+// one up, so that the truncation done by newAmount does not lose the rounding.
+func canonicalizeRound(xrp bool, mantissa uint64, exponent int) (uint64, int) {
+	if xrp {
+		if exponent < 0 {
+			loops := 0
+			for exponent < -1 {
+				mantissa /= 10
+				exponent++
+				loops++
+			}
+			// rippled adds 10 rather than 9 when fewer than two digits were
+			// dropped before the last one.
+			if loops >= 2 {
+				mantissa += 9
+			} else {
+				mantissa += 10
+			}
+			mantissa /= 10
+			exponent++
+		}
+		return mantissa, exponent
+	}
+
+	if mantissa > maxMantissa {
+		for mantissa > 10*maxMantissa {
+			mantissa /= 10
+			exponent++
+		}
+		mantissa += 9
+		mantissa /= 10
+		exponent++
+	}
+	return mantissa, exponent
+}
+
+// transferRateAmount returns a transfer rate, in billionths, as an amount.
+func transferRateAmount(rate uint32) Amount {
+	a, _ := newAmount(noIssue, uint64(rate), -9, false)
+	return a
+}
@@ -0,0 +1,115 @@
+package dex
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func ratio(t *testing.T, value string) Amount {
+	t.Helper()
+	a, err := parseIssued(noIssue, value)
+	require.NoError(t, err)
+	return a
+}
+
+func TestDivide(t *testing.T) {
+	tt := []struct {
+		name     string
+		a, b     string
+		roundUp  *bool
+		expected string
+	}{
+		{name: "one third", a: "1", b: "3", expected: "0.3333333333333333"},
+		{name: "two thirds", a: "2", b: "3", expected: "0.6666666666666667"},
+		{name: "one third rounded down", a: "1", b: "3", roundUp: ptr(false), expected: "0.3333333333333333"},
+		{name: "one third rounded up", a: "1", b: "3", roundUp: ptr(true), expected: "0.3333333333333334"},
+		{name: "two thirds rounded down", a: "2", b: "3", roundUp: ptr(false), expected: "0.6666666666666666"},
+		{name: "two thirds rounded up", a: "2", b: "3", roundUp: ptr(true), expected: "0.6666666666666667"},
+		{name: "exact rounded up", a: "1", b: "4", roundUp: ptr(true), expected: "0.25"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual Amount
+			var err error
+			if tc.roundUp == nil {
+				actual, err = divide(ratio(t, tc.a), ratio(t, tc.b), noIssue)
+			} else {
+				actual, err = divRound(ratio(t, tc.a), ratio(t, tc.b), noIssue, *tc.roundUp)
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual.String())
+		})
+	}
+
+	_, err := divide(ratio(t, "1"), ratio(t, "0"), noIssue)
+	require.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestMulRound(t *testing.T) {
+	tt := []struct {
+		name     string
+		a        types.CurrencyAmount
+		rate     string
+		roundUp  bool
+		expected string
+	}{
+		{name: "issued rounded up", a: usd("1"), rate: "0.6666666666666667", roundUp: true, expected: "0.6666666666666667"},
+		{name: "issued rounded down", a: usd("3"), rate: "0.3333333333333333", expected: "0.9999999999999999"},
+		{name: "xrp rounded up", a: types.XRPCurrencyAmount(3), rate: "0.5", roundUp: true, expected: "2"},
+		{name: "xrp rounded down", a: types.XRPCurrencyAmount(3), rate: "0.5", expected: "1"},
+		{name: "xrp never rounded up to zero", a: types.XRPCurrencyAmount(1), rate: "0.000001", roundUp: true, expected: "1"},
+		{name: "xrp rounded down to zero", a: types.XRPCurrencyAmount(1), rate: "0.000001", expected: "0"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			a := mustAmount(t, tc.a)
+			actual, err := mulRound(a, ratio(t, tc.rate), a.issue, tc.roundUp)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual.String())
+		})
+	}
+}
+
+func TestMultiply_TransferRate(t *testing.T) {
+	actual, err := multiplyRate(mustAmount(t, usd("45")), 1_002_000_000)
+	require.NoError(t, err)
+	require.Equal(t, "45.09", actual.String())
+
+	actual, err = divideRate(actual, 1_002_000_000)
+	require.NoError(t, err)
+	require.Equal(t, "45", actual.String())
+}
+
+func TestMultiplyRate(t *testing.T) {
+	tt := []struct {
+		name     string
+		a        types.IssuedCurrencyAmount
+		rate     uint32
+		expected string
+	}{
+		{name: "parity", a: usd("1.000000000000001"), rate: parityRate, expected: "1.000000000000001"},
+		{name: "exact", a: usd("45"), rate: 1_002_000_000, expected: "45.09"},
+		// 1.000000000000001 * 1.002 = 1.002000000000001002, which has more than
+		// 16 significant digits and is rounded up.
+		{name: "rounded up at the 16th digit", a: usd("1.000000000000001"), rate: 1_002_000_000, expected: "1.002000000000002"},
+		// 9.999999999999999 * 1.000000001 = 10.000000009999999..., rounded up
+		// into the next exponent.
+		{name: "rounded up into the next exponent", a: usd("9.999999999999999"), rate: 1_000_000_001, expected: "10.00000001"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := multiplyRate(mustAmount(t, tc.a), tc.rate)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual.String())
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
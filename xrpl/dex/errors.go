@@ -0,0 +1,22 @@
+package dex
+
+import "errors"
+
+var (
+	// ErrInvalidAmount is returned when an amount is missing or its value is not a decimal number.
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrUnsupportedAmount is returned for amounts other than XRP and issued currency amounts.
+	ErrUnsupportedAmount = errors.New("unsupported amount type")
+	// ErrAmountOverflow is returned when an amount is too large to be represented.
+	ErrAmountOverflow = errors.New("amount overflow")
+	// ErrIssueMismatch is returned when adding or subtracting amounts of different currencies.
+	ErrIssueMismatch = errors.New("amounts have different currencies")
+	// ErrDivisionByZero is returned when dividing by a zero amount.
+	ErrDivisionByZero = errors.New("division by zero")
+	// ErrInvalidQuality is returned when a book directory does not hold a quality.
+	ErrInvalidQuality = errors.New("invalid quality")
+	// ErrInvalidOffer is returned when the amounts of an OfferCreate are missing, zero or negative.
+	ErrInvalidOffer = errors.New("offer amounts must be positive")
+	// ErrBookMismatch is returned when a book offer does not sell what the OfferCreate buys for what it sells.
+	ErrBookMismatch = errors.New("book offer is not in the book the offer crosses")
+)
@@ -0,0 +1,118 @@
+package dex
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// Quality is the exchange rate of an offer, the amount the taker pays for each unit
+// it gets, encoded as rippled encodes it in the last 64 bits of a book directory:
+// the exponent plus 100 in the top byte and the 16-digit mantissa in the other 56
+// bits. A lower quality is a better rate for the taker.
+type Quality uint64
+
+const qualityMantissaMask = 1<<56 - 1
+
+// NewQuality returns the quality of an offer from its TakerPays and TakerGets
+// amounts. It returns zero if takerGets is zero.
+func NewQuality(takerPays, takerGets Amount) (Quality, error) {
+	if takerGets.IsZero() {
+		return 0, nil
+	}
+	rate, err := divide(takerPays, takerGets, noIssue)
+	if err != nil {
+		return 0, err
+	}
+	if rate.IsZero() {
+		return 0, nil
+	}
+	return Quality(uint64(rate.exponent+100)<<56 | rate.mantissa), nil
+}
+
+// OfferQuality returns the quality of an offer from its TakerPays and TakerGets
+// currency amounts.
+func OfferQuality(takerPays, takerGets types.CurrencyAmount) (Quality, error) {
+	pays, err := NewAmount(takerPays)
+	if err != nil {
+		return 0, err
+	}
+	gets, err := NewAmount(takerGets)
+	if err != nil {
+		return 0, err
+	}
+	return NewQuality(pays, gets)
+}
+
+// DirectoryQuality returns the quality of the offers in a book directory, held in
+// the last 64 bits of its ID. Every offer is crossed at the quality of its
+// directory, even after it has been partially consumed.
+func DirectoryQuality(bookDirectory types.Hash256) (Quality, error) {
+	b, err := hex.DecodeString(bookDirectory.String())
+	if err != nil || len(b) != 32 {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidQuality, bookDirectory)
+	}
+	return Quality(binary.BigEndian.Uint64(b[24:])), nil
+}
+
+// Rate returns the quality as a ratio: the amount the taker pays for each unit it
+// gets.
+func (q Quality) Rate() Amount {
+	if q == 0 {
+		return zero(noIssue)
+	}
+	rate, _ := newAmount(noIssue, uint64(q)&qualityMantissaMask, int(q>>56)-100, false)
+	return rate
+}
+
+// String returns the rate of the quality as a decimal number, the way rippled
+// writes the quality of book offers.
+func (q Quality) String() string {
+	return q.Rate().String()
+}
+
+// BetterThan reports whether q is a better rate for the taker than o.
+func (q Quality) BetterThan(o Quality) bool {
+	return q < o
+}
+
+// ceilIn limits the amounts of an offer of quality q to an input of at most limit,
+// rounding the output up. The output never exceeds the one of the offer.
+func (q Quality) ceilIn(amounts offerAmounts, limit Amount) (offerAmounts, error) {
+	if amounts.in.Cmp(limit) <= 0 {
+		return amounts, nil
+	}
+	out, err := divRound(limit, q.Rate(), amounts.out.issue, true)
+	if err != nil {
+		return offerAmounts{}, err
+	}
+	if out.Cmp(amounts.out) > 0 {
+		out = amounts.out
+	}
+	return offerAmounts{in: limit, out: out}, nil
+}
+
+// ceilOut limits the amounts of an offer of quality q to an output of at most
+// limit, rounding the input up. The input never exceeds the one of the offer.
+func (q Quality) ceilOut(amounts offerAmounts, limit Amount) (offerAmounts, error) {
+	if amounts.out.Cmp(limit) <= 0 {
+		return amounts, nil
+	}
+	in, err := mulRound(limit, q.Rate(), amounts.in.issue, true)
+	if err != nil {
+		return offerAmounts{}, err
+	}
+	if in.Cmp(amounts.in) > 0 {
+		in = amounts.in
+	}
+	return offerAmounts{in: in, out: limit}, nil
+}
+
+// offerAmounts are the amounts of an offer from the point of view of the taker:
+// what it pays in and what it gets out.
+type offerAmounts struct {
+	in  Amount
+	out Amount
+}
@@ -0,0 +1,101 @@
+package dex
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOfferQuality(t *testing.T) {
+	tt := []struct {
+		name      string
+		takerPays types.CurrencyAmount
+		takerGets types.CurrencyAmount
+		// The last 64 bits of the book directory of the offer.
+		expected Quality
+		rate     string
+	}{
+		{
+			name:      "issued for issued",
+			takerPays: usd("27.05340557506234"),
+			takerGets: eur("17.90363633316433"),
+			expected:  0x55055E4C405218EB,
+			rate:      "1.511056473200875",
+		},
+		{
+			name:      "xrp for issued",
+			takerPays: types.XRPCurrencyAmount(79550000000),
+			takerGets: types.IssuedCurrencyAmount{Currency: "XAG", Issuer: "r9Dr5xwkeLegBeXq6ujinjSBLQzQ1zQGjH", Value: "37"},
+			expected:  0x5E07A369E2446000,
+			rate:      "2150000000",
+		},
+		{
+			name:      "zero taker gets",
+			takerPays: usd("1"),
+			takerGets: eur("0"),
+			rate:      "0",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			q, err := OfferQuality(tc.takerPays, tc.takerGets)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, q)
+			require.Equal(t, tc.rate, q.String())
+		})
+	}
+}
+
+func TestDirectoryQuality(t *testing.T) {
+	t.Run("pass", func(t *testing.T) {
+		q, err := DirectoryQuality("7E5F614417C2D0A7CEFEB73C4AA773ED5B078DE2B5771F6D5505DCAA8FE12000")
+		require.NoError(t, err)
+		require.Equal(t, "1.65", q.String())
+	})
+
+	t.Run("fail - invalid directory", func(t *testing.T) {
+		_, err := DirectoryQuality("5505DCAA8FE12000")
+		require.ErrorIs(t, err, ErrInvalidQuality)
+	})
+}
+
+func TestQuality_BetterThan(t *testing.T) {
+	low, err := OfferQuality(usd("1.5"), eur("1"))
+	require.NoError(t, err)
+	high, err := OfferQuality(usd("1.55"), eur("1"))
+	require.NoError(t, err)
+
+	require.True(t, low.BetterThan(high))
+	require.False(t, high.BetterThan(low))
+	require.False(t, low.BetterThan(low))
+}
+
+func TestQuality_Ceil(t *testing.T) {
+	q, err := OfferQuality(types.XRPCurrencyAmount(10), usd("3"))
+	require.NoError(t, err)
+	amounts := offerAmounts{in: mustAmount(t, types.XRPCurrencyAmount(10)), out: mustAmount(t, usd("3"))}
+
+	t.Run("ceil out rounds the input up", func(t *testing.T) {
+		actual, err := q.ceilOut(amounts, mustAmount(t, usd("1")))
+		require.NoError(t, err)
+		require.Equal(t, "4", actual.in.String())
+		require.Equal(t, "1", actual.out.String())
+	})
+
+	t.Run("ceil in rounds the output up", func(t *testing.T) {
+		actual, err := q.ceilIn(amounts, mustAmount(t, types.XRPCurrencyAmount(5)))
+		require.NoError(t, err)
+		require.Equal(t, "5", actual.in.String())
+		// The rate is truncated to 3.333333333333333, so 5 drops buy a little
+		// more than 1.5.
+		require.Equal(t, "1.500000000000001", actual.out.String())
+	})
+
+	t.Run("within the limit", func(t *testing.T) {
+		actual, err := q.ceilOut(amounts, mustAmount(t, usd("5")))
+		require.NoError(t, err)
+		require.Equal(t, amounts, actual)
+	})
+}
@@ -0,0 +1,436 @@
+package dex
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// OfferCreate flags, as set by the OfferCreate flag setters.
+const (
+	tfPassive           uint32 = 65536
+	tfImmediateOrCancel uint32 = 131072
+	tfFillOrKill        uint32 = 262144
+	tfSell              uint32 = 524288
+)
+
+// parityRate is a transfer rate that charges no fee.
+const parityRate uint32 = 1_000_000_000
+
+// Option configures a simulation.
+type Option func(c *config)
+
+type config struct {
+	transferRate uint32
+	funds        types.CurrencyAmount
+}
+
+// WithTransferRate sets the TransferRate of the issuer of the currency the offer
+// sells, as found in its AccountRoot. The offer creator pays the transfer fee on
+// top of what it delivers to the owners of the offers it crosses, unless either
+// of them is the issuer.
+func WithTransferRate(rate uint32) Option {
+	return func(c *config) {
+		c.transferRate = rate
+	}
+}
+
+// WithFunds sets the balance of the currency the offer sells that the creator can
+// spend, transfer fees included. Without it, the creator is assumed to hold the
+// whole TakerGets amount and the transfer fees on it.
+func WithFunds(funds types.CurrencyAmount) Option {
+	return func(c *config) {
+		c.funds = funds
+	}
+}
+
+// Fill is the part of a book offer crossed by the simulated offer.
+type Fill struct {
+	// The ID of the crossed Offer entry.
+	Offer types.Hash256
+	// The quality the offer is crossed at.
+	Quality Quality
+	// The amount of the book offer's TakerGets received by the offer creator.
+	TakerGot types.CurrencyAmount
+	// The amount of the book offer's TakerPays delivered to its owner.
+	TakerPaid types.CurrencyAmount
+	// Whether the book offer is consumed, either fully taken or left unfunded.
+	Consumed bool
+}
+
+// Remainder is the offer placed in the ledger with what is left of an OfferCreate
+// after crossing.
+type Remainder struct {
+	TakerGets types.CurrencyAmount
+	TakerPays types.CurrencyAmount
+}
+
+// Result is the expected outcome of an OfferCreate.
+type Result struct {
+	// The book offers crossed, best quality first.
+	Fills []Fill
+	// The total amount of TakerPays currency received by the offer creator.
+	Received types.CurrencyAmount
+	// The total amount of TakerGets currency delivered to the owners of the
+	// crossed offers.
+	Paid types.CurrencyAmount
+	// Paid plus the transfer fees charged by the issuer of the TakerGets currency.
+	Spent types.CurrencyAmount
+	// The average rate of the fills: Paid divided by Received. Zero if nothing is
+	// crossed.
+	AveragePrice Quality
+	// The offer placed in the ledger after crossing, nil if none is placed.
+	Remainder *Remainder
+	// Whether the offer receives its whole TakerPays amount or, with tfSell,
+	// sells its whole TakerGets amount.
+	Filled bool
+	// Whether the offer is a fill-or-kill offer that cannot be filled, in which
+	// case the transaction fails with tecKILLED and nothing is exchanged.
+	Killed bool
+}
+
+// bookEntry is a book offer with its quality and funded amounts.
+type bookEntry struct {
+	offer   *pathtypes.BookOffer
+	quality Quality
+	amounts offerAmounts
+}
+
+// Simulate works out how an OfferCreate would cross an order book, returned by
+// book_offers for the book that sells the offer's TakerPays currency for its
+// TakerGets currency. Book offers are crossed best quality first, at the quality
+// of their book directory and limited to their funded amounts, as long as their
+// quality is at least as good as the one of the OfferCreate, or strictly better
+// with tfPassive. The amounts are rounded as rippled rounds them.
+//
+// The simulation assumes the book does not change before the OfferCreate is
+// applied, and does not account for expired offers, offers of the creator itself,
+// or liquidity reached through auto-bridging or AMMs.
+func Simulate(offer *transaction.OfferCreate, book []pathtypes.BookOffer, opts ...Option) (*Result, error) {
+	var cfg config
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	takerGets, err := NewAmount(offer.TakerGets)
+	if err != nil {
+		return nil, fmt.Errorf("%w: TakerGets: %w", ErrInvalidOffer, err)
+	}
+	takerPays, err := NewAmount(offer.TakerPays)
+	if err != nil {
+		return nil, fmt.Errorf("%w: TakerPays: %w", ErrInvalidOffer, err)
+	}
+	if takerGets.Sign() <= 0 || takerPays.Sign() <= 0 {
+		return nil, ErrInvalidOffer
+	}
+
+	// The creator takes the book offers, paying its TakerGets for its TakerPays.
+	threshold, err := NewQuality(takerGets, takerPays)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &taker{
+		account:      offer.Account,
+		sell:         offer.Flags&tfSell != 0,
+		remaining:    offerAmounts{in: takerGets, out: takerPays},
+		transferRate: cfg.transferRate,
+	}
+	if cfg.funds != nil {
+		funds, err := NewAmount(cfg.funds)
+		if err != nil {
+			return nil, err
+		}
+		if funds.issue != takerGets.issue {
+			return nil, fmt.Errorf("%w: funds in %s, TakerGets in %s", ErrIssueMismatch, funds.issue, takerGets.issue)
+		}
+		t.funds = &funds
+	}
+
+	entries, err := bookEntries(book, takerGets.issue, takerPays.issue)
+	if err != nil {
+		return nil, err
+	}
+
+	passive := offer.Flags&tfPassive != 0
+	paid, received, spent := zero(takerGets.issue), zero(takerPays.issue), zero(takerGets.issue)
+	var fills []Fill
+	for _, e := range entries {
+		if t.done() {
+			break
+		}
+		if (passive && !e.quality.BetterThan(threshold)) || threshold.BetterThan(e.quality) {
+			break
+		}
+
+		order, issuersIn, err := t.flow(e)
+		if err != nil {
+			return nil, err
+		}
+		if order.in.IsZero() && order.out.IsZero() {
+			continue
+		}
+		if err := t.consume(order, issuersIn); err != nil {
+			return nil, err
+		}
+		if paid, err = paid.Add(order.in); err != nil {
+			return nil, err
+		}
+		if received, err = received.Add(order.out); err != nil {
+			return nil, err
+		}
+		if spent, err = spent.Add(issuersIn); err != nil {
+			return nil, err
+		}
+		fills = append(fills, Fill{
+			Offer:     e.offer.Index,
+			Quality:   e.quality,
+			TakerGot:  order.out.CurrencyAmount(),
+			TakerPaid: order.in.CurrencyAmount(),
+			Consumed:  order.out.Cmp(e.amounts.out) >= 0,
+		})
+	}
+
+	filled := t.remaining.out.Sign() <= 0
+	if t.sell {
+		filled = t.remaining.in.Sign() <= 0
+	}
+	if offer.Flags&tfFillOrKill != 0 && !filled {
+		return &Result{
+			Received: zero(takerPays.issue).CurrencyAmount(),
+			Paid:     zero(takerGets.issue).CurrencyAmount(),
+			Spent:    zero(takerGets.issue).CurrencyAmount(),
+			Killed:   true,
+		}, nil
+	}
+
+	result := &Result{
+		Fills:    fills,
+		Received: received.CurrencyAmount(),
+		Paid:     paid.CurrencyAmount(),
+		Spent:    spent.CurrencyAmount(),
+		Filled:   filled,
+	}
+	if !received.IsZero() {
+		if result.AveragePrice, err = NewQuality(paid, received); err != nil {
+			return nil, err
+		}
+	}
+
+	if offer.Flags&(tfImmediateOrCancel|tfFillOrKill) == 0 && (t.funds == nil || t.funds.Sign() > 0) {
+		if result.Remainder, err = remainder(t.sell, takerGets, takerPays, paid, received, threshold); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// bookEntries returns the funded book offers sorted by quality, best first.
+func bookEntries(book []pathtypes.BookOffer, in, out Issue) ([]bookEntry, error) {
+	entries := make([]bookEntry, 0, len(book))
+	for i := range book {
+		o := &book[i]
+		gets, err := NewAmount(o.TakerGets)
+		if err != nil {
+			return nil, fmt.Errorf("book offer %s: TakerGets: %w", o.Index, err)
+		}
+		pays, err := NewAmount(o.TakerPays)
+		if err != nil {
+			return nil, fmt.Errorf("book offer %s: TakerPays: %w", o.Index, err)
+		}
+		if gets.issue != out || pays.issue != in {
+			return nil, fmt.Errorf("%w: %s offers %s for %s", ErrBookMismatch, o.Index, gets.issue, pays.issue)
+		}
+
+		var quality Quality
+		if o.BookDirectory != "" {
+			quality, err = DirectoryQuality(o.BookDirectory)
+		} else {
+			quality, err = NewQuality(pays, gets)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		amounts := offerAmounts{in: pays, out: gets}
+		if o.TakerGetsFunded != nil {
+			funded, err := fundedAmount(o.TakerGetsFunded)
+			if err != nil {
+				return nil, fmt.Errorf("book offer %s: taker_gets_funded: %w", o.Index, err)
+			}
+			if funded.Sign() <= 0 {
+				// Unfunded offers are removed when crossed.
+				continue
+			}
+			if amounts, err = quality.ceilOut(amounts, funded); err != nil {
+				return nil, err
+			}
+		}
+		entries = append(entries, bookEntry{offer: o, quality: quality, amounts: amounts})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].quality.BetterThan(entries[j].quality)
+	})
+	return entries, nil
+}
+
+// fundedAmount decodes the taker_gets_funded field of a book offer.
+func fundedAmount(v any) (Amount, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return Amount{}, err
+	}
+	amount, err := types.UnmarshalCurrencyAmount(data)
+	if err != nil {
+		return Amount{}, err
+	}
+	return NewAmount(amount)
+}
+
+// remainder returns the offer placed after crossing. A selling offer keeps its
+// rate and sells what it has not sold yet. Any other offer keeps its rate and buys
+// what it has not received yet.
+func remainder(sell bool, takerGets, takerPays, paid, received Amount, threshold Quality) (*Remainder, error) {
+	rate := threshold.Rate()
+
+	var in, out Amount
+	var err error
+	if sell {
+		if in, err = takerGets.Sub(paid); err != nil {
+			return nil, err
+		}
+		if in.Sign() <= 0 {
+			return nil, nil
+		}
+		if out, err = divRound(in, rate, takerPays.issue, false); err != nil {
+			return nil, err
+		}
+	} else {
+		if out, err = takerPays.Sub(received); err != nil {
+			return nil, err
+		}
+		if out.Sign() <= 0 {
+			return nil, nil
+		}
+		if in, err = mulRound(out, rate, takerGets.issue, true); err != nil {
+			return nil, err
+		}
+	}
+
+	if in.Sign() <= 0 || out.Sign() <= 0 {
+		return nil, nil
+	}
+	return &Remainder{TakerGets: in.CurrencyAmount(), TakerPays: out.CurrencyAmount()}, nil
+}
+
+// taker keeps track of what is left of an OfferCreate while it crosses a book.
+type taker struct {
+	account   types.Address
+	sell      bool
+	remaining offerAmounts
+	// The creator's balance of the currency it sells, nil if not limited.
+	funds        *Amount
+	transferRate uint32
+}
+
+// done reports whether the taker cannot cross any more offers.
+func (t *taker) done() bool {
+	if !t.sell && t.remaining.out.Sign() <= 0 {
+		return true
+	}
+	if t.remaining.in.Sign() <= 0 {
+		return true
+	}
+	return t.funds != nil && t.funds.Sign() <= 0
+}
+
+// rateIn returns the transfer rate the taker pays when delivering to owner.
+func (t *taker) rateIn(owner types.Address, issue Issue) uint32 {
+	if issue.IsXRP() || t.transferRate == 0 || t.account == issue.Issuer || owner == issue.Issuer {
+		return parityRate
+	}
+	return t.transferRate
+}
+
+// flow returns how much of a book offer the taker crosses, and what it spends on
+// it, transfer fee included. The offer is limited by what the taker wants, unless
+// it sells, by its funds and by what it has left to pay.
+func (t *taker) flow(e bookEntry) (offerAmounts, Amount, error) {
+	rate := t.rateIn(e.offer.Account, e.amounts.in.issue)
+	order := e.amounts
+	issuersIn, err := multiplyRate(order.in, rate)
+	if err != nil {
+		return offerAmounts{}, Amount{}, err
+	}
+
+	if !t.sell && t.remaining.out.Cmp(order.out) < 0 {
+		if order, err = e.quality.ceilOut(order, t.remaining.out); err != nil {
+			return offerAmounts{}, Amount{}, err
+		}
+		if issuersIn, err = multiplyRate(order.in, rate); err != nil {
+			return offerAmounts{}, Amount{}, err
+		}
+	}
+
+	if t.funds != nil && t.funds.Cmp(issuersIn) < 0 {
+		issuersIn = *t.funds
+		limit, err := divideRate(issuersIn, rate)
+		if err != nil {
+			return offerAmounts{}, Amount{}, err
+		}
+		if order, err = e.quality.ceilIn(order, limit); err != nil {
+			return offerAmounts{}, Amount{}, err
+		}
+	}
+
+	if t.remaining.in.Cmp(order.in) < 0 {
+		if order, err = e.quality.ceilIn(order, t.remaining.in); err != nil {
+			return offerAmounts{}, Amount{}, err
+		}
+		if issuersIn, err = multiplyRate(order.in, rate); err != nil {
+			return offerAmounts{}, Amount{}, err
+		}
+	}
+	return order, issuersIn, nil
+}
+
+// consume takes a crossed order off what the taker has left.
+func (t *taker) consume(order offerAmounts, issuersIn Amount) error {
+	var err error
+	if t.remaining.in, err = t.remaining.in.Sub(order.in); err != nil {
+		return err
+	}
+	if t.remaining.out, err = t.remaining.out.Sub(order.out); err != nil {
+		return err
+	}
+	if t.funds != nil {
+		funds, err := t.funds.Sub(issuersIn)
+		if err != nil {
+			return err
+		}
+		t.funds = &funds
+	}
+	return nil
+}
+
+// multiplyRate returns an amount with a transfer fee of the given rate added,
+// rounded up as rippled does, so the fee is never underestimated.
+func multiplyRate(a Amount, rate uint32) (Amount, error) {
+	if rate == parityRate {
+		return a, nil
+	}
+	return mulRound(a, transferRateAmount(rate), a.issue, true)
+}
+
+// divideRate returns an amount with a transfer fee of the given rate taken off.
+func divideRate(a Amount, rate uint32) (Amount, error) {
+	if rate == parityRate {
+		return a, nil
+	}
+	return divide(a, transferRateAmount(rate), a.issue)
+}
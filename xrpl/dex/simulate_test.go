@@ -0,0 +1,288 @@
+package dex
+
+import (
+	"testing"
+
+	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testCreator types.Address = "rM3X3QSr8icjTGpaF52dozhbT2BZSXJQYM"
+	testOwner   types.Address = "rhsxKNyN99q6vyYCTHNTC1TqWCeHr7PNgp"
+)
+
+func bookOffer(index types.Hash256, takerGets, takerPays types.CurrencyAmount) pathtypes.BookOffer {
+	return pathtypes.BookOffer{
+		Index:           index,
+		LedgerEntryType: "Offer",
+		Account:         testOwner,
+		TakerGets:       takerGets,
+		TakerPays:       takerPays,
+	}
+}
+
+// testBook sells USD for EUR at 1.5, 1.55 and 1.7 EUR per USD, listed out of order.
+func testBook() []pathtypes.BookOffer {
+	return []pathtypes.BookOffer{
+		bookOffer("C", usd("40"), eur("68")),
+		bookOffer("A", usd("30"), eur("45")),
+		bookOffer("B", usd("50"), eur("77.5")),
+	}
+}
+
+// buyUSD returns an OfferCreate buying USD with EUR.
+func buyUSD(takerPays, takerGets string, flags uint32) *transaction.OfferCreate {
+	return &transaction.OfferCreate{
+		BaseTx: transaction.BaseTx{
+			Account:         testCreator,
+			TransactionType: transaction.OfferCreateTx,
+			Flags:           flags,
+		},
+		TakerPays: usd(takerPays),
+		TakerGets: eur(takerGets),
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	tt := []struct {
+		name     string
+		offer    *transaction.OfferCreate
+		book     []pathtypes.BookOffer
+		opts     []Option
+		expected Result
+		price    string
+	}{
+		{
+			name:  "partially filled, remainder placed",
+			offer: buyUSD("100", "160", 0),
+			book:  testBook(),
+			expected: Result{
+				Fills: []Fill{
+					{Offer: "A", TakerGot: usd("30"), TakerPaid: eur("45"), Consumed: true},
+					{Offer: "B", TakerGot: usd("50"), TakerPaid: eur("77.5"), Consumed: true},
+				},
+				Received:  usd("80"),
+				Paid:      eur("122.5"),
+				Spent:     eur("122.5"),
+				Remainder: &Remainder{TakerGets: eur("32"), TakerPays: usd("20")},
+			},
+			price: "1.53125",
+		},
+		{
+			name:  "filled by a partial fill",
+			offer: buyUSD("60", "96", 0),
+			book:  testBook(),
+			expected: Result{
+				Fills: []Fill{
+					{Offer: "A", TakerGot: usd("30"), TakerPaid: eur("45"), Consumed: true},
+					{Offer: "B", TakerGot: usd("30"), TakerPaid: eur("46.5")},
+				},
+				Received: usd("60"),
+				Paid:     eur("91.5"),
+				Spent:    eur("91.5"),
+				Filled:   true,
+			},
+			price: "1.525",
+		},
+		{
+			name: "sell receives more than TakerPays",
+			offer: &transaction.OfferCreate{
+				BaseTx:    transaction.BaseTx{Account: testCreator, Flags: tfSell},
+				TakerPays: usd("60"),
+				TakerGets: eur("100"),
+			},
+			book: testBook(),
+			expected: Result{
+				Fills: []Fill{
+					{Offer: "A", TakerGot: usd("30"), TakerPaid: eur("45"), Consumed: true},
+					{Offer: "B", TakerGot: usd("35.48387096774194"), TakerPaid: eur("55")},
+				},
+				Received: usd("65.48387096774194"),
+				Paid:     eur("100"),
+				Spent:    eur("100"),
+				Filled:   true,
+			},
+			price: "1.527093596059113",
+		},
+		{
+			name:  "immediate or cancel places no remainder",
+			offer: buyUSD("100", "160", tfImmediateOrCancel),
+			book:  testBook(),
+			expected: Result{
+				Fills: []Fill{
+					{Offer: "A", TakerGot: usd("30"), TakerPaid: eur("45"), Consumed: true},
+					{Offer: "B", TakerGot: usd("50"), TakerPaid: eur("77.5"), Consumed: true},
+				},
+				Received: usd("80"),
+				Paid:     eur("122.5"),
+				Spent:    eur("122.5"),
+			},
+			price: "1.53125",
+		},
+		{
+			name:  "fill or kill is killed",
+			offer: buyUSD("100", "160", tfFillOrKill),
+			book:  testBook(),
+			expected: Result{
+				Received: usd("0"),
+				Paid:     eur("0"),
+				Spent:    eur("0"),
+				Killed:   true,
+			},
+			price: "0",
+		},
+		{
+			name:  "fill or kill is filled",
+			offer: buyUSD("60", "96", tfFillOrKill),
+			book:  testBook(),
+			expected: Result{
+				Fills: []Fill{
+					{Offer: "A", TakerGot: usd("30"), TakerPaid: eur("45"), Consumed: true},
+					{Offer: "B", TakerGot: usd("30"), TakerPaid: eur("46.5")},
+				},
+				Received: usd("60"),
+				Paid:     eur("91.5"),
+				Spent:    eur("91.5"),
+				Filled:   true,
+			},
+			price: "1.525",
+		},
+		{
+			name:  "passive does not cross offers of the same quality",
+			offer: buyUSD("30", "45", tfPassive),
+			book:  testBook(),
+			expected: Result{
+				Received:  usd("0"),
+				Paid:      eur("0"),
+				Spent:     eur("0"),
+				Remainder: &Remainder{TakerGets: eur("45"), TakerPays: usd("30")},
+			},
+			price: "0",
+		},
+		{
+			name:  "crosses offers of the same quality",
+			offer: buyUSD("30", "45", 0),
+			book:  testBook(),
+			expected: Result{
+				Fills:    []Fill{{Offer: "A", TakerGot: usd("30"), TakerPaid: eur("45"), Consumed: true}},
+				Received: usd("30"),
+				Paid:     eur("45"),
+				Spent:    eur("45"),
+				Filled:   true,
+			},
+			price: "1.5",
+		},
+		{
+			name:  "partially funded and unfunded offers",
+			offer: buyUSD("20", "32", 0),
+			book: func() []pathtypes.BookOffer {
+				book := testBook()
+				book[1].TakerGetsFunded = map[string]any{"currency": "USD", "issuer": string(testIssuer), "value": "10"}
+				book[2].TakerGetsFunded = map[string]any{"currency": "USD", "issuer": string(testIssuer), "value": "0"}
+				return book
+			}(),
+			expected: Result{
+				Fills:     []Fill{{Offer: "A", TakerGot: usd("10"), TakerPaid: eur("15"), Consumed: true}},
+				Received:  usd("10"),
+				Paid:      eur("15"),
+				Spent:     eur("15"),
+				Remainder: &Remainder{TakerGets: eur("16"), TakerPays: usd("10")},
+			},
+			price: "1.5",
+		},
+		{
+			name:  "transfer fee",
+			offer: buyUSD("30", "45", 0),
+			book:  testBook(),
+			opts:  []Option{WithTransferRate(1_002_000_000)},
+			expected: Result{
+				Fills:    []Fill{{Offer: "A", TakerGot: usd("30"), TakerPaid: eur("45"), Consumed: true}},
+				Received: usd("30"),
+				Paid:     eur("45"),
+				Spent:    eur("45.09"),
+				Filled:   true,
+			},
+			price: "1.5",
+		},
+		{
+			name:  "limited by funds",
+			offer: buyUSD("100", "160", 0),
+			book:  testBook(),
+			opts:  []Option{WithFunds(eur("50"))},
+			expected: Result{
+				Fills: []Fill{
+					{Offer: "A", TakerGot: usd("30"), TakerPaid: eur("45"), Consumed: true},
+					{Offer: "B", TakerGot: usd("3.225806451612904"), TakerPaid: eur("5")},
+				},
+				Received: usd("33.2258064516129"),
+				Paid:     eur("50"),
+				Spent:    eur("50"),
+			},
+			price: "1.504854368932039",
+		},
+		{
+			name: "xrp for issued",
+			offer: &transaction.OfferCreate{
+				BaseTx:    transaction.BaseTx{Account: testCreator},
+				TakerPays: usd("10"),
+				TakerGets: types.XRPCurrencyAmount(25_000_000),
+			},
+			book: []pathtypes.BookOffer{
+				bookOffer("A", usd("4"), types.XRPCurrencyAmount(9_000_000)),
+				bookOffer("B", usd("10"), types.XRPCurrencyAmount(24_000_000)),
+			},
+			expected: Result{
+				Fills: []Fill{
+					{Offer: "A", TakerGot: usd("4"), TakerPaid: types.XRPCurrencyAmount(9_000_000), Consumed: true},
+					{Offer: "B", TakerGot: usd("6"), TakerPaid: types.XRPCurrencyAmount(14_400_000)},
+				},
+				Received: usd("10"),
+				Paid:     types.XRPCurrencyAmount(23_400_000),
+				Spent:    types.XRPCurrencyAmount(23_400_000),
+				Filled:   true,
+			},
+			price: "2340000",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := Simulate(tc.offer, tc.book, tc.opts...)
+			require.NoError(t, err)
+
+			for i := range actual.Fills {
+				require.NotZero(t, actual.Fills[i].Quality)
+				actual.Fills[i].Quality = 0
+			}
+			require.Equal(t, tc.price, actual.AveragePrice.String())
+			actual.AveragePrice = 0
+			require.Equal(t, tc.expected, *actual)
+		})
+	}
+}
+
+func TestSimulate_Errors(t *testing.T) {
+	t.Run("fail - zero amount", func(t *testing.T) {
+		_, err := Simulate(buyUSD("0", "1", 0), testBook())
+		require.ErrorIs(t, err, ErrInvalidOffer)
+	})
+
+	t.Run("fail - missing amount", func(t *testing.T) {
+		_, err := Simulate(&transaction.OfferCreate{TakerPays: usd("1")}, testBook())
+		require.ErrorIs(t, err, ErrInvalidOffer)
+	})
+
+	t.Run("fail - book mismatch", func(t *testing.T) {
+		book := []pathtypes.BookOffer{bookOffer("A", eur("30"), usd("45"))}
+		_, err := Simulate(buyUSD("100", "160", 0), book)
+		require.ErrorIs(t, err, ErrBookMismatch)
+	})
+
+	t.Run("fail - funds in another currency", func(t *testing.T) {
+		_, err := Simulate(buyUSD("100", "160", 0), testBook(), WithFunds(usd("1")))
+		require.ErrorIs(t, err, ErrIssueMismatch)
+	})
+}
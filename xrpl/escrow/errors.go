@@ -0,0 +1,14 @@
+package escrow
+
+import "errors"
+
+var (
+	// ErrNotEscrowCreate is returned when the transaction that created an escrow is not an EscrowCreate.
+	ErrNotEscrowCreate = errors.New("previous transaction of the escrow is not an EscrowCreate")
+	// ErrFulfillmentRequired is returned when finishing an escrow locked with a condition without its fulfillment.
+	ErrFulfillmentRequired = errors.New("escrow is locked with a condition and requires a fulfillment")
+	// ErrNoCondition is returned when a fulfillment is given for an escrow not locked with a condition.
+	ErrNoCondition = errors.New("escrow is not locked with a condition")
+	// ErrNotCancelable is returned when canceling an escrow without CancelAfter.
+	ErrNotCancelable = errors.New("escrow has no CancelAfter and cannot be canceled")
+)
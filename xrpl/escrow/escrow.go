@@ -0,0 +1,231 @@
+// Package escrow lists the escrows of an account, works out whether each one can be
+// finished or canceled at a given ledger close time, and builds the EscrowFinish and
+// EscrowCancel transactions to release or return them.
+package escrow
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"time"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/xrpl/client"
+	ledgerentry "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	xrpltime "github.com/Peersyst/xrpl-go/xrpl/time"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// Client pages through the escrow objects of an account, reads the close time of
+// the last closed ledger and looks up the EscrowCreate that created an escrow,
+// decoding it with the definitions of the client. It is a subset of client.XRPLClient.
+type Client interface {
+	Definitions() *definitions.Definitions
+	AccountObjects(ctx context.Context, req *account.ObjectsRequest, opts ...client.PageOption) iter.Seq2[ledgerentry.FlatLedgerObject, error]
+	GetLedgerWithContext(ctx context.Context, req *ledger.Request) (*ledger.Response, error)
+	GetTxWithContext(ctx context.Context, req *requests.TxRequest) (*requests.TxResponse, error)
+}
+
+// Status tells which transaction, if any, can be applied to an escrow.
+type Status int
+
+const (
+	// Pending escrows can be neither finished nor canceled yet.
+	Pending Status = iota
+	// Finishable escrows can be finished, releasing the XRP to the destination.
+	Finishable
+	// Cancelable escrows have expired and can be canceled, returning the XRP to the owner.
+	Cancelable
+)
+
+// String returns the name of the status.
+func (s Status) String() string {
+	switch s {
+	case Finishable:
+		return "finishable"
+	case Cancelable:
+		return "cancelable"
+	default:
+		return "pending"
+	}
+}
+
+// Escrow is an Escrow ledger entry together with the sequence of the EscrowCreate
+// transaction that created it, which EscrowFinish and EscrowCancel take as their
+// OfferSequence.
+type Escrow struct {
+	Entry ledgerentry.Escrow
+	// The Sequence, or TicketSequence, of the EscrowCreate transaction.
+	OfferSequence uint32
+}
+
+// List returns the escrows of an account, both the ones it owns and the ones it is the
+// destination of, in the validated ledger. The OfferSequence of each escrow is looked
+// up from the EscrowCreate transaction of its PreviousTxnID.
+func List(ctx context.Context, c Client, address types.Address) ([]Escrow, error) {
+	req := &account.ObjectsRequest{
+		Account:     address,
+		Type:        account.EscrowObject,
+		LedgerIndex: common.Validated,
+	}
+
+	var escrows []Escrow
+	for object, err := range c.AccountObjects(ctx, req) {
+		if err != nil {
+			return nil, err
+		}
+		entry, err := decodeEscrow(object)
+		if err != nil {
+			return nil, err
+		}
+		sequence, err := OfferSequence(ctx, c, entry.PreviousTxnID)
+		if err != nil {
+			return nil, err
+		}
+		escrows = append(escrows, Escrow{Entry: *entry, OfferSequence: sequence})
+	}
+	return escrows, nil
+}
+
+func decodeEscrow(object ledgerentry.FlatLedgerObject) (*ledgerentry.Escrow, error) {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	var entry ledgerentry.Escrow
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// OfferSequence returns the sequence of the EscrowCreate transaction with the given
+// hash: its Sequence, or its TicketSequence if it used a ticket.
+func OfferSequence(ctx context.Context, c Client, txHash types.Hash256) (uint32, error) {
+	res, err := c.GetTxWithContext(ctx, &requests.TxRequest{Transaction: string(txHash)})
+	if err != nil {
+		return 0, err
+	}
+	tx, err := res.TypedTx(binarycodec.WithDefinitions(c.Definitions()))
+	if err != nil {
+		return 0, err
+	}
+	create, ok := tx.(*transaction.EscrowCreate)
+	if !ok {
+		return 0, ErrNotEscrowCreate
+	}
+	if create.Sequence == 0 {
+		return create.TicketSequence, nil
+	}
+	return create.Sequence, nil
+}
+
+// CloseTime returns the close time of the last closed ledger. The next ledger checks
+// FinishAfter and CancelAfter against it.
+func CloseTime(ctx context.Context, c Client) (time.Time, error) {
+	res, err := c.GetLedgerWithContext(ctx, &ledger.Request{LedgerIndex: common.Closed})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return fromRippleTime(uint32(res.Ledger.CloseTime)), nil
+}
+
+// Status returns whether the escrow can be finished or canceled in a ledger following
+// a ledger closed at closeTime. An escrow can be finished once the close time is past
+// its FinishAfter, until it is past its CancelAfter. It can be canceled once the close
+// time is past its CancelAfter.
+func (e *Escrow) Status(closeTime time.Time) Status {
+	now := closeTime.Unix() - xrpltime.RippleEpochDiff
+	if e.Entry.CancelAfter != 0 && now > int64(e.Entry.CancelAfter) {
+		return Cancelable
+	}
+	if e.Entry.FinishAfter != 0 && now <= int64(e.Entry.FinishAfter) {
+		return Pending
+	}
+	return Finishable
+}
+
+// FinishableAt returns the earliest ledger close time after which the escrow can be
+// finished. It returns false if the escrow has no FinishAfter, in which case it can be
+// finished at once.
+func (e *Escrow) FinishableAt() (time.Time, bool) {
+	if e.Entry.FinishAfter == 0 {
+		return time.Time{}, false
+	}
+	return fromRippleTime(e.Entry.FinishAfter), true
+}
+
+// CancelableAt returns the ledger close time after which the escrow can be canceled.
+// It returns false if the escrow has no CancelAfter, in which case it can never be
+// canceled.
+func (e *Escrow) CancelableAt() (time.Time, bool) {
+	if e.Entry.CancelAfter == 0 {
+		return time.Time{}, false
+	}
+	return fromRippleTime(e.Entry.CancelAfter), true
+}
+
+// RequiresFulfillment reports whether the escrow is locked with a condition, and so
+// can only be finished with its fulfillment.
+func (e *Escrow) RequiresFulfillment() bool {
+	return e.Entry.Condition != ""
+}
+
+// FinishTx returns an EscrowFinish transaction, sent by account, releasing the escrow.
+// The fulfillment is required if the escrow is locked with a condition, and must be
+// empty otherwise. The transaction cost of a fulfillment is given by FinishFee.
+func (e *Escrow) FinishTx(account types.Address, fulfillment string) (*transaction.EscrowFinish, error) {
+	if e.RequiresFulfillment() && fulfillment == "" {
+		return nil, ErrFulfillmentRequired
+	}
+	if !e.RequiresFulfillment() && fulfillment != "" {
+		return nil, ErrNoCondition
+	}
+	return &transaction.EscrowFinish{
+		BaseTx: transaction.BaseTx{
+			Account:         account,
+			TransactionType: transaction.EscrowFinishTx,
+		},
+		Owner:         e.Entry.Account,
+		OfferSequence: e.OfferSequence,
+		Condition:     e.Entry.Condition,
+		Fulfillment:   fulfillment,
+	}, nil
+}
+
+// CancelTx returns an EscrowCancel transaction, sent by account, returning the escrow
+// to its owner.
+func (e *Escrow) CancelTx(account types.Address) (*transaction.EscrowCancel, error) {
+	if e.Entry.CancelAfter == 0 {
+		return nil, ErrNotCancelable
+	}
+	return &transaction.EscrowCancel{
+		BaseTx: transaction.BaseTx{
+			Account:         account,
+			TransactionType: transaction.EscrowCancelTx,
+		},
+		Owner:         e.Entry.Account,
+		OfferSequence: e.OfferSequence,
+	}, nil
+}
+
+// FinishFee returns the transaction cost of an EscrowFinish carrying the hex encoded
+// fulfillment, given the base fee of the network: 33 times the base fee, plus the base
+// fee for every 16 bytes of fulfillment. Without a fulfillment it is the base fee.
+func FinishFee(baseFee types.XRPCurrencyAmount, fulfillment string) types.XRPCurrencyAmount {
+	if fulfillment == "" {
+		return baseFee
+	}
+	size := uint64(len(fulfillment)+1) / 2
+	return baseFee * types.XRPCurrencyAmount(33+size/16)
+}
+
+func fromRippleTime(t uint32) time.Time {
+	return time.Unix(int64(t)+xrpltime.RippleEpochDiff, 0)
+}
@@ -0,0 +1,335 @@
+package escrow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"iter"
+	"os"
+	"testing"
+	"time"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/xrpl/client"
+	ledgerentry "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	ledgertypes "github.com/Peersyst/xrpl-go/xrpl/queries/ledger/types"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	xrpltime "github.com/Peersyst/xrpl-go/xrpl/time"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+var _ Client = client.XRPLClient(nil)
+
+const (
+	testOwner       types.Address = "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn"
+	testDestination types.Address = "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH"
+	testCondition                 = "A0258020E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855810100"
+	testFulfillment               = "A0028000"
+	testFinishAfter uint32        = 1000
+	testCancelAfter uint32        = 2000
+)
+
+// mockClient serves a fixed set of escrows, the transactions that created them, and
+// a closed ledger.
+type mockClient struct {
+	objects []ledgerentry.FlatLedgerObject
+	txs     map[string]transaction.FlatTransaction
+	// Transactions returned in binary format
+	blobs       map[string]string
+	definitions *definitions.Definitions
+	closeTime   uint32
+	err         error
+}
+
+func (m *mockClient) Definitions() *definitions.Definitions {
+	return m.definitions
+}
+
+func (m *mockClient) AccountObjects(_ context.Context, req *account.ObjectsRequest, _ ...client.PageOption) iter.Seq2[ledgerentry.FlatLedgerObject, error] {
+	return func(yield func(ledgerentry.FlatLedgerObject, error) bool) {
+		if m.err != nil {
+			yield(nil, m.err)
+			return
+		}
+		if req.Type != account.EscrowObject {
+			return
+		}
+		for _, object := range m.objects {
+			if !yield(object, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (m *mockClient) GetLedgerWithContext(_ context.Context, req *ledger.Request) (*ledger.Response, error) {
+	if req.LedgerIndex != common.Closed {
+		return nil, errors.New("unexpected ledger index")
+	}
+	return &ledger.Response{Ledger: ledgertypes.BaseLedger{CloseTime: int(m.closeTime)}}, nil
+}
+
+func (m *mockClient) GetTxWithContext(_ context.Context, req *requests.TxRequest) (*requests.TxResponse, error) {
+	if blob, ok := m.blobs[req.Transaction]; ok {
+		return &requests.TxResponse{TxBlob: blob, Validated: true}, nil
+	}
+	tx, ok := m.txs[req.Transaction]
+	if !ok {
+		return nil, errors.New("txnNotFound")
+	}
+	return &requests.TxResponse{Tx: tx, Validated: true}, nil
+}
+
+func testEscrowObject(owner, destination types.Address, previousTxnID string) ledgerentry.FlatLedgerObject {
+	return ledgerentry.FlatLedgerObject{
+		"LedgerEntryType": "Escrow",
+		"Account":         string(owner),
+		"Destination":     string(destination),
+		"Amount":          "10000",
+		"FinishAfter":     float64(testFinishAfter),
+		"CancelAfter":     float64(testCancelAfter),
+		"PreviousTxnID":   previousTxnID,
+	}
+}
+
+func testEscrowCreate(sequence, ticketSequence uint32) transaction.FlatTransaction {
+	return transaction.FlatTransaction{
+		"TransactionType": "EscrowCreate",
+		"Account":         string(testOwner),
+		"Destination":     string(testDestination),
+		"Amount":          "10000",
+		"Sequence":        float64(sequence),
+		"TicketSequence":  float64(ticketSequence),
+	}
+}
+
+func rippleTime(t uint32) time.Time {
+	return time.Unix(int64(t)+xrpltime.RippleEpochDiff, 0)
+}
+
+func TestList(t *testing.T) {
+	const (
+		ownedID    = "C44F2EB84196B9AD820313DBEBA6316A15C9A2D35787579ED172B87A30131DA7"
+		incomingID = "5DB01B7FFED6B67E6B0414DED11E051D2EE2B7619CE0EAA6286D67A3A4D5BDB3"
+	)
+
+	t.Run("pass - owned and destination escrows", func(t *testing.T) {
+		c := &mockClient{
+			objects: []ledgerentry.FlatLedgerObject{
+				testEscrowObject(testOwner, testDestination, ownedID),
+				testEscrowObject(testDestination, testOwner, incomingID),
+			},
+			txs: map[string]transaction.FlatTransaction{
+				ownedID:    testEscrowCreate(7, 0),
+				incomingID: testEscrowCreate(0, 12),
+			},
+		}
+
+		escrows, err := List(context.Background(), c, testOwner)
+		require.NoError(t, err)
+		require.Len(t, escrows, 2)
+
+		require.Equal(t, testOwner, escrows[0].Entry.Account)
+		require.Equal(t, types.XRPCurrencyAmount(10000), escrows[0].Entry.Amount)
+		require.Equal(t, testCancelAfter, escrows[0].Entry.CancelAfter)
+		require.Equal(t, uint32(7), escrows[0].OfferSequence)
+
+		require.Equal(t, testDestination, escrows[1].Entry.Account)
+		require.Equal(t, uint32(12), escrows[1].OfferSequence)
+	})
+
+	t.Run("fail - previous transaction is not an EscrowCreate", func(t *testing.T) {
+		c := &mockClient{
+			objects: []ledgerentry.FlatLedgerObject{testEscrowObject(testOwner, testDestination, ownedID)},
+			txs: map[string]transaction.FlatTransaction{
+				ownedID: {"TransactionType": "AccountSet", "Account": string(testOwner)},
+			},
+		}
+		_, err := List(context.Background(), c, testOwner)
+		require.ErrorIs(t, err, ErrNotEscrowCreate)
+	})
+
+	t.Run("fail - account objects error", func(t *testing.T) {
+		c := &mockClient{err: errors.New("actNotFound")}
+		_, err := List(context.Background(), c, testOwner)
+		require.EqualError(t, err, "actNotFound")
+	})
+}
+
+func TestOfferSequence(t *testing.T) {
+	const hash = "7B4E8C2AFC15E7A66E6F4D2BA8E4A0B0C2E0FE36C7C2E9D6F0F8F7C35A1A2B3C"
+
+	// A network that added a field the embedded definitions don't know.
+	data, err := os.ReadFile("../../binary-codec/definitions/definitions.json")
+	require.NoError(t, err)
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(data, &doc))
+	doc["FIELDS"] = append(doc["FIELDS"].([]any), []any{"CustomField", map[string]any{
+		"nth":            99,
+		"isVLEncoded":    false,
+		"isSerialized":   true,
+		"isSigningField": true,
+		"type":           "UInt32",
+	}})
+	data, err = json.Marshal(doc)
+	require.NoError(t, err)
+	d, err := definitions.FromJSON(data)
+	require.NoError(t, err)
+
+	blob, err := binarycodec.Encode(map[string]any{
+		"TransactionType": "EscrowCreate",
+		"Account":         string(testOwner),
+		"Destination":     string(testDestination),
+		"Amount":          "10000",
+		"Fee":             "10",
+		"Sequence":        uint32(7),
+		"CustomField":     uint32(1),
+	}, binarycodec.WithDefinitions(d))
+	require.NoError(t, err)
+
+	t.Run("pass - binary transaction decoded with the client definitions", func(t *testing.T) {
+		c := &mockClient{blobs: map[string]string{hash: blob}, definitions: d}
+		sequence, err := OfferSequence(context.Background(), c, hash)
+		require.NoError(t, err)
+		require.Equal(t, uint32(7), sequence)
+	})
+
+	t.Run("fail - binary transaction with the embedded definitions", func(t *testing.T) {
+		c := &mockClient{blobs: map[string]string{hash: blob}}
+		_, err := OfferSequence(context.Background(), c, hash)
+		require.Error(t, err)
+	})
+}
+
+func TestCloseTime(t *testing.T) {
+	c := &mockClient{closeTime: 638329241}
+	closeTime, err := CloseTime(context.Background(), c)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2020, time.March, 24, 1, 40, 41, 0, time.UTC), closeTime.UTC())
+}
+
+func TestEscrow_Status(t *testing.T) {
+	tt := []struct {
+		name        string
+		finishAfter uint32
+		cancelAfter uint32
+		closeTime   uint32
+		expected    Status
+	}{
+		{name: "pending - before FinishAfter", finishAfter: testFinishAfter, cancelAfter: testCancelAfter, closeTime: 999, expected: Pending},
+		{name: "pending - at FinishAfter", finishAfter: testFinishAfter, cancelAfter: testCancelAfter, closeTime: 1000, expected: Pending},
+		{name: "finishable - after FinishAfter", finishAfter: testFinishAfter, cancelAfter: testCancelAfter, closeTime: 1001, expected: Finishable},
+		{name: "finishable - at CancelAfter", finishAfter: testFinishAfter, cancelAfter: testCancelAfter, closeTime: 2000, expected: Finishable},
+		{name: "cancelable - after CancelAfter", finishAfter: testFinishAfter, cancelAfter: testCancelAfter, closeTime: 2001, expected: Cancelable},
+		{name: "finishable - no FinishAfter", cancelAfter: testCancelAfter, closeTime: 1, expected: Finishable},
+		{name: "finishable - no CancelAfter", finishAfter: testFinishAfter, closeTime: 1_000_000, expected: Finishable},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			e := Escrow{Entry: ledgerentry.Escrow{FinishAfter: tc.finishAfter, CancelAfter: tc.cancelAfter}}
+			require.Equal(t, tc.expected, e.Status(rippleTime(tc.closeTime)))
+		})
+	}
+}
+
+func TestEscrow_Schedule(t *testing.T) {
+	e := Escrow{Entry: ledgerentry.Escrow{FinishAfter: testFinishAfter, CancelAfter: testCancelAfter}}
+
+	finishAt, ok := e.FinishableAt()
+	require.True(t, ok)
+	require.Equal(t, rippleTime(testFinishAfter), finishAt)
+
+	cancelAt, ok := e.CancelableAt()
+	require.True(t, ok)
+	require.Equal(t, rippleTime(testCancelAfter), cancelAt)
+
+	_, ok = (&Escrow{}).FinishableAt()
+	require.False(t, ok)
+	_, ok = (&Escrow{}).CancelableAt()
+	require.False(t, ok)
+}
+
+func TestEscrow_FinishTx(t *testing.T) {
+	tt := []struct {
+		name        string
+		condition   string
+		fulfillment string
+		expectedErr error
+	}{
+		{name: "pass - no condition"},
+		{name: "pass - condition and fulfillment", condition: testCondition, fulfillment: testFulfillment},
+		{name: "fail - missing fulfillment", condition: testCondition, expectedErr: ErrFulfillmentRequired},
+		{name: "fail - fulfillment without condition", fulfillment: testFulfillment, expectedErr: ErrNoCondition},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			e := Escrow{
+				Entry:         ledgerentry.Escrow{Account: testOwner, Condition: tc.condition},
+				OfferSequence: 7,
+			}
+			tx, err := e.FinishTx(testDestination, tc.fulfillment)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testDestination, tx.Account)
+			require.Equal(t, transaction.EscrowFinishTx, tx.TransactionType)
+			require.Equal(t, testOwner, tx.Owner)
+			require.Equal(t, uint32(7), tx.OfferSequence)
+			require.Equal(t, tc.condition, tx.Condition)
+			require.Equal(t, tc.condition != "", e.RequiresFulfillment())
+			require.Equal(t, tc.fulfillment, tx.Fulfillment)
+
+			valid, err := tx.Validate()
+			require.NoError(t, err)
+			require.True(t, valid)
+		})
+	}
+}
+
+func TestEscrow_CancelTx(t *testing.T) {
+	t.Run("pass", func(t *testing.T) {
+		e := Escrow{Entry: ledgerentry.Escrow{Account: testOwner, CancelAfter: testCancelAfter}, OfferSequence: 7}
+		tx, err := e.CancelTx(testDestination)
+		require.NoError(t, err)
+		require.Equal(t, testDestination, tx.Account)
+		require.Equal(t, transaction.EscrowCancelTx, tx.TransactionType)
+		require.Equal(t, testOwner, tx.Owner)
+		require.Equal(t, uint32(7), tx.OfferSequence)
+	})
+
+	t.Run("fail - no CancelAfter", func(t *testing.T) {
+		e := Escrow{Entry: ledgerentry.Escrow{Account: testOwner}, OfferSequence: 7}
+		_, err := e.CancelTx(testDestination)
+		require.ErrorIs(t, err, ErrNotCancelable)
+	})
+}
+
+func TestFinishFee(t *testing.T) {
+	tt := []struct {
+		name        string
+		fulfillment string
+		expected    types.XRPCurrencyAmount
+	}{
+		{name: "no fulfillment", expected: 10},
+		// 10 * (33 + 4/16) = 330
+		{name: "empty preimage", fulfillment: testFulfillment, expected: 330},
+		// 10 * (33 + 36/16) = 350
+		{name: "32 byte preimage", fulfillment: "A0228020" + "0000000000000000000000000000000000000000000000000000000000000000", expected: 350},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, FinishFee(10, tc.fulfillment))
+		})
+	}
+}
@@ -14,4 +14,16 @@ package hash
 const (
 	// Transaction plus signature to give transaction ID 'TXN'
 	TransactionPrefix uint32 = 0x54584E00
+	// Transaction plus metadata in a SHAMap leaf 'SND'
+	TransactionNodePrefix uint32 = 0x534E4400
+	// Ledger entry in a SHAMap leaf 'MLN'
+	LeafNodePrefix uint32 = 0x4D4C4E00
+	// Inner node of a SHAMap 'MIN'
+	InnerNodePrefix uint32 = 0x4D494E00
+	// Ledger header to give ledger hash 'LWR'
+	LedgerPrefix uint32 = 0x4C575200
+	// Validation for signing 'VAL'
+	ValidationPrefix uint32 = 0x56414C00
+	// Manifest for signing 'MAN'
+	ManifestPrefix uint32 = 0x4D414E00
 )
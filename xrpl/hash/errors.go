@@ -4,4 +4,6 @@ import "errors"
 
 var (
 	ErrNonSignedTransaction = errors.New("transaction must have at least one of TxnSignature, Signers, or SigningPubKey")
+	ErrInvalidLedgerHash    = errors.New("ledger header hashes must be 64-character hex strings")
+	ErrInvalidTotalCoins    = errors.New("ledger header total coins must be a base-10 integer string")
 )
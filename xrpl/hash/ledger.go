@@ -0,0 +1,39 @@
+package hash
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+)
+
+// LedgerHeader hashes a ledger header
+// It serializes the header in its canonical binary format and returns the ledger hash.
+// It returns an error if a hash or the total coins of the header are invalid.
+func LedgerHeader(header binarycodec.LedgerData) (string, error) {
+	totalCoins, err := strconv.ParseUint(header.TotalCoins, 10, 64)
+	if err != nil {
+		return "", ErrInvalidTotalCoins
+	}
+
+	payload := binary.BigEndian.AppendUint32(nil, LedgerPrefix)
+	payload = binary.BigEndian.AppendUint32(payload, header.LedgerIndex)
+	payload = binary.BigEndian.AppendUint64(payload, totalCoins)
+
+	for _, h := range []string{header.ParentHash, header.TransactionHash, header.AccountHash} {
+		b, err := hex.DecodeString(h)
+		if err != nil || len(b) != 32 {
+			return "", ErrInvalidLedgerHash
+		}
+		payload = append(payload, b...)
+	}
+
+	payload = binary.BigEndian.AppendUint32(payload, header.ParentCloseTime)
+	payload = binary.BigEndian.AppendUint32(payload, header.CloseTime)
+	payload = append(payload, header.CloseTimeResolution, header.CloseFlags)
+
+	return strings.ToUpper(hex.EncodeToString(crypto.Sha512Half(payload))), nil
+}
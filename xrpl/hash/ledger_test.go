@@ -0,0 +1,64 @@
+package hash
+
+import (
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLedgerHeader(t *testing.T) {
+	header := binarycodec.LedgerData{
+		LedgerIndex:         32052277,
+		TotalCoins:          "99994494362043555",
+		ParentHash:          "EACEB081770D8ADE216C85445DD6FB002C6B5A2930F2DECE006DA18150CB18F6",
+		TransactionHash:     "DD33F6F0990754C962A7CCE62F332FF9C13939B03B864117F0BDA86B6E9B4F87",
+		AccountHash:         "3B5C3E520634D343EF5D9D9A4246643D64DAD278BA95DC0EAC6EB5350CF970D5",
+		ParentCloseTime:     556231902,
+		CloseTime:           556231910,
+		CloseTimeResolution: 10,
+		CloseFlags:          0,
+	}
+
+	tests := []struct {
+		name        string
+		mutate      func(h *binarycodec.LedgerData)
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:     "pass - valid header",
+			mutate:   func(*binarycodec.LedgerData) {},
+			expected: "7309471F39EDB5288202C16DDF473B2B58B103BFE4BC947BF080FB7CB0D25A3E",
+		},
+		{
+			name:        "fail - invalid total coins",
+			mutate:      func(h *binarycodec.LedgerData) { h.TotalCoins = "1.5" },
+			expectedErr: ErrInvalidTotalCoins,
+		},
+		{
+			name:        "fail - invalid parent hash",
+			mutate:      func(h *binarycodec.LedgerData) { h.ParentHash = "EACEB081" },
+			expectedErr: ErrInvalidLedgerHash,
+		},
+		{
+			name:        "fail - invalid account hash",
+			mutate:      func(h *binarycodec.LedgerData) { h.AccountHash = "invalid" },
+			expectedErr: ErrInvalidLedgerHash,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := header
+			tt.mutate(&h)
+			actual, err := LedgerHeader(h)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
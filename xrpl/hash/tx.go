@@ -21,8 +21,9 @@ var (
 // SignTxBlob hashes a signed transaction blob
 // It takes a transaction blob and returns the hash of the signed transaction.
 // It returns an error if the transaction blob is invalid.
-func SignTxBlob(txBlob string) (string, error) {
-	tx, err := binarycodec.Decode(txBlob)
+// The options are passed to the binary codec, for example to decode with custom definitions.
+func SignTxBlob(txBlob string, opts ...binarycodec.Option) (string, error) {
+	tx, err := binarycodec.Decode(txBlob, opts...)
 	if err != nil {
 		return "", err
 	}
@@ -37,12 +38,13 @@ func SignTxBlob(txBlob string) (string, error) {
 // SignTx hashes a signed transaction
 // It takes a signed transaction and returns the hash of the signed transaction.
 // It returns an error if the transaction is invalid.
-func SignTx(tx map[string]interface{}) (string, error) {
+// The options are passed to the binary codec, for example to encode with custom definitions.
+func SignTx(tx map[string]interface{}, opts ...binarycodec.Option) (string, error) {
 	if valid, err := isTxValid(tx); !valid {
 		return "", err
 	}
 
-	txBlob, err := binarycodec.Encode(tx)
+	txBlob, err := binarycodec.Encode(tx, opts...)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,49 @@
+package keylet
+
+// Ledger namespaces.
+//
+// Each ledger object ID is the SHA-512Half of a 2-byte namespace followed by
+// the fields that identify the object. The namespace keeps objects of
+// different types that are identified by the same fields from colliding.
+// The values match the LedgerNameSpace enumeration of rippled.
+
+const (
+	accountSpace                    uint16 = 'a'
+	dirNodeSpace                    uint16 = 'd'
+	trustLineSpace                  uint16 = 'r'
+	offerSpace                      uint16 = 'o'
+	ownerDirSpace                   uint16 = 'O'
+	bookDirSpace                    uint16 = 'B'
+	skipListSpace                   uint16 = 's'
+	escrowSpace                     uint16 = 'u'
+	amendmentsSpace                 uint16 = 'f'
+	feeSettingsSpace                uint16 = 'e'
+	ticketSpace                     uint16 = 'T'
+	signerListSpace                 uint16 = 'S'
+	paymentChannelSpace             uint16 = 'x'
+	checkSpace                      uint16 = 'C'
+	depositPreauthSpace             uint16 = 'p'
+	negativeUNLSpace                uint16 = 'N'
+	nftokenOfferSpace               uint16 = 'q'
+	nftokenBuyOffersSpace           uint16 = 'h'
+	nftokenSellOffersSpace          uint16 = 'i'
+	ammSpace                        uint16 = 'A'
+	bridgeSpace                     uint16 = 'H'
+	xchainClaimIDSpace              uint16 = 'Q'
+	xchainCreateAccountClaimIDSpace uint16 = 'K'
+	didSpace                        uint16 = 'I'
+	oracleSpace                     uint16 = 'R'
+	mptokenIssuanceSpace            uint16 = '~'
+	mptokenSpace                    uint16 = 't'
+	credentialSpace                 uint16 = 'D'
+	permissionedDomainSpace         uint16 = 'm'
+	delegateSpace                   uint16 = 'E'
+)
+
+const (
+	// The index of the only signer list of an account. Reserved for future use
+	// of multiple signer lists.
+	signerListID uint32 = 0
+	// The number of low bits of an NFToken ID that select its NFTokenPage.
+	nftokenPageBytes = 12
+)
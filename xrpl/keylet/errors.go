@@ -0,0 +1,13 @@
+package keylet
+
+import "errors"
+
+var (
+	ErrInvalidAddress           = errors.New("invalid classic address")
+	ErrInvalidCurrency          = errors.New("currency must be a 3-character code or a 40-character hex string")
+	ErrInvalidHash              = errors.New("hash must be a 64-character hex string")
+	ErrInvalidNFTokenID         = errors.New("nftoken id must be a 64-character hex string")
+	ErrInvalidMPTokenIssuanceID = errors.New("mpt issuance id must be a 48-character hex string")
+	ErrInvalidCredentialType    = errors.New("credential type must be a non-empty hex string of at most 64 bytes")
+	ErrSameAccounts             = errors.New("a trust line needs two different accounts")
+)
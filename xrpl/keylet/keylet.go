@@ -0,0 +1,508 @@
+package keylet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// XChainBridge identifies a cross-chain bridge by its door accounts and assets.
+type XChainBridge struct {
+	LockingChainDoor  types.Address
+	LockingChainIssue ledger.Asset
+	IssuingChainDoor  types.Address
+	IssuingChainIssue ledger.Asset
+}
+
+// ############################################################################
+// Singletons
+// ############################################################################
+
+// Amendments returns the ID of the Amendments ledger entry.
+func Amendments() string {
+	return indexHash(amendmentsSpace)
+}
+
+// FeeSettings returns the ID of the FeeSettings ledger entry.
+func FeeSettings() string {
+	return indexHash(feeSettingsSpace)
+}
+
+// NegativeUNL returns the ID of the NegativeUNL ledger entry.
+func NegativeUNL() string {
+	return indexHash(negativeUNLSpace)
+}
+
+// LedgerHashes returns the ID of the LedgerHashes entry holding the hashes of
+// the most recent 256 ledgers.
+func LedgerHashes() string {
+	return indexHash(skipListSpace)
+}
+
+// LedgerHashesFor returns the ID of the LedgerHashes entry holding the hash of the
+// flag ledger ledgerSeq. Each of these entries covers 65536 ledgers.
+func LedgerHashesFor(ledgerSeq uint32) string {
+	return indexHash(skipListSpace, uint32Bytes(ledgerSeq>>16))
+}
+
+// ############################################################################
+// Accounts
+// ############################################################################
+
+// AccountRoot returns the ID of the AccountRoot entry of account.
+func AccountRoot(account types.Address) (string, error) {
+	id, err := accountID(account)
+	if err != nil {
+		return "", err
+	}
+	return indexHash(accountSpace, id), nil
+}
+
+// SignerList returns the ID of the SignerList entry of account.
+func SignerList(account types.Address) (string, error) {
+	id, err := accountID(account)
+	if err != nil {
+		return "", err
+	}
+	return indexHash(signerListSpace, id, uint32Bytes(signerListID)), nil
+}
+
+// Ticket returns the ID of the Ticket entry created by account with ticketSeq.
+func Ticket(account types.Address, ticketSeq uint32) (string, error) {
+	return accountSequence(ticketSpace, account, ticketSeq)
+}
+
+// DepositPreauth returns the ID of the DepositPreauth entry by which owner
+// preauthorizes the authorized account.
+func DepositPreauth(owner, authorized types.Address) (string, error) {
+	return accountPair(depositPreauthSpace, owner, authorized)
+}
+
+// Delegate returns the ID of the Delegate entry by which account delegates
+// permissions to the authorized account.
+func Delegate(account, authorized types.Address) (string, error) {
+	return accountPair(delegateSpace, account, authorized)
+}
+
+// DID returns the ID of the DID entry of account.
+func DID(account types.Address) (string, error) {
+	id, err := accountID(account)
+	if err != nil {
+		return "", err
+	}
+	return indexHash(didSpace, id), nil
+}
+
+// ############################################################################
+// Directories
+// ############################################################################
+
+// OwnerDirectory returns the ID of the root page of the owner directory of owner.
+func OwnerDirectory(owner types.Address) (string, error) {
+	id, err := accountID(owner)
+	if err != nil {
+		return "", err
+	}
+	return indexHash(ownerDirSpace, id), nil
+}
+
+// DirectoryPage returns the ID of page number page of the directory with the
+// given root ID. The first page of a directory is its root.
+func DirectoryPage(root string, page uint64) (string, error) {
+	rootBytes, err := hash256(root)
+	if err != nil {
+		return "", err
+	}
+	if page == 0 {
+		return strings.ToUpper(hex.EncodeToString(rootBytes)), nil
+	}
+	return indexHash(dirNodeSpace, rootBytes, uint64Bytes(page)), nil
+}
+
+// BookBase returns the base ID of the order book directories of offers that
+// take takerPays in exchange for takerGets. The directory of each exchange
+// rate is found with Quality.
+func BookBase(takerPays, takerGets ledger.Asset) (string, error) {
+	pays, err := issueBytes(takerPays)
+	if err != nil {
+		return "", err
+	}
+	gets, err := issueBytes(takerGets)
+	if err != nil {
+		return "", err
+	}
+	// The currencies of both sides come before their issuers.
+	return Quality(indexHash(bookDirSpace, pays[:20], gets[:20], pays[20:], gets[20:]), 0)
+}
+
+// Quality returns the ID of the order book directory with the given base ID
+// holding offers at quality. The quality replaces the last 8 bytes of the base.
+func Quality(base string, quality uint64) (string, error) {
+	b, err := hash256(base)
+	if err != nil {
+		return "", err
+	}
+	binary.BigEndian.PutUint64(b[24:], quality)
+	return strings.ToUpper(hex.EncodeToString(b)), nil
+}
+
+// ############################################################################
+// Payments and trading
+// ############################################################################
+
+// RippleState returns the ID of the trust line between two accounts in
+// currency. The order of the accounts does not matter.
+func RippleState(account1, account2 types.Address, currency string) (string, error) {
+	id1, err := accountID(account1)
+	if err != nil {
+		return "", err
+	}
+	id2, err := accountID(account2)
+	if err != nil {
+		return "", err
+	}
+	cmp := bytes.Compare(id1, id2)
+	if cmp == 0 {
+		return "", ErrSameAccounts
+	}
+	if cmp > 0 {
+		id1, id2 = id2, id1
+	}
+	cur, err := currencyBytes(currency)
+	if err != nil {
+		return "", err
+	}
+	return indexHash(trustLineSpace, id1, id2, cur), nil
+}
+
+// Offer returns the ID of the Offer entry created by account with seq.
+func Offer(account types.Address, seq uint32) (string, error) {
+	return accountSequence(offerSpace, account, seq)
+}
+
+// Escrow returns the ID of the Escrow entry created by owner with seq.
+func Escrow(owner types.Address, seq uint32) (string, error) {
+	return accountSequence(escrowSpace, owner, seq)
+}
+
+// Check returns the ID of the Check entry created by account with seq.
+func Check(account types.Address, seq uint32) (string, error) {
+	return accountSequence(checkSpace, account, seq)
+}
+
+// PayChannel returns the ID of the PayChannel entry from source to destination
+// created with seq.
+func PayChannel(source, destination types.Address, seq uint32) (string, error) {
+	src, err := accountID(source)
+	if err != nil {
+		return "", err
+	}
+	dst, err := accountID(destination)
+	if err != nil {
+		return "", err
+	}
+	return indexHash(paymentChannelSpace, src, dst, uint32Bytes(seq)), nil
+}
+
+// AMM returns the ID of the AMM entry of the pool of asset1 and asset2. The
+// order of the assets does not matter.
+func AMM(asset1, asset2 ledger.Asset) (string, error) {
+	a, err := issueBytes(asset1)
+	if err != nil {
+		return "", err
+	}
+	b, err := issueBytes(asset2)
+	if err != nil {
+		return "", err
+	}
+	// Issues are ordered by currency first and then by issuer, but hashed as
+	// issuer followed by currency.
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	return indexHash(ammSpace, a[20:], a[:20], b[20:], b[:20]), nil
+}
+
+// ############################################################################
+// NFTokens
+// ############################################################################
+
+// NFTokenPageMin returns the lowest possible NFTokenPage ID of owner.
+func NFTokenPageMin(owner types.Address) (string, error) {
+	id, err := accountID(owner)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToUpper(hex.EncodeToString(id) + strings.Repeat("00", nftokenPageBytes)), nil
+}
+
+// NFTokenPageMax returns the highest possible NFTokenPage ID of owner. This is
+// the ID of the last page of the owner's NFTokens.
+func NFTokenPageMax(owner types.Address) (string, error) {
+	id, err := accountID(owner)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToUpper(hex.EncodeToString(id) + strings.Repeat("FF", nftokenPageBytes)), nil
+}
+
+// NFTokenPage returns the lowest ID that the page of owner holding nftokenID can have.
+// The token is held by the first page of the owner with an ID greater than or equal to it.
+func NFTokenPage(owner types.Address, nftokenID string) (string, error) {
+	id, err := accountID(owner)
+	if err != nil {
+		return "", err
+	}
+	token, err := hex.DecodeString(nftokenID)
+	if err != nil || len(token) != 32 {
+		return "", ErrInvalidNFTokenID
+	}
+	return strings.ToUpper(hex.EncodeToString(append(id, token[32-nftokenPageBytes:]...))), nil
+}
+
+// NFTokenOffer returns the ID of the NFTokenOffer entry created by owner with seq.
+func NFTokenOffer(owner types.Address, seq uint32) (string, error) {
+	return accountSequence(nftokenOfferSpace, owner, seq)
+}
+
+// NFTokenBuyOffers returns the ID of the directory of buy offers for nftokenID.
+func NFTokenBuyOffers(nftokenID string) (string, error) {
+	return nftokenOffers(nftokenBuyOffersSpace, nftokenID)
+}
+
+// NFTokenSellOffers returns the ID of the directory of sell offers for nftokenID.
+func NFTokenSellOffers(nftokenID string) (string, error) {
+	return nftokenOffers(nftokenSellOffersSpace, nftokenID)
+}
+
+func nftokenOffers(space uint16, nftokenID string) (string, error) {
+	token, err := hex.DecodeString(nftokenID)
+	if err != nil || len(token) != 32 {
+		return "", ErrInvalidNFTokenID
+	}
+	return indexHash(space, token), nil
+}
+
+// ############################################################################
+// Multi-purpose tokens
+// ############################################################################
+
+// MPTokenIssuanceID returns the MPTokenIssuanceID of the issuance created by
+// issuer with seq, the big-endian sequence followed by the account ID of the issuer.
+func MPTokenIssuanceID(issuer types.Address, seq uint32) (string, error) {
+	id, err := accountID(issuer)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToUpper(hex.EncodeToString(append(uint32Bytes(seq), id...))), nil
+}
+
+// MPTokenIssuance returns the ID of the MPTokenIssuance entry with the given
+// MPTokenIssuanceID.
+func MPTokenIssuance(issuanceID string) (string, error) {
+	id, err := hex.DecodeString(issuanceID)
+	if err != nil || len(id) != 24 {
+		return "", ErrInvalidMPTokenIssuanceID
+	}
+	return indexHash(mptokenIssuanceSpace, id), nil
+}
+
+// MPToken returns the ID of the MPToken entry holding the balance of holder in
+// the issuance with the given MPTokenIssuanceID.
+func MPToken(issuanceID string, holder types.Address) (string, error) {
+	issuance, err := MPTokenIssuance(issuanceID)
+	if err != nil {
+		return "", err
+	}
+	issuanceKey, err := hex.DecodeString(issuance)
+	if err != nil {
+		return "", err
+	}
+	id, err := accountID(holder)
+	if err != nil {
+		return "", err
+	}
+	return indexHash(mptokenSpace, issuanceKey, id), nil
+}
+
+// ############################################################################
+// Oracles, credentials and domains
+// ############################################################################
+
+// Oracle returns the ID of the Oracle entry of owner with documentID.
+func Oracle(owner types.Address, documentID uint32) (string, error) {
+	return accountSequence(oracleSpace, owner, documentID)
+}
+
+// Credential returns the ID of the Credential entry issued by issuer to
+// subject. The credential type is given as a hex string.
+func Credential(subject, issuer types.Address, credentialType string) (string, error) {
+	sub, err := accountID(subject)
+	if err != nil {
+		return "", err
+	}
+	iss, err := accountID(issuer)
+	if err != nil {
+		return "", err
+	}
+	credType, err := hex.DecodeString(credentialType)
+	if err != nil || len(credType) == 0 || len(credType) > 64 {
+		return "", ErrInvalidCredentialType
+	}
+	return indexHash(credentialSpace, sub, iss, credType), nil
+}
+
+// PermissionedDomain returns the ID of the PermissionedDomain entry created by
+// owner with seq.
+func PermissionedDomain(owner types.Address, seq uint32) (string, error) {
+	return accountSequence(permissionedDomainSpace, owner, seq)
+}
+
+// ############################################################################
+// Cross-chain bridges
+// ############################################################################
+
+// Bridge returns the ID of the Bridge entry owned by door for a bridge
+// transferring issue. Only the currency of issue is part of the ID.
+func Bridge(door types.Address, issue ledger.Asset) (string, error) {
+	id, err := accountID(door)
+	if err != nil {
+		return "", err
+	}
+	cur, err := currencyBytes(issue.Currency)
+	if err != nil {
+		return "", err
+	}
+	return indexHash(bridgeSpace, id, cur), nil
+}
+
+// XChainOwnedClaimID returns the ID of the XChainOwnedClaimID entry of bridge
+// with claimID.
+func XChainOwnedClaimID(bridge XChainBridge, claimID uint64) (string, error) {
+	return xchainSequence(xchainClaimIDSpace, bridge, claimID)
+}
+
+// XChainOwnedCreateAccountClaimID returns the ID of the
+// XChainOwnedCreateAccountClaimID entry of bridge with the given account create count.
+func XChainOwnedCreateAccountClaimID(bridge XChainBridge, count uint64) (string, error) {
+	return xchainSequence(xchainCreateAccountClaimIDSpace, bridge, count)
+}
+
+func xchainSequence(space uint16, bridge XChainBridge, seq uint64) (string, error) {
+	lockingDoor, err := accountID(bridge.LockingChainDoor)
+	if err != nil {
+		return "", err
+	}
+	lockingIssue, err := issueBytes(bridge.LockingChainIssue)
+	if err != nil {
+		return "", err
+	}
+	issuingDoor, err := accountID(bridge.IssuingChainDoor)
+	if err != nil {
+		return "", err
+	}
+	issuingIssue, err := issueBytes(bridge.IssuingChainIssue)
+	if err != nil {
+		return "", err
+	}
+	return indexHash(space, lockingDoor, lockingIssue, issuingDoor, issuingIssue, uint64Bytes(seq)), nil
+}
+
+// ############################################################################
+// Helpers
+// ############################################################################
+
+// indexHash returns the uppercase hex SHA-512Half of the namespace followed by parts.
+func indexHash(space uint16, parts ...[]byte) string {
+	payload := binary.BigEndian.AppendUint16(nil, space)
+	for _, p := range parts {
+		payload = append(payload, p...)
+	}
+	return strings.ToUpper(hex.EncodeToString(crypto.Sha512Half(payload)))
+}
+
+func accountSequence(space uint16, account types.Address, seq uint32) (string, error) {
+	id, err := accountID(account)
+	if err != nil {
+		return "", err
+	}
+	return indexHash(space, id, uint32Bytes(seq)), nil
+}
+
+func accountPair(space uint16, account1, account2 types.Address) (string, error) {
+	id1, err := accountID(account1)
+	if err != nil {
+		return "", err
+	}
+	id2, err := accountID(account2)
+	if err != nil {
+		return "", err
+	}
+	return indexHash(space, id1, id2), nil
+}
+
+func accountID(address types.Address) ([]byte, error) {
+	_, id, err := addresscodec.DecodeClassicAddressToAccountID(address.String())
+	if err != nil {
+		return nil, ErrInvalidAddress
+	}
+	return id, nil
+}
+
+// currencyBytes encodes a currency code into its 160-bit form. XRP is all zeros.
+func currencyBytes(currency string) ([]byte, error) {
+	b := make([]byte, 20)
+	switch len(currency) {
+	case 3:
+		if currency != "XRP" {
+			copy(b[12:], currency)
+		}
+		return b, nil
+	case 40:
+		if _, err := hex.Decode(b, []byte(currency)); err != nil {
+			return nil, ErrInvalidCurrency
+		}
+		return b, nil
+	default:
+		return nil, ErrInvalidCurrency
+	}
+}
+
+// issueBytes encodes an asset as its currency followed by its issuer. The issuer
+// of XRP is all zeros.
+func issueBytes(asset ledger.Asset) ([]byte, error) {
+	cur, err := currencyBytes(asset.Currency)
+	if err != nil {
+		return nil, err
+	}
+	if asset.Issuer == "" {
+		return append(cur, make([]byte, 20)...), nil
+	}
+	id, err := accountID(asset.Issuer)
+	if err != nil {
+		return nil, err
+	}
+	return append(cur, id...), nil
+}
+
+func hash256(h string) ([]byte, error) {
+	b, err := hex.DecodeString(h)
+	if err != nil || len(b) != 32 {
+		return nil, ErrInvalidHash
+	}
+	return b, nil
+}
+
+func uint32Bytes(v uint32) []byte {
+	return binary.BigEndian.AppendUint32(nil, v)
+}
+
+func uint64Bytes(v uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, v)
+}
@@ -0,0 +1,243 @@
+package keylet
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSingletons(t *testing.T) {
+	require.Equal(t, "7DB0788C020F02780A673DC74757F23823FA3014C1866E72CC4CD8B226CD6EF4", Amendments())
+	require.Equal(t, "4BC50C9B0D8515D3EAAE1E74B29A95804346C491EE1A95BF25E4AAB854A6A651", FeeSettings())
+	require.Equal(t, "2E8A59AA9D3B5B186B0B9E0F62E6C02587CA74A4D778938E957B6357D364B244", NegativeUNL())
+	require.Equal(t, "B4979A36CDC7F3D3D5C31A4EAE2AC7D7209DDA877588B9AFC66799692AB0D66B", LedgerHashes())
+	require.Equal(t, LedgerHashesFor(65536), LedgerHashesFor(131071))
+	require.NotEqual(t, LedgerHashesFor(65535), LedgerHashesFor(65536))
+}
+
+func TestKeylet(t *testing.T) {
+	tests := []struct {
+		name     string
+		keylet   func() (string, error)
+		expected string
+	}{
+		{
+			name:     "account root",
+			keylet:   func() (string, error) { return AccountRoot("rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn") },
+			expected: "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+		},
+		{
+			name:     "signer list",
+			keylet:   func() (string, error) { return SignerList("rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn") },
+			expected: "A9C28A28B85CD533217F5C0A0C7767666B093FA58A0F2D80026FCC4CD932DDC7",
+		},
+		{
+			name: "ripple state",
+			keylet: func() (string, error) {
+				return RippleState("rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn", "rsA2LpzuawewSBQXkiju3YQTMzW13pAAdW", "USD")
+			},
+			expected: "9CA88CDEDFF9252B3DE183CE35B038F57282BC9503CDFA1923EF9A95DF0D6F7B",
+		},
+		{
+			name: "ripple state, reversed accounts",
+			keylet: func() (string, error) {
+				return RippleState("rsA2LpzuawewSBQXkiju3YQTMzW13pAAdW", "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn", "USD")
+			},
+			expected: "9CA88CDEDFF9252B3DE183CE35B038F57282BC9503CDFA1923EF9A95DF0D6F7B",
+		},
+		{
+			name:     "offer",
+			keylet:   func() (string, error) { return Offer("rBqb89MRQJnMPq8wTwEbtz4kvxrEDfcYvt", 866) },
+			expected: "96F76F27D8A327FC48753167EC04A46AA0E382E6F57F32FD12274144D00F1797",
+		},
+		{
+			name:     "escrow",
+			keylet:   func() (string, error) { return Escrow("rDx69ebzbowuqztksVDmZXjizTd12BVr4x", 84) },
+			expected: "61E8E8ED53FA2CEBE192B23897071E9A75217BF5A410E9CB5B45AAB7AECA567A",
+		},
+		{
+			name: "pay channel",
+			keylet: func() (string, error) {
+				return PayChannel("rDx69ebzbowuqztksVDmZXjizTd12BVr4x", "rLFtVprxUEfsH54eCWKsZrEQzMDsx1wqso", 82)
+			},
+			expected: "E35708503B3C3143FB522D749AAFCC296E8060F0FB371A9A56FAE0B1ED127366",
+		},
+		{
+			name: "deposit preauth",
+			keylet: func() (string, error) {
+				return DepositPreauth("rsUiUMpnrgxQp24dJYZDhmV4bE3aBtQyt8", "rEhxGqkqPPSxQ3P25J66ft5TwpzV14k2de")
+			},
+			expected: "4A255038CC3ADCC1A9C91509279B59908251728D0DAADB248FFE297D0F7E068C",
+		},
+		{
+			name:     "did",
+			keylet:   func() (string, error) { return DID("rpfqJrXg5uidNo2ZsRhRY6TiF1cvYmV9Fg") },
+			expected: "46813BE38B798B3752CA590D44E7FEADB17485649074403AD1761A2835CE91FF",
+		},
+		{
+			name: "book base",
+			keylet: func() (string, error) {
+				return BookBase(ledger.Asset{Currency: "USD", Issuer: "rhub8VRN55s94qWKDv6jmDy1pUykJzF3wq"}, ledger.Asset{Currency: "XRP"})
+			},
+			expected: "79C54A4EBD69AB2EADCE313042F36092BE432423CC6A4F780000000000000000",
+		},
+		{
+			name: "book directory at quality",
+			keylet: func() (string, error) {
+				return Quality("79C54A4EBD69AB2EADCE313042F36092BE432423CC6A4F780000000000000000", 0x4E133C40576F7C00)
+			},
+			expected: "79C54A4EBD69AB2EADCE313042F36092BE432423CC6A4F784E133C40576F7C00",
+		},
+		{
+			name: "directory root page",
+			keylet: func() (string, error) {
+				return DirectoryPage("79c54a4ebd69ab2eadce313042f36092be432423cc6a4f784e133c40576f7c00", 0)
+			},
+			expected: "79C54A4EBD69AB2EADCE313042F36092BE432423CC6A4F784E133C40576F7C00",
+		},
+		{
+			name: "nftoken page min",
+			keylet: func() (string, error) {
+				return NFTokenPageMin("rhub8VRN55s94qWKDv6jmDy1pUykJzF3wq")
+			},
+			expected: "2ADB0B3959D60A6E6991F729E1918B7163925230000000000000000000000000",
+		},
+		{
+			name: "nftoken page max",
+			keylet: func() (string, error) {
+				return NFTokenPageMax("rhub8VRN55s94qWKDv6jmDy1pUykJzF3wq")
+			},
+			expected: "2ADB0B3959D60A6E6991F729E1918B7163925230FFFFFFFFFFFFFFFFFFFFFFFF",
+		},
+		{
+			name: "nftoken page",
+			keylet: func() (string, error) {
+				return NFTokenPage("rhub8VRN55s94qWKDv6jmDy1pUykJzF3wq", "000B013A95F14B0044F78A264E41713C64B5F89242540EE208C3098E00000D65")
+			},
+			expected: "2ADB0B3959D60A6E6991F729E1918B716392523042540EE208C3098E00000D65",
+		},
+		{
+			name: "mpt issuance id",
+			keylet: func() (string, error) {
+				return MPTokenIssuanceID("rhub8VRN55s94qWKDv6jmDy1pUykJzF3wq", 1220)
+			},
+			expected: "000004C42ADB0B3959D60A6E6991F729E1918B7163925230",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := tt.keylet()
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestAMM_AssetOrder(t *testing.T) {
+	xrp := ledger.Asset{Currency: "XRP"}
+	usd := ledger.Asset{Currency: "USD", Issuer: "rhub8VRN55s94qWKDv6jmDy1pUykJzF3wq"}
+
+	id, err := AMM(xrp, usd)
+	require.NoError(t, err)
+	reversed, err := AMM(usd, xrp)
+	require.NoError(t, err)
+	require.Equal(t, id, reversed)
+	require.Len(t, id, 64)
+}
+
+func TestMPToken(t *testing.T) {
+	issuanceID, err := MPTokenIssuanceID("rhub8VRN55s94qWKDv6jmDy1pUykJzF3wq", 1220)
+	require.NoError(t, err)
+
+	issuance, err := MPTokenIssuance(issuanceID)
+	require.NoError(t, err)
+	require.Len(t, issuance, 64)
+
+	holder, err := MPToken(issuanceID, "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn")
+	require.NoError(t, err)
+	require.NotEqual(t, issuance, holder)
+
+	other, err := MPToken(issuanceID, "rsA2LpzuawewSBQXkiju3YQTMzW13pAAdW")
+	require.NoError(t, err)
+	require.NotEqual(t, holder, other)
+}
+
+func TestKeylet_Namespaces(t *testing.T) {
+	const account = "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn"
+
+	ids := map[string]string{}
+	for name, keylet := range map[string]func(types.Address, uint32) (string, error){
+		"offer":               Offer,
+		"escrow":              Escrow,
+		"check":               Check,
+		"ticket":              Ticket,
+		"nftoken offer":       NFTokenOffer,
+		"oracle":              Oracle,
+		"permissioned domain": PermissionedDomain,
+	} {
+		id, err := keylet(account, 1)
+		require.NoError(t, err, name)
+		require.NotContains(t, ids, id, name)
+		ids[id] = name
+	}
+}
+
+func TestKeylet_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		keylet func() (string, error)
+		err    error
+	}{
+		{
+			name:   "invalid address",
+			keylet: func() (string, error) { return AccountRoot("invalid") },
+			err:    ErrInvalidAddress,
+		},
+		{
+			name: "same trust line accounts",
+			keylet: func() (string, error) {
+				return RippleState("rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn", "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn", "USD")
+			},
+			err: ErrSameAccounts,
+		},
+		{
+			name: "invalid currency",
+			keylet: func() (string, error) {
+				return RippleState("rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn", "rsA2LpzuawewSBQXkiju3YQTMzW13pAAdW", "DOLLAR")
+			},
+			err: ErrInvalidCurrency,
+		},
+		{
+			name:   "invalid directory root",
+			keylet: func() (string, error) { return DirectoryPage("ABCD", 1) },
+			err:    ErrInvalidHash,
+		},
+		{
+			name:   "invalid nftoken id",
+			keylet: func() (string, error) { return NFTokenBuyOffers("ABCD") },
+			err:    ErrInvalidNFTokenID,
+		},
+		{
+			name:   "invalid mpt issuance id",
+			keylet: func() (string, error) { return MPToken("ABCD", "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn") },
+			err:    ErrInvalidMPTokenIssuanceID,
+		},
+		{
+			name: "empty credential type",
+			keylet: func() (string, error) {
+				return Credential("rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn", "rsA2LpzuawewSBQXkiju3YQTMzW13pAAdW", "")
+			},
+			err: ErrInvalidCredentialType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.keylet()
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package ledger
 
 import (
+	"encoding/json"
+
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -182,3 +184,71 @@ type VoteEntry struct {
 func (*AMM) EntryType() EntryType {
 	return AMMEntry
 }
+
+// Unmarshals the AMM from a JSON byte slice.
+func (a *AMM) UnmarshalJSON(data []byte) error {
+	type ammHelper struct {
+		Index             types.Hash256 `json:"index,omitempty"`
+		LedgerEntryType   string
+		Flags             uint32
+		Account           types.Address
+		Asset             Asset
+		Asset2            Asset
+		AuctionSlot       AuctionSlot
+		LPTokenBalance    json.RawMessage
+		TradingFee        uint16
+		VoteSlots         []VoteSlots
+		PreviousTxnID     types.Hash256
+		PreviousTxnLgrSeq uint32
+	}
+	var h ammHelper
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*a = AMM{
+		Index:             h.Index,
+		LedgerEntryType:   h.LedgerEntryType,
+		Flags:             h.Flags,
+		Account:           h.Account,
+		Asset:             h.Asset,
+		Asset2:            h.Asset2,
+		AuctionSlot:       h.AuctionSlot,
+		TradingFee:        h.TradingFee,
+		VoteSlots:         h.VoteSlots,
+		PreviousTxnID:     h.PreviousTxnID,
+		PreviousTxnLgrSeq: h.PreviousTxnLgrSeq,
+	}
+	balance, err := types.UnmarshalCurrencyAmount(h.LPTokenBalance)
+	if err != nil {
+		return err
+	}
+	a.LPTokenBalance = balance
+	return nil
+}
+
+// Unmarshals the auction slot from a JSON byte slice.
+func (s *AuctionSlot) UnmarshalJSON(data []byte) error {
+	type auctionSlotHelper struct {
+		Account       types.Address
+		AuthAccounts  []AuthAccounts
+		DiscountedFee uint16
+		Price         json.RawMessage
+		Expiration    uint32
+	}
+	var h auctionSlotHelper
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*s = AuctionSlot{
+		Account:       h.Account,
+		AuthAccounts:  h.AuthAccounts,
+		DiscountedFee: h.DiscountedFee,
+		Expiration:    h.Expiration,
+	}
+	price, err := types.UnmarshalCurrencyAmount(h.Price)
+	if err != nil {
+		return err
+	}
+	s.Price = price
+	return nil
+}
@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAssetFlatten(t *testing.T) {
@@ -73,3 +75,81 @@ func TestAuthAccount_Flatten(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestAMM_Unmarshal(t *testing.T) {
+	data := `{
+	"Account": "rE54zDvgnghAoPopCgvtiqWNq3dU5y836S",
+	"Asset": {
+		"currency": "XRP"
+	},
+	"Asset2": {
+		"currency": "TST",
+		"issuer": "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd"
+	},
+	"AuctionSlot": {
+		"Account": "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm",
+		"AuthAccounts": [
+			{
+				"AuthAccount": {
+					"Account": "rMKXGCbJ5d8LbrqthdG46q3f969MVK2Qeg"
+				}
+			}
+		],
+		"DiscountedFee": 60,
+		"Expiration": 721870180,
+		"Price": {
+			"currency": "039C99CD9AB0B70B32ECDA51EAAE471625608EA2",
+			"issuer": "rE54zDvgnghAoPopCgvtiqWNq3dU5y836S",
+			"value": "0.8696263565463045"
+		}
+	},
+	"Flags": 0,
+	"LPTokenBalance": {
+		"currency": "039C99CD9AB0B70B32ECDA51EAAE471625608EA2",
+		"issuer": "rE54zDvgnghAoPopCgvtiqWNq3dU5y836S",
+		"value": "71150.53584131501"
+	},
+	"LedgerEntryType": "AMM",
+	"TradingFee": 600,
+	"VoteSlots": [
+		{
+			"VoteEntry": {
+				"Account": "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm",
+				"TradingFee": 600,
+				"VoteWeight": 100000
+			}
+		}
+	]
+}`
+
+	obj, err := UnmarshalLedgerObject([]byte(data))
+	require.NoError(t, err)
+	require.Equal(t, &AMM{
+		LedgerEntryType: "AMM",
+		Account:         "rE54zDvgnghAoPopCgvtiqWNq3dU5y836S",
+		Asset:           Asset{Currency: "XRP"},
+		Asset2:          Asset{Currency: "TST", Issuer: "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd"},
+		AuctionSlot: AuctionSlot{
+			Account: "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm",
+			AuthAccounts: []AuthAccounts{
+				{AuthAccount: AuthAccount{Account: "rMKXGCbJ5d8LbrqthdG46q3f969MVK2Qeg"}},
+			},
+			DiscountedFee: 60,
+			Expiration:    721870180,
+			Price: types.IssuedCurrencyAmount{
+				Currency: "039C99CD9AB0B70B32ECDA51EAAE471625608EA2",
+				Issuer:   "rE54zDvgnghAoPopCgvtiqWNq3dU5y836S",
+				Value:    "0.8696263565463045",
+			},
+		},
+		LPTokenBalance: types.IssuedCurrencyAmount{
+			Currency: "039C99CD9AB0B70B32ECDA51EAAE471625608EA2",
+			Issuer:   "rE54zDvgnghAoPopCgvtiqWNq3dU5y836S",
+			Value:    "71150.53584131501",
+		},
+		TradingFee: 600,
+		VoteSlots: []VoteSlots{
+			{VoteEntry: VoteEntry{Account: "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm", TradingFee: 600, VoteWeight: 100000}},
+		},
+	}, obj)
+}
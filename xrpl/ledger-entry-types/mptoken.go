@@ -0,0 +1,68 @@
+package ledger
+
+import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+const (
+	// If enabled, the holder is authorized by the issuer to hold the token. Only used when
+	// the issuance requires authorization.
+	lsfMPTAuthorized uint32 = 0x00000002
+)
+
+// An MPToken entry tracks the balance of a Multi-Purpose Token (MPT) held by an account
+// that is not the issuer. You can create an MPToken entry with an MPTokenAuthorize
+// transaction.
+// Requires the MPTokensV1 amendment to be enabled.
+//
+// ```json
+//
+//	{
+//	    "LedgerEntryType": "MPToken",
+//	    "Account": "rajgkBmMxmz161r8bWYH7CQAFZP5bA9oSG",
+//	    "Flags": 0,
+//	    "MPTokenIssuanceID": "000004C463C52827307480341125DA0577DEFC38405B0E3E",
+//	    "MPTAmount": "100000000",
+//	    "OwnerNode": "0000000000000000",
+//	    "PreviousTxnID": "6D3F2B9A2F9A8A9E4F1B5C83B9D4AE4C2E1F0A3D5B7C9E1F3A5B7C9D1E3F5A7B",
+//	    "PreviousTxnLgrSeq": 134
+//	}
+//
+// ```
+type MPToken struct {
+	// The unique ID for this ledger entry. In JSON, this field is represented with different names depending on the
+	// context and API method. (Note, even though this is specified as "optional" in the code, every ledger entry
+	// should have one unless it's legacy data from very early in the XRP Ledger's history.)
+	Index types.Hash256 `json:"index,omitempty"`
+	// The value 0x007F, mapped to the string MPToken, indicates that this is an MPToken entry.
+	LedgerEntryType EntryType
+	// Set of bit-flags for this ledger entry.
+	Flags uint32
+	// The owner of the MPT.
+	Account types.Address
+	// The ID of the MPTokenIssuance this balance belongs to.
+	MPTokenIssuanceID string
+	// The amount of the token held by the account, as a base-10 integer string.
+	MPTAmount string `json:",omitempty"`
+	// The amount of the token held by the account that is locked in escrows, as a base-10 integer string.
+	LockedAmount string `json:",omitempty"`
+	// A hint indicating which page of the owner directory links to this entry, in case the directory consists of multiple pages.
+	OwnerNode string
+	// The identifying hash of the transaction that most recently modified this entry.
+	PreviousTxnID types.Hash256
+	// The index of the ledger that contains the transaction that most recently modified this entry.
+	PreviousTxnLgrSeq uint32
+}
+
+// EntryType returns the type of the ledger entry.
+func (*MPToken) EntryType() EntryType {
+	return MPTokenEntry
+}
+
+// SetLsfMPTLocked sets the flag locking the balance of the holder.
+func (m *MPToken) SetLsfMPTLocked() {
+	m.Flags |= lsfMPTLocked
+}
+
+// SetLsfMPTAuthorized sets the flag marking the holder as authorized by the issuer.
+func (m *MPToken) SetLsfMPTAuthorized() {
+	m.Flags |= lsfMPTAuthorized
+}
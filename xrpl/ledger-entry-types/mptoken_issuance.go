@@ -0,0 +1,118 @@
+package ledger
+
+import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+
+const (
+	// If enabled, all balances of the token are locked. For an MPToken, only the balance
+	// of that holder is locked.
+	lsfMPTLocked uint32 = 0x00000001
+	// If enabled, the issuer can lock an individual balance or all balances of the token.
+	lsfMPTCanLock uint32 = 0x00000002
+	// If enabled, holders must be authorized by the issuer before they can hold the token.
+	lsfMPTRequireAuth uint32 = 0x00000004
+	// If enabled, holders can place their balances into an escrow.
+	lsfMPTCanEscrow uint32 = 0x00000008
+	// If enabled, holders can trade their balances using the XRP Ledger DEX or AMM.
+	lsfMPTCanTrade uint32 = 0x00000010
+	// If enabled, the token can be transferred to accounts other than the issuer.
+	lsfMPTCanTransfer uint32 = 0x00000020
+	// If enabled, the issuer can claw back value from individual holders.
+	lsfMPTCanClawback uint32 = 0x00000040
+)
+
+// An MPTokenIssuance entry represents a single Multi-Purpose Token (MPT) issuance and
+// holds its properties. You can create an MPTokenIssuance entry with an
+// MPTokenIssuanceCreate transaction.
+// Requires the MPTokensV1 amendment to be enabled.
+//
+// ```json
+//
+//	{
+//	    "LedgerEntryType": "MPTokenIssuance",
+//	    "Flags": 122,
+//	    "Issuer": "rfmDuhDyLGgx94qiwf3YF8BUV5j6KSvE8",
+//	    "AssetScale": 2,
+//	    "MaximumAmount": "100000000",
+//	    "OutstandingAmount": "5000",
+//	    "TransferFee": 314,
+//	    "MPTokenMetadata": "CAFEBABE",
+//	    "OwnerNode": "0000000000000000",
+//	    "PreviousTxnID": "6D3F2B9A2F9A8A9E4F1B5C83B9D4AE4C2E1F0A3D5B7C9E1F3A5B7C9D1E3F5A7B",
+//	    "PreviousTxnLgrSeq": 134,
+//	    "Sequence": 12
+//	}
+//
+// ```
+type MPTokenIssuance struct {
+	// The unique ID for this ledger entry. In JSON, this field is represented with different names depending on the
+	// context and API method. (Note, even though this is specified as "optional" in the code, every ledger entry
+	// should have one unless it's legacy data from very early in the XRP Ledger's history.)
+	Index types.Hash256 `json:"index,omitempty"`
+	// The value 0x007E, mapped to the string MPTokenIssuance, indicates that this is an MPTokenIssuance entry.
+	LedgerEntryType EntryType
+	// Set of bit-flags for this ledger entry.
+	Flags uint32
+	// The address of the account that controls both the issuance amounts and characteristics of the token.
+	Issuer types.Address
+	// The difference, in orders of magnitude, between a standard unit and the fractional unit of the token.
+	AssetScale uint8 `json:",omitempty"`
+	// The maximum amount of the token that can ever be issued, as a base-10 integer string.
+	MaximumAmount string `json:",omitempty"`
+	// The amount of the token currently held by accounts other than the issuer, as a base-10 integer string.
+	OutstandingAmount string
+	// The amount of the token currently locked in escrows, as a base-10 integer string.
+	LockedAmount string `json:",omitempty"`
+	// The fee charged by the issuer for secondary sales of the token, in units of 1/100,000.
+	TransferFee uint16 `json:",omitempty"`
+	// Arbitrary metadata about the token, as a hex string.
+	MPTokenMetadata string `json:",omitempty"`
+	// A hint indicating which page of the owner directory links to this entry, in case the directory consists of multiple pages.
+	OwnerNode string
+	// The identifying hash of the transaction that most recently modified this entry.
+	PreviousTxnID types.Hash256
+	// The index of the ledger that contains the transaction that most recently modified this entry.
+	PreviousTxnLgrSeq uint32
+	// The Sequence of the transaction that created this issuance. Used with the Issuer to
+	// compute the MPTokenIssuanceID.
+	Sequence uint32
+}
+
+// EntryType returns the type of the ledger entry.
+func (*MPTokenIssuance) EntryType() EntryType {
+	return MPTokenIssuanceEntry
+}
+
+// SetLsfMPTLocked sets the flag locking all balances of the token.
+func (m *MPTokenIssuance) SetLsfMPTLocked() {
+	m.Flags |= lsfMPTLocked
+}
+
+// SetLsfMPTCanLock sets the flag allowing the issuer to lock balances.
+func (m *MPTokenIssuance) SetLsfMPTCanLock() {
+	m.Flags |= lsfMPTCanLock
+}
+
+// SetLsfMPTRequireAuth sets the flag requiring holders to be authorized.
+func (m *MPTokenIssuance) SetLsfMPTRequireAuth() {
+	m.Flags |= lsfMPTRequireAuth
+}
+
+// SetLsfMPTCanEscrow sets the flag allowing holders to escrow their balances.
+func (m *MPTokenIssuance) SetLsfMPTCanEscrow() {
+	m.Flags |= lsfMPTCanEscrow
+}
+
+// SetLsfMPTCanTrade sets the flag allowing holders to trade their balances.
+func (m *MPTokenIssuance) SetLsfMPTCanTrade() {
+	m.Flags |= lsfMPTCanTrade
+}
+
+// SetLsfMPTCanTransfer sets the flag allowing transfers to accounts other than the issuer.
+func (m *MPTokenIssuance) SetLsfMPTCanTransfer() {
+	m.Flags |= lsfMPTCanTransfer
+}
+
+// SetLsfMPTCanClawback sets the flag allowing the issuer to claw back balances.
+func (m *MPTokenIssuance) SetLsfMPTCanClawback() {
+	m.Flags |= lsfMPTCanClawback
+}
@@ -0,0 +1,86 @@
+package ledger
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMPTokenIssuance(t *testing.T) {
+	var s Object = &MPTokenIssuance{
+		LedgerEntryType:   MPTokenIssuanceEntry,
+		Flags:             lsfMPTCanLock | lsfMPTCanEscrow | lsfMPTCanTrade | lsfMPTCanTransfer | lsfMPTCanClawback,
+		Issuer:            "rfmDuhDyLGgx94qiwf3YF8BUV5j6KSvE8",
+		AssetScale:        2,
+		MaximumAmount:     "100000000",
+		OutstandingAmount: "5000",
+		TransferFee:       314,
+		MPTokenMetadata:   "CAFEBABE",
+		OwnerNode:         "0000000000000000",
+		PreviousTxnID:     "6D3F2B9A2F9A8A9E4F1B5C83B9D4AE4C2E1F0A3D5B7C9E1F3A5B7C9D1E3F5A7B",
+		PreviousTxnLgrSeq: 134,
+		Sequence:          12,
+	}
+
+	j := `{
+	"LedgerEntryType": "MPTokenIssuance",
+	"Flags": 122,
+	"Issuer": "rfmDuhDyLGgx94qiwf3YF8BUV5j6KSvE8",
+	"AssetScale": 2,
+	"MaximumAmount": "100000000",
+	"OutstandingAmount": "5000",
+	"TransferFee": 314,
+	"MPTokenMetadata": "CAFEBABE",
+	"OwnerNode": "0000000000000000",
+	"PreviousTxnID": "6D3F2B9A2F9A8A9E4F1B5C83B9D4AE4C2E1F0A3D5B7C9E1F3A5B7C9D1E3F5A7B",
+	"PreviousTxnLgrSeq": 134,
+	"Sequence": 12
+}`
+
+	if err := testutil.SerializeAndDeserialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+
+	obj, err := UnmarshalLedgerObject([]byte(j))
+	require.NoError(t, err)
+	require.Equal(t, s, obj)
+
+	empty, err := EmptyLedgerObject("MPTokenIssuance")
+	require.NoError(t, err)
+	require.Equal(t, &MPTokenIssuance{}, empty)
+
+	data, err := json.Marshal(obj)
+	require.NoError(t, err)
+	require.JSONEq(t, j, string(data))
+}
+
+func TestMPTokenIssuance_EntryType(t *testing.T) {
+	m := &MPTokenIssuance{}
+	require.Equal(t, MPTokenIssuanceEntry, m.EntryType())
+}
+
+func TestMPTokenIssuance_SetFlags(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      func(m *MPTokenIssuance)
+		expected uint32
+	}{
+		{"locked", (*MPTokenIssuance).SetLsfMPTLocked, 0x00000001},
+		{"can lock", (*MPTokenIssuance).SetLsfMPTCanLock, 0x00000002},
+		{"require auth", (*MPTokenIssuance).SetLsfMPTRequireAuth, 0x00000004},
+		{"can escrow", (*MPTokenIssuance).SetLsfMPTCanEscrow, 0x00000008},
+		{"can trade", (*MPTokenIssuance).SetLsfMPTCanTrade, 0x00000010},
+		{"can transfer", (*MPTokenIssuance).SetLsfMPTCanTransfer, 0x00000020},
+		{"can clawback", (*MPTokenIssuance).SetLsfMPTCanClawback, 0x00000040},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &MPTokenIssuance{}
+			tt.set(m)
+			require.Equal(t, tt.expected, m.Flags)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMPToken(t *testing.T) {
+	var s Object = &MPToken{
+		LedgerEntryType:   MPTokenEntry,
+		Flags:             lsfMPTAuthorized,
+		Account:           "rajgkBmMxmz161r8bWYH7CQAFZP5bA9oSG",
+		MPTokenIssuanceID: "000004C463C52827307480341125DA0577DEFC38405B0E3E",
+		MPTAmount:         "100000000",
+		OwnerNode:         "0000000000000000",
+		PreviousTxnID:     "6D3F2B9A2F9A8A9E4F1B5C83B9D4AE4C2E1F0A3D5B7C9E1F3A5B7C9D1E3F5A7B",
+		PreviousTxnLgrSeq: 134,
+	}
+
+	j := `{
+	"LedgerEntryType": "MPToken",
+	"Flags": 2,
+	"Account": "rajgkBmMxmz161r8bWYH7CQAFZP5bA9oSG",
+	"MPTokenIssuanceID": "000004C463C52827307480341125DA0577DEFC38405B0E3E",
+	"MPTAmount": "100000000",
+	"OwnerNode": "0000000000000000",
+	"PreviousTxnID": "6D3F2B9A2F9A8A9E4F1B5C83B9D4AE4C2E1F0A3D5B7C9E1F3A5B7C9D1E3F5A7B",
+	"PreviousTxnLgrSeq": 134
+}`
+
+	if err := testutil.SerializeAndDeserialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+
+	obj, err := UnmarshalLedgerObject([]byte(j))
+	require.NoError(t, err)
+	require.Equal(t, s, obj)
+
+	empty, err := EmptyLedgerObject("MPToken")
+	require.NoError(t, err)
+	require.Equal(t, &MPToken{}, empty)
+}
+
+func TestMPToken_EntryType(t *testing.T) {
+	m := &MPToken{}
+	require.Equal(t, MPTokenEntry, m.EntryType())
+}
+
+func TestMPToken_SetLsfMPTLocked(t *testing.T) {
+	m := &MPToken{}
+	m.SetLsfMPTLocked()
+	require.Equal(t, uint32(0x00000001), m.Flags)
+}
+
+func TestMPToken_SetLsfMPTAuthorized(t *testing.T) {
+	m := &MPToken{}
+	m.SetLsfMPTAuthorized()
+	require.Equal(t, uint32(0x00000002), m.Flags)
+}
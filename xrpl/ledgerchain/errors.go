@@ -0,0 +1,13 @@
+package ledgerchain
+
+import "errors"
+
+var (
+	ErrNoLedgers        = errors.New("no ledgers to verify")
+	ErrDuplicateLedger  = errors.New("ledger appears more than once")
+	ErrLedgerGap        = errors.New("ledgers are not consecutive")
+	ErrHashMismatch     = errors.New("ledger hash does not match its header")
+	ErrParentMismatch   = errors.New("parent hash does not match the hash of the previous ledger")
+	ErrSkipListMismatch = errors.New("ledger hash does not match the skip list")
+	ErrUnknownSkipList  = errors.New("skip list index is neither the recent nor a flag ledger hashes entry")
+)
@@ -0,0 +1,132 @@
+package ledgerchain
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+	"github.com/Peersyst/xrpl-go/xrpl/keylet"
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	ledgertypes "github.com/Peersyst/xrpl-go/xrpl/queries/ledger/types"
+)
+
+const (
+	// The number of ledgers between two hashes of a flag ledger hashes entry.
+	flagLedgerInterval = 256
+)
+
+// Ledger is a ledger header together with the hash it claims to have.
+type Ledger struct {
+	Header binarycodec.LedgerData
+	Hash   string
+}
+
+// FromLedgerResponse returns the header and hash of the ledger of a ledger response.
+func FromLedgerResponse(l ledgertypes.BaseLedger) Ledger {
+	return Ledger{
+		Header: binarycodec.LedgerData{
+			LedgerIndex:         uint32(l.LedgerIndex),
+			TotalCoins:          strconv.FormatUint(l.TotalCoins.Uint64(), 10),
+			ParentHash:          l.ParentHash,
+			TransactionHash:     l.TransactionHash,
+			AccountHash:         l.AccountHash,
+			ParentCloseTime:     uint32(l.ParentCloseTime),
+			CloseTime:           uint32(l.CloseTime),
+			CloseTimeResolution: uint8(l.CloseTimeResolution),
+			CloseFlags:          uint8(l.CloseFlags),
+		},
+		Hash: l.LedgerHash,
+	}
+}
+
+// FromBinary returns the ledger with the given header, in its canonical binary
+// format, and hash.
+func FromBinary(header, ledgerHash string) (Ledger, error) {
+	data, err := binarycodec.DecodeLedgerData(header)
+	if err != nil {
+		return Ledger{}, err
+	}
+	return Ledger{Header: data, Hash: ledgerHash}, nil
+}
+
+// Verify checks a run of consecutive ledgers. The hash of every ledger must
+// match its header, and the parent hash of every ledger must be the hash of
+// the ledger before it. The ledgers can be given in any order.
+func Verify(ledgers []Ledger) error {
+	if len(ledgers) == 0 {
+		return ErrNoLedgers
+	}
+
+	sorted := slices.Clone(ledgers)
+	slices.SortFunc(sorted, func(a, b Ledger) int {
+		return cmp.Compare(a.Header.LedgerIndex, b.Header.LedgerIndex)
+	})
+
+	for i, l := range sorted {
+		computed, err := hash.LedgerHeader(l.Header)
+		if err != nil {
+			return fmt.Errorf("ledger %d: %w", l.Header.LedgerIndex, err)
+		}
+		if !strings.EqualFold(computed, l.Hash) {
+			return fmt.Errorf("ledger %d: %w", l.Header.LedgerIndex, ErrHashMismatch)
+		}
+		if i == 0 {
+			continue
+		}
+
+		prev := sorted[i-1]
+		switch l.Header.LedgerIndex - prev.Header.LedgerIndex {
+		case 0:
+			return fmt.Errorf("ledger %d: %w", l.Header.LedgerIndex, ErrDuplicateLedger)
+		case 1:
+		default:
+			return fmt.Errorf("ledgers %d and %d: %w", prev.Header.LedgerIndex, l.Header.LedgerIndex, ErrLedgerGap)
+		}
+		if !strings.EqualFold(l.Header.ParentHash, prev.Hash) {
+			return fmt.Errorf("ledger %d: %w", l.Header.LedgerIndex, ErrParentMismatch)
+		}
+	}
+	return nil
+}
+
+// VerifySkipList checks the hashes of ledgers against a LedgerHashes entry and
+// returns how many of them the entry covers. The entry must have its Index set
+// so it can be told apart as the recent hashes entry, which holds the previous
+// 256 ledgers, or a flag ledger hashes entry, which holds every 256th ledger.
+//
+// The entry is only as trustworthy as the ledger it was read from. Check it
+// against the account_hash of a verified ledger with a SHAMap proof first.
+func VerifySkipList(ledgers []Ledger, skipList ledger.Hashes) (int, error) {
+	last := skipList.LastLedgerSequence
+	var interval uint32
+	switch {
+	case strings.EqualFold(string(skipList.Index), keylet.LedgerHashes()):
+		interval = 1
+	case strings.EqualFold(string(skipList.Index), keylet.LedgerHashesFor(last)):
+		interval = flagLedgerInterval
+	default:
+		return 0, ErrUnknownSkipList
+	}
+
+	checked := 0
+	for _, l := range ledgers {
+		seq := l.Header.LedgerIndex
+		if seq > last || (last-seq)%interval != 0 {
+			continue
+		}
+		back := int((last - seq) / interval)
+		if back >= len(skipList.Hashes) {
+			continue
+		}
+		expected := skipList.Hashes[len(skipList.Hashes)-1-back]
+		if !strings.EqualFold(string(expected), l.Hash) {
+			return checked, fmt.Errorf("ledger %d: %w", seq, ErrSkipListMismatch)
+		}
+		checked++
+	}
+	return checked, nil
+}
@@ -0,0 +1,177 @@
+package ledgerchain
+
+import (
+	"strings"
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+	"github.com/Peersyst/xrpl-go/xrpl/keylet"
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	ledgertypes "github.com/Peersyst/xrpl-go/xrpl/queries/ledger/types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testHeader = "01E91435016340767BF1C4A3EACEB081770D8ADE216C85445DD6FB002C6B5A2930F2DECE006DA18150CB18F6DD33F6F0990754C962A7CCE62F332FF9C13939B03B864117F0BDA86B6E9B4F873B5C3E520634D343EF5D9D9A4246643D64DAD278BA95DC0EAC6EB5350CF970D521276CDE21276CE60A00"
+
+// newChain returns n consecutive ledgers starting at first, each linked to the one before it.
+func newChain(t *testing.T, first uint32, n int) []Ledger {
+	t.Helper()
+	ledgers := make([]Ledger, 0, n)
+	parent := strings.Repeat("AB", 32)
+	for i := 0; i < n; i++ {
+		header := binarycodec.LedgerData{
+			LedgerIndex:         first + uint32(i),
+			TotalCoins:          "99994494362043555",
+			ParentHash:          parent,
+			TransactionHash:     strings.Repeat("00", 32),
+			AccountHash:         strings.Repeat("CD", 32),
+			ParentCloseTime:     556231902 + uint32(i)*4,
+			CloseTime:           556231906 + uint32(i)*4,
+			CloseTimeResolution: 10,
+		}
+		h, err := hash.LedgerHeader(header)
+		require.NoError(t, err)
+		ledgers = append(ledgers, Ledger{Header: header, Hash: h})
+		parent = h
+	}
+	return ledgers
+}
+
+func TestFromBinary(t *testing.T) {
+	l, err := FromBinary(testHeader, "7309471F39EDB5288202C16DDF473B2B58B103BFE4BC947BF080FB7CB0D25A3E")
+	require.NoError(t, err)
+	require.Equal(t, uint32(32052277), l.Header.LedgerIndex)
+	require.NoError(t, Verify([]Ledger{l}))
+}
+
+func TestFromLedgerResponse(t *testing.T) {
+	l := FromLedgerResponse(ledgertypes.BaseLedger{
+		AccountHash:         "3B5C3E520634D343EF5D9D9A4246643D64DAD278BA95DC0EAC6EB5350CF970D5",
+		CloseFlags:          0,
+		CloseTime:           556231910,
+		CloseTimeResolution: 10,
+		LedgerHash:          "7309471F39EDB5288202C16DDF473B2B58B103BFE4BC947BF080FB7CB0D25A3E",
+		LedgerIndex:         common.LedgerIndex(32052277),
+		ParentCloseTime:     556231902,
+		ParentHash:          "EACEB081770D8ADE216C85445DD6FB002C6B5A2930F2DECE006DA18150CB18F6",
+		TotalCoins:          types.XRPCurrencyAmount(99994494362043555),
+		TransactionHash:     "DD33F6F0990754C962A7CCE62F332FF9C13939B03B864117F0BDA86B6E9B4F87",
+	})
+
+	expected, err := FromBinary(testHeader, "7309471F39EDB5288202C16DDF473B2B58B103BFE4BC947BF080FB7CB0D25A3E")
+	require.NoError(t, err)
+	require.Equal(t, expected, l)
+}
+
+func TestVerify(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(ledgers []Ledger) []Ledger
+		err    error
+	}{
+		{
+			name:   "pass - consecutive ledgers",
+			mutate: func(ledgers []Ledger) []Ledger { return ledgers },
+		},
+		{
+			name: "pass - any order",
+			mutate: func(ledgers []Ledger) []Ledger {
+				return []Ledger{ledgers[2], ledgers[0], ledgers[3], ledgers[1]}
+			},
+		},
+		{
+			name:   "fail - no ledgers",
+			mutate: func([]Ledger) []Ledger { return nil },
+			err:    ErrNoLedgers,
+		},
+		{
+			name: "fail - hash does not match header",
+			mutate: func(ledgers []Ledger) []Ledger {
+				ledgers[1].Header.CloseTime++
+				return ledgers
+			},
+			err: ErrHashMismatch,
+		},
+		{
+			name: "fail - broken parent link",
+			mutate: func(ledgers []Ledger) []Ledger {
+				ledgers[2].Header.ParentHash = strings.Repeat("EF", 32)
+				h, err := hash.LedgerHeader(ledgers[2].Header)
+				if err != nil {
+					panic(err)
+				}
+				ledgers[2].Hash = h
+				return ledgers
+			},
+			err: ErrParentMismatch,
+		},
+		{
+			name:   "fail - gap",
+			mutate: func(ledgers []Ledger) []Ledger { return []Ledger{ledgers[0], ledgers[2]} },
+			err:    ErrLedgerGap,
+		},
+		{
+			name:   "fail - duplicate",
+			mutate: func(ledgers []Ledger) []Ledger { return []Ledger{ledgers[0], ledgers[1], ledgers[1]} },
+			err:    ErrDuplicateLedger,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(tt.mutate(newChain(t, 1000, 4)))
+			if tt.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestVerifySkipList_Recent(t *testing.T) {
+	ledgers := newChain(t, 1000, 4)
+	skipList := ledger.Hashes{
+		Index:              types.Hash256(keylet.LedgerHashes()),
+		LastLedgerSequence: 1003,
+		Hashes: []types.Hash256{
+			types.Hash256(ledgers[1].Hash),
+			types.Hash256(ledgers[2].Hash),
+			types.Hash256(ledgers[3].Hash),
+		},
+	}
+
+	checked, err := VerifySkipList(ledgers, skipList)
+	require.NoError(t, err)
+	require.Equal(t, 3, checked)
+
+	skipList.Hashes[0] = types.Hash256(strings.Repeat("EF", 32))
+	_, err = VerifySkipList(ledgers, skipList)
+	require.ErrorIs(t, err, ErrSkipListMismatch)
+}
+
+func TestVerifySkipList_FlagLedgers(t *testing.T) {
+	flag := newChain(t, 65536+512, 1)[0]
+	skipList := ledger.Hashes{
+		Index:              types.Hash256(keylet.LedgerHashesFor(65536 + 768)),
+		LastLedgerSequence: 65536 + 768,
+		Hashes: []types.Hash256{
+			types.Hash256(strings.Repeat("11", 32)),
+			types.Hash256(flag.Hash),
+			types.Hash256(strings.Repeat("22", 32)),
+		},
+	}
+	other := newChain(t, 65536+513, 1)[0]
+
+	checked, err := VerifySkipList([]Ledger{flag, other}, skipList)
+	require.NoError(t, err)
+	require.Equal(t, 1, checked)
+}
+
+func TestVerifySkipList_UnknownIndex(t *testing.T) {
+	_, err := VerifySkipList(newChain(t, 1000, 1), ledger.Hashes{LastLedgerSequence: 1000})
+	require.ErrorIs(t, err, ErrUnknownSkipList)
+}
@@ -0,0 +1,70 @@
+// Package paychan keeps the books of payment channels used for streaming
+// micropayments. A Payer issues cumulative claims against a channel it funds, and a
+// Payee checks the claims it receives against the channel and redeems the best one.
+package paychan
+
+import (
+	"context"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/client"
+	ledgerentry "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	xrpltime "github.com/Peersyst/xrpl-go/xrpl/time"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+// Client reads the PayChannel entry of a channel and submits the transactions a
+// Payer or Payee sends to fund, claim or close it. Any client.XRPLClient, such as
+// an rpc or websocket client, can be passed as is.
+type Client interface {
+	GetLedgerEntryWithContext(ctx context.Context, req *ledger.EntryRequest) (*ledger.EntryResponse, error)
+	SubmitTxAndWaitWithContext(ctx context.Context, tx transaction.FlatTransaction, opts *client.SubmitOptions) (*requests.TxResponse, error)
+}
+
+// Fetch returns the validated PayChannel entry of a channel.
+func Fetch(ctx context.Context, c Client, channelID types.Hash256) (*ledgerentry.PayChannel, error) {
+	res, err := c.GetLedgerEntryWithContext(ctx, &ledger.EntryRequest{
+		PaymentChannel: string(channelID),
+		LedgerIndex:    common.Validated,
+	})
+	if err != nil {
+		return nil, err
+	}
+	channel, ok := res.Object.(*ledgerentry.PayChannel)
+	if !ok {
+		return nil, ErrNotPayChannel
+	}
+	return channel, nil
+}
+
+// Deadline returns the time after which the channel can no longer be claimed, the
+// earlier of its Expiration and CancelAfter. It returns false if neither is set.
+func Deadline(channel *ledgerentry.PayChannel) (time.Time, bool) {
+	deadline := channel.Expiration
+	if channel.CancelAfter != 0 && (deadline == 0 || channel.CancelAfter < deadline) {
+		deadline = channel.CancelAfter
+	}
+	if deadline == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(deadline)+xrpltime.RippleEpochDiff, 0), true
+}
+
+// isDue reports whether the deadline of the channel is less than margin away from now.
+func isDue(channel *ledgerentry.PayChannel, now time.Time, margin time.Duration) bool {
+	deadline, ok := Deadline(channel)
+	return ok && !now.Add(margin).Before(deadline)
+}
+
+// submit autofills, signs and submits tx with w, and waits for it to be validated.
+func submit(ctx context.Context, c Client, w *wallet.Wallet, tx transaction.FlatTransaction) (*requests.TxResponse, error) {
+	return c.SubmitTxAndWaitWithContext(ctx, tx, &client.SubmitOptions{
+		Autofill: true,
+		Wallet:   w,
+	})
+}
@@ -0,0 +1,133 @@
+package paychan
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/client"
+	ledgerentry "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testChannelID   types.Hash256 = "5DB01B7FFED6B67E6B0414DED11E051D2EE2B7619CE0EAA6286D67A3A4D5BDB3"
+	testDestination types.Address = "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH"
+	// 2030-01-01T00:00:00Z in seconds since the Ripple Epoch.
+	testCancelAfter uint32 = 946771200
+)
+
+func testWallet(t *testing.T) *wallet.Wallet {
+	t.Helper()
+	w, err := wallet.FromSeed("sEdSuqBPSQaood2DmNYVkwWTn1oQTj2", "")
+	require.NoError(t, err)
+	return &w
+}
+
+func testChannel(w *wallet.Wallet) *ledgerentry.PayChannel {
+	return &ledgerentry.PayChannel{
+		Account:         w.ClassicAddress,
+		Destination:     testDestination,
+		Amount:          types.XRPCurrencyAmount(1000),
+		Balance:         types.XRPCurrencyAmount(100),
+		PublicKey:       w.PublicKey,
+		SettleDelay:     3600,
+		CancelAfter:     testCancelAfter,
+		LedgerEntryType: ledgerentry.PayChannelEntry,
+	}
+}
+
+// mockClient serves a single channel and records the transactions submitted to it.
+type mockClient struct {
+	channel   ledgerentry.Object
+	submitted []transaction.FlatTransaction
+	// onSubmit applies a submitted transaction to the channel.
+	onSubmit func(tx transaction.FlatTransaction)
+}
+
+func (m *mockClient) GetLedgerEntryWithContext(_ context.Context, req *ledger.EntryRequest) (*ledger.EntryResponse, error) {
+	return &ledger.EntryResponse{Index: req.PaymentChannel, Object: m.channel, Validated: true}, nil
+}
+
+func (m *mockClient) SubmitTxAndWaitWithContext(_ context.Context, tx transaction.FlatTransaction, opts *client.SubmitOptions) (*requests.TxResponse, error) {
+	if !opts.Autofill || opts.Wallet == nil {
+		return nil, client.ErrMissingWallet
+	}
+	m.submitted = append(m.submitted, tx)
+	if m.onSubmit != nil {
+		m.onSubmit(tx)
+	}
+	return &requests.TxResponse{Validated: true}, nil
+}
+
+func TestFetch(t *testing.T) {
+	w := testWallet(t)
+
+	t.Run("pass", func(t *testing.T) {
+		c := &mockClient{channel: testChannel(w)}
+		channel, err := Fetch(context.Background(), c, testChannelID)
+		require.NoError(t, err)
+		require.Equal(t, testChannel(w), channel)
+	})
+
+	t.Run("fail - not a payment channel", func(t *testing.T) {
+		c := &mockClient{channel: &ledgerentry.Check{}}
+		_, err := Fetch(context.Background(), c, testChannelID)
+		require.ErrorIs(t, err, ErrNotPayChannel)
+	})
+}
+
+func TestDeadline(t *testing.T) {
+	cancelAfter := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name        string
+		expiration  uint32
+		cancelAfter uint32
+		expected    time.Time
+		expectedOk  bool
+	}{
+		{
+			name: "no deadline",
+		},
+		{
+			name:        "cancel after",
+			cancelAfter: testCancelAfter,
+			expected:    cancelAfter,
+			expectedOk:  true,
+		},
+		{
+			name:        "expiration before cancel after",
+			expiration:  testCancelAfter - 60,
+			cancelAfter: testCancelAfter,
+			expected:    cancelAfter.Add(-time.Minute),
+			expectedOk:  true,
+		},
+		{
+			name:        "cancel after before expiration",
+			expiration:  testCancelAfter + 60,
+			cancelAfter: testCancelAfter,
+			expected:    cancelAfter,
+			expectedOk:  true,
+		},
+		{
+			name:       "expiration",
+			expiration: testCancelAfter,
+			expected:   cancelAfter,
+			expectedOk: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			deadline, ok := Deadline(&ledgerentry.PayChannel{Expiration: tc.expiration, CancelAfter: tc.cancelAfter})
+			require.Equal(t, tc.expectedOk, ok)
+			require.True(t, tc.expected.Equal(deadline))
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package paychan
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+// Claim authorizes the destination of a channel to receive a cumulative amount of XRP
+// from it. Each claim replaces the previous ones, so only the best claim needs to be
+// redeemed.
+type Claim struct {
+	// The ID of the channel.
+	Channel types.Hash256 `json:"channel"`
+	// The cumulative amount of XRP, in drops, the claim authorizes.
+	Amount types.XRPCurrencyAmount `json:"amount"`
+	// The hex encoded signature of the claim.
+	Signature string `json:"signature"`
+}
+
+// Verify reports whether the claim is signed with the key pair of the public key.
+func (c Claim) Verify(publicKey string) (bool, error) {
+	return wallet.VerifyChannelClaim(string(c.Channel), c.Amount, c.Signature, publicKey)
+}
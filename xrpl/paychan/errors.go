@@ -0,0 +1,28 @@
+package paychan
+
+import "errors"
+
+var (
+	// ErrNotChannelSource is returned when the payer wallet is not the source of the channel.
+	ErrNotChannelSource = errors.New("wallet is not the source of the channel")
+	// ErrNotChannelDestination is returned when the payee is not the destination of the channel.
+	ErrNotChannelDestination = errors.New("account is not the destination of the channel")
+	// ErrPublicKeyMismatch is returned when the payer wallet does not hold the key pair of the channel.
+	ErrPublicKeyMismatch = errors.New("wallet public key does not match the channel public key")
+	// ErrZeroAmount is returned when a payment of zero drops is made.
+	ErrZeroAmount = errors.New("amount must be greater than zero")
+	// ErrInsufficientFunds is returned when a claim would exceed the XRP set aside in the channel.
+	ErrInsufficientFunds = errors.New("channel does not hold enough XRP")
+	// ErrChannelMismatch is returned when a claim is for another channel.
+	ErrChannelMismatch = errors.New("claim is for another channel")
+	// ErrInvalidClaimSignature is returned when a claim is not signed with the key pair of the channel.
+	ErrInvalidClaimSignature = errors.New("claim signature is not valid for the channel")
+	// ErrStaleClaim is returned when a claim does not exceed the best claim received so far.
+	ErrStaleClaim = errors.New("claim does not exceed the best claim received")
+	// ErrChannelExpired is returned when a claim is received after the channel can no longer be claimed.
+	ErrChannelExpired = errors.New("channel has expired")
+	// ErrNothingToRedeem is returned when no claim exceeds the balance already paid out by the channel.
+	ErrNothingToRedeem = errors.New("no claim exceeds the channel balance")
+	// ErrNotPayChannel is returned when the ledger entry of a channel ID is not a PayChannel.
+	ErrNotPayChannel = errors.New("ledger entry is not a payment channel")
+)
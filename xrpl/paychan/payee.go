@@ -0,0 +1,183 @@
+package paychan
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	ledgerentry "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+// Payee receives claims against a channel paying its account. It checks every claim
+// against the state of the channel, keeps the best one and redeems it. A Payee is safe
+// for concurrent use.
+type Payee struct {
+	mu        sync.Mutex
+	account   types.Address
+	channelID types.Hash256
+	channel   ledgerentry.PayChannel
+	best      *Claim
+	now       func() time.Time
+}
+
+// NewPayee returns a Payee for the channel, as returned by Fetch. The account must be
+// the destination of the channel.
+func NewPayee(account types.Address, channelID types.Hash256, channel *ledgerentry.PayChannel) (*Payee, error) {
+	if channel.Destination != account {
+		return nil, ErrNotChannelDestination
+	}
+	return &Payee{
+		account:   account,
+		channelID: channelID,
+		channel:   *channel,
+		now:       time.Now,
+	}, nil
+}
+
+// ChannelID returns the ID of the channel.
+func (p *Payee) ChannelID() types.Hash256 {
+	return p.channelID
+}
+
+// Channel returns the last known state of the channel.
+func (p *Payee) Channel() ledgerentry.PayChannel {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.channel
+}
+
+// Receive checks a claim against the channel and keeps it if it is the best claim
+// received so far. The claim must be for the channel, be signed with its key pair,
+// not exceed the XRP in it and exceed both its balance and the previous best claim.
+// Returns the amount the claim adds to the previous best claim.
+func (p *Payee) Receive(claim Claim) (types.XRPCurrencyAmount, error) {
+	if claim.Channel != p.channelID {
+		return 0, ErrChannelMismatch
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if deadline, ok := Deadline(&p.channel); ok && !p.now().Before(deadline) {
+		return 0, ErrChannelExpired
+	}
+	if claim.Amount > p.channel.Amount {
+		return 0, fmt.Errorf("%w: claim of %d drops, %d in the channel", ErrInsufficientFunds, claim.Amount, p.channel.Amount)
+	}
+	floor := p.floor()
+	if claim.Amount <= floor {
+		return 0, fmt.Errorf("%w: claim of %d drops, %d already received", ErrStaleClaim, claim.Amount, floor)
+	}
+
+	ok, err := claim.Verify(p.channel.PublicKey)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, ErrInvalidClaimSignature
+	}
+
+	p.best = &claim
+	return claim.Amount - floor, nil
+}
+
+// floor returns the amount a new claim must exceed: the best claim received, or the
+// balance of the channel if it is higher.
+func (p *Payee) floor() types.XRPCurrencyAmount {
+	if p.best != nil && p.best.Amount > p.channel.Balance {
+		return p.best.Amount
+	}
+	return p.channel.Balance
+}
+
+// BestClaim returns the best claim received so far. It returns false if no claim was
+// received.
+func (p *Payee) BestClaim() (Claim, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.best == nil {
+		return Claim{}, false
+	}
+	return *p.best, true
+}
+
+// Unredeemed returns the amount of the best claim not paid out by the channel yet.
+func (p *Payee) Unredeemed() types.XRPCurrencyAmount {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.floor() - p.channel.Balance
+}
+
+// Update replaces the state of the channel, for example with the entry returned by
+// Fetch after the channel was funded or claimed.
+func (p *Payee) Update(channel *ledgerentry.PayChannel) error {
+	if channel.Destination != p.account {
+		return ErrNotChannelDestination
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.channel = *channel
+	return nil
+}
+
+// RedeemDue reports whether the best claim has not been redeemed and the channel can
+// no longer be claimed within margin of now, in which case the payee should redeem
+// the claim before it is lost.
+func (p *Payee) RedeemDue(now time.Time, margin time.Duration) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.floor() > p.channel.Balance && isDue(&p.channel, now, margin)
+}
+
+// RedeemTx returns a PaymentChannelClaim transaction redeeming the best claim.
+func (p *Payee) RedeemTx() (*transaction.PaymentChannelClaim, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.floor() == p.channel.Balance {
+		return nil, ErrNothingToRedeem
+	}
+	return &transaction.PaymentChannelClaim{
+		BaseTx: transaction.BaseTx{
+			Account:         p.account,
+			TransactionType: transaction.PaymentChannelClaimTx,
+		},
+		Channel:   p.channelID,
+		Balance:   p.best.Amount,
+		Amount:    p.best.Amount,
+		Signature: p.best.Signature,
+		PublicKey: p.channel.PublicKey,
+	}, nil
+}
+
+// Redeem submits RedeemTx with the wallet of the payee, waits for it to be validated
+// and refreshes the channel.
+func (p *Payee) Redeem(ctx context.Context, c Client, w *wallet.Wallet) (*requests.TxResponse, error) {
+	if w.ClassicAddress != p.account {
+		return nil, ErrNotChannelDestination
+	}
+	tx, err := p.RedeemTx()
+	if err != nil {
+		return nil, err
+	}
+	res, err := submit(ctx, c, w, tx.Flatten())
+	if err != nil {
+		return nil, err
+	}
+	return res, p.Refresh(ctx, c)
+}
+
+// Refresh fetches the validated state of the channel and updates the payee with it.
+func (p *Payee) Refresh(ctx context.Context, c Client) error {
+	channel, err := Fetch(ctx, c, p.channelID)
+	if err != nil {
+		return err
+	}
+	return p.Update(channel)
+}
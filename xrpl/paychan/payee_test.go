@@ -0,0 +1,188 @@
+package paychan
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPayee(t *testing.T) {
+	w := testWallet(t)
+
+	_, err := NewPayee(testDestination, testChannelID, testChannel(w))
+	require.NoError(t, err)
+
+	_, err = NewPayee(w.ClassicAddress, testChannelID, testChannel(w))
+	require.ErrorIs(t, err, ErrNotChannelDestination)
+}
+
+func TestPayee_Receive(t *testing.T) {
+	w := testWallet(t)
+	payer, err := NewPayer(w, testChannelID, testChannel(w))
+	require.NoError(t, err)
+	first, err := payer.Pay(50)
+	require.NoError(t, err)
+	second, err := payer.Pay(25)
+	require.NoError(t, err)
+
+	other, err := wallet.FromSeed("snGHNrPbHrdUcszeuDEigMdC1Lyyd", "")
+	require.NoError(t, err)
+	forged := first
+	forged.Amount = 1000
+	forged.Signature, err = other.AuthorizeChannelClaim(string(testChannelID), forged.Amount)
+	require.NoError(t, err)
+
+	tt := []struct {
+		name        string
+		claims      []Claim
+		expected    types.XRPCurrencyAmount
+		expectedErr error
+	}{
+		{
+			name:     "pass - first claim",
+			claims:   []Claim{first},
+			expected: 50,
+		},
+		{
+			name:     "pass - increasing claims",
+			claims:   []Claim{first, second},
+			expected: 25,
+		},
+		{
+			name:     "pass - skipped claim",
+			claims:   []Claim{second},
+			expected: 75,
+		},
+		{
+			name:        "fail - stale claim",
+			claims:      []Claim{second, first},
+			expectedErr: ErrStaleClaim,
+		},
+		{
+			name:        "fail - claim below balance",
+			claims:      []Claim{{Channel: testChannelID, Amount: 100, Signature: first.Signature}},
+			expectedErr: ErrStaleClaim,
+		},
+		{
+			name:        "fail - other channel",
+			claims:      []Claim{{Channel: "00", Amount: first.Amount, Signature: first.Signature}},
+			expectedErr: ErrChannelMismatch,
+		},
+		{
+			name:        "fail - exceeds channel",
+			claims:      []Claim{{Channel: testChannelID, Amount: 1001, Signature: first.Signature}},
+			expectedErr: ErrInsufficientFunds,
+		},
+		{
+			name:        "fail - tampered amount",
+			claims:      []Claim{{Channel: testChannelID, Amount: 200, Signature: first.Signature}},
+			expectedErr: ErrInvalidClaimSignature,
+		},
+		{
+			name:        "fail - signed with another key",
+			claims:      []Claim{forged},
+			expectedErr: ErrInvalidClaimSignature,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := NewPayee(testDestination, testChannelID, testChannel(w))
+			require.NoError(t, err)
+
+			var received types.XRPCurrencyAmount
+			for _, claim := range tc.claims {
+				received, err = p.Receive(claim)
+			}
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, received)
+
+			best, ok := p.BestClaim()
+			require.True(t, ok)
+			require.Equal(t, tc.claims[len(tc.claims)-1], best)
+		})
+	}
+}
+
+func TestPayee_ReceiveExpired(t *testing.T) {
+	w := testWallet(t)
+	payer, err := NewPayer(w, testChannelID, testChannel(w))
+	require.NoError(t, err)
+	claim, err := payer.Pay(50)
+	require.NoError(t, err)
+
+	p, err := NewPayee(testDestination, testChannelID, testChannel(w))
+	require.NoError(t, err)
+	p.now = func() time.Time { return time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	_, err = p.Receive(claim)
+	require.ErrorIs(t, err, ErrChannelExpired)
+}
+
+func TestPayee_Redeem(t *testing.T) {
+	w := testWallet(t)
+	payer, err := NewPayer(w, testChannelID, testChannel(w))
+	require.NoError(t, err)
+	claim, err := payer.Pay(300)
+	require.NoError(t, err)
+
+	p, err := NewPayee(testDestination, testChannelID, testChannel(w))
+	require.NoError(t, err)
+	_, ok := p.BestClaim()
+	require.False(t, ok)
+	_, err = p.RedeemTx()
+	require.ErrorIs(t, err, ErrNothingToRedeem)
+
+	_, err = p.Receive(claim)
+	require.NoError(t, err)
+	require.Equal(t, types.XRPCurrencyAmount(300), p.Unredeemed())
+
+	cancelAfter := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.False(t, p.RedeemDue(cancelAfter.Add(-2*time.Hour), time.Hour))
+	require.True(t, p.RedeemDue(cancelAfter.Add(-30*time.Minute), time.Hour))
+
+	tx, err := p.RedeemTx()
+	require.NoError(t, err)
+	require.Equal(t, &transaction.PaymentChannelClaim{
+		BaseTx: transaction.BaseTx{
+			Account:         testDestination,
+			TransactionType: transaction.PaymentChannelClaimTx,
+		},
+		Channel:   testChannelID,
+		Balance:   400,
+		Amount:    400,
+		Signature: claim.Signature,
+		PublicKey: w.PublicKey,
+	}, tx)
+
+	payee, err := wallet.FromSeed("snGHNrPbHrdUcszeuDEigMdC1Lyyd", "")
+	require.NoError(t, err)
+	c := &mockClient{channel: testChannel(w)}
+	_, err = p.Redeem(context.Background(), c, &payee)
+	require.ErrorIs(t, err, ErrNotChannelDestination)
+
+	payee.ClassicAddress = testDestination
+	c.onSubmit = func(tx transaction.FlatTransaction) {
+		channel := testChannel(w)
+		channel.Balance = 400
+		c.channel = channel
+	}
+	_, err = p.Redeem(context.Background(), c, &payee)
+	require.NoError(t, err)
+	require.Len(t, c.submitted, 1)
+	require.Equal(t, "400", c.submitted[0]["Balance"])
+	require.Zero(t, p.Unredeemed())
+	require.False(t, p.RedeemDue(cancelAfter, time.Hour))
+
+	_, err = p.RedeemTx()
+	require.ErrorIs(t, err, ErrNothingToRedeem)
+}
@@ -0,0 +1,200 @@
+package paychan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	ledgerentry "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+// Payer issues claims against a channel funded by its wallet. It tracks the
+// cumulative amount it has authorized, so each claim covers every payment made so
+// far. A Payer is safe for concurrent use.
+type Payer struct {
+	mu         sync.Mutex
+	wallet     *wallet.Wallet
+	channelID  types.Hash256
+	channel    ledgerentry.PayChannel
+	authorized types.XRPCurrencyAmount
+}
+
+// NewPayer returns a Payer for the channel, as returned by Fetch. The wallet must be
+// the source of the channel and hold its key pair. The authorized amount starts at the
+// balance the channel has already paid out.
+func NewPayer(w *wallet.Wallet, channelID types.Hash256, channel *ledgerentry.PayChannel) (*Payer, error) {
+	if channel.Account != w.ClassicAddress {
+		return nil, ErrNotChannelSource
+	}
+	if !strings.EqualFold(channel.PublicKey, w.PublicKey) {
+		return nil, ErrPublicKeyMismatch
+	}
+	return &Payer{
+		wallet:     w,
+		channelID:  channelID,
+		channel:    *channel,
+		authorized: channel.Balance,
+	}, nil
+}
+
+// ChannelID returns the ID of the channel.
+func (p *Payer) ChannelID() types.Hash256 {
+	return p.channelID
+}
+
+// Channel returns the last known state of the channel.
+func (p *Payer) Channel() ledgerentry.PayChannel {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.channel
+}
+
+// Authorized returns the cumulative amount authorized by the claims issued so far.
+func (p *Payer) Authorized() types.XRPCurrencyAmount {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.authorized
+}
+
+// Remaining returns the amount of XRP in the channel not authorized yet.
+func (p *Payer) Remaining() types.XRPCurrencyAmount {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.channel.Amount - p.authorized
+}
+
+// SetAuthorized restores the cumulative amount authorized by the claims issued
+// before, for example after a restart. It must be between the balance and the amount
+// of the channel.
+func (p *Payer) SetAuthorized(amount types.XRPCurrencyAmount) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if amount > p.channel.Amount {
+		return fmt.Errorf("%w: %d drops authorized, %d in the channel", ErrInsufficientFunds, amount, p.channel.Amount)
+	}
+	p.authorized = max(amount, p.channel.Balance)
+	return nil
+}
+
+// Pay authorizes amount more drops and returns the claim for the new cumulative
+// amount.
+func (p *Payer) Pay(amount types.XRPCurrencyAmount) (Claim, error) {
+	if amount == 0 {
+		return Claim{}, ErrZeroAmount
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	remaining := p.channel.Amount - p.authorized
+	if amount > remaining {
+		return Claim{}, fmt.Errorf("%w: %d drops requested, %d remaining", ErrInsufficientFunds, amount, remaining)
+	}
+
+	claim, err := p.sign(p.authorized + amount)
+	if err != nil {
+		return Claim{}, err
+	}
+	p.authorized = claim.Amount
+	return claim, nil
+}
+
+// Claim returns a claim for the cumulative amount authorized so far, for example to
+// send it again to the payee.
+func (p *Payer) Claim() (Claim, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.sign(p.authorized)
+}
+
+func (p *Payer) sign(amount types.XRPCurrencyAmount) (Claim, error) {
+	signature, err := p.wallet.AuthorizeChannelClaim(string(p.channelID), amount)
+	if err != nil {
+		return Claim{}, err
+	}
+	return Claim{
+		Channel:   p.channelID,
+		Amount:    amount,
+		Signature: signature,
+	}, nil
+}
+
+// Update replaces the state of the channel, for example with the entry returned by
+// Fetch after the channel was funded or claimed.
+func (p *Payer) Update(channel *ledgerentry.PayChannel) error {
+	if channel.Account != p.wallet.ClassicAddress {
+		return ErrNotChannelSource
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.channel = *channel
+	p.authorized = max(p.authorized, channel.Balance)
+	return nil
+}
+
+// CloseDue reports whether the channel can no longer be claimed within margin of now,
+// in which case the payer should request its closure to recover the XRP left in it.
+func (p *Payer) CloseDue(now time.Time, margin time.Duration) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return isDue(&p.channel, now, margin)
+}
+
+// FundTx returns a PaymentChannelFund transaction adding amount drops to the channel.
+// A non-zero expiration, in seconds since the Ripple Epoch, sets a new Expiration.
+func (p *Payer) FundTx(amount types.XRPCurrencyAmount, expiration uint32) *transaction.PaymentChannelFund {
+	return &transaction.PaymentChannelFund{
+		BaseTx: transaction.BaseTx{
+			Account:         p.wallet.ClassicAddress,
+			TransactionType: transaction.PaymentChannelFundTx,
+		},
+		Channel:    p.channelID,
+		Amount:     amount,
+		Expiration: expiration,
+	}
+}
+
+// CloseTx returns a PaymentChannelClaim transaction requesting the closure of the
+// channel. The channel closes once its SettleDelay has passed, or at once if no XRP is
+// left in it.
+func (p *Payer) CloseTx() *transaction.PaymentChannelClaim {
+	tx := &transaction.PaymentChannelClaim{
+		BaseTx: transaction.BaseTx{
+			Account:         p.wallet.ClassicAddress,
+			TransactionType: transaction.PaymentChannelClaimTx,
+		},
+		Channel: p.channelID,
+	}
+	tx.SetCloseFlag()
+	return tx
+}
+
+// TopUp submits FundTx, waits for it to be validated and refreshes the channel.
+func (p *Payer) TopUp(ctx context.Context, c Client, amount types.XRPCurrencyAmount, expiration uint32) (*requests.TxResponse, error) {
+	res, err := submit(ctx, c, p.wallet, p.FundTx(amount, expiration).Flatten())
+	if err != nil {
+		return nil, err
+	}
+	return res, p.Refresh(ctx, c)
+}
+
+// RequestClose submits CloseTx and waits for it to be validated.
+func (p *Payer) RequestClose(ctx context.Context, c Client) (*requests.TxResponse, error) {
+	return submit(ctx, c, p.wallet, p.CloseTx().Flatten())
+}
+
+// Refresh fetches the validated state of the channel and updates the payer with it.
+func (p *Payer) Refresh(ctx context.Context, c Client) error {
+	channel, err := Fetch(ctx, c, p.channelID)
+	if err != nil {
+		return err
+	}
+	return p.Update(channel)
+}
@@ -0,0 +1,162 @@
+package paychan
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPayer(t *testing.T) {
+	w := testWallet(t)
+
+	t.Run("pass", func(t *testing.T) {
+		p, err := NewPayer(w, testChannelID, testChannel(w))
+		require.NoError(t, err)
+		require.Equal(t, testChannelID, p.ChannelID())
+		require.Equal(t, types.XRPCurrencyAmount(100), p.Authorized())
+		require.Equal(t, types.XRPCurrencyAmount(900), p.Remaining())
+	})
+
+	t.Run("fail - not the source", func(t *testing.T) {
+		channel := testChannel(w)
+		channel.Account = testDestination
+		_, err := NewPayer(w, testChannelID, channel)
+		require.ErrorIs(t, err, ErrNotChannelSource)
+	})
+
+	t.Run("fail - other public key", func(t *testing.T) {
+		channel := testChannel(w)
+		channel.PublicKey = "ED0000000000000000000000000000000000000000000000000000000000000000"
+		_, err := NewPayer(w, testChannelID, channel)
+		require.ErrorIs(t, err, ErrPublicKeyMismatch)
+	})
+}
+
+func TestPayer_Pay(t *testing.T) {
+	w := testWallet(t)
+	p, err := NewPayer(w, testChannelID, testChannel(w))
+	require.NoError(t, err)
+
+	claim, err := p.Pay(50)
+	require.NoError(t, err)
+	require.Equal(t, testChannelID, claim.Channel)
+	require.Equal(t, types.XRPCurrencyAmount(150), claim.Amount)
+	ok, err := claim.Verify(w.PublicKey)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	claim, err = p.Pay(850)
+	require.NoError(t, err)
+	require.Equal(t, types.XRPCurrencyAmount(1000), claim.Amount)
+	require.Zero(t, p.Remaining())
+
+	_, err = p.Pay(1)
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+	_, err = p.Pay(0)
+	require.ErrorIs(t, err, ErrZeroAmount)
+	require.Equal(t, types.XRPCurrencyAmount(1000), p.Authorized())
+
+	last, err := p.Claim()
+	require.NoError(t, err)
+	require.Equal(t, claim, last)
+}
+
+func TestPayer_SetAuthorized(t *testing.T) {
+	w := testWallet(t)
+	p, err := NewPayer(w, testChannelID, testChannel(w))
+	require.NoError(t, err)
+
+	require.NoError(t, p.SetAuthorized(400))
+	require.Equal(t, types.XRPCurrencyAmount(400), p.Authorized())
+
+	require.NoError(t, p.SetAuthorized(10))
+	require.Equal(t, types.XRPCurrencyAmount(100), p.Authorized())
+
+	require.ErrorIs(t, p.SetAuthorized(1001), ErrInsufficientFunds)
+}
+
+func TestPayer_CloseDue(t *testing.T) {
+	w := testWallet(t)
+	p, err := NewPayer(w, testChannelID, testChannel(w))
+	require.NoError(t, err)
+
+	cancelAfter := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.False(t, p.CloseDue(cancelAfter.Add(-2*time.Hour), time.Hour))
+	require.True(t, p.CloseDue(cancelAfter.Add(-time.Hour), time.Hour))
+	require.True(t, p.CloseDue(cancelAfter.Add(time.Hour), 0))
+
+	channel := testChannel(w)
+	channel.CancelAfter = 0
+	require.NoError(t, p.Update(channel))
+	require.False(t, p.CloseDue(cancelAfter.Add(time.Hour), time.Hour))
+}
+
+func TestPayer_Transactions(t *testing.T) {
+	w := testWallet(t)
+	p, err := NewPayer(w, testChannelID, testChannel(w))
+	require.NoError(t, err)
+
+	fund := p.FundTx(500, testCancelAfter-60)
+	require.Equal(t, &transaction.PaymentChannelFund{
+		BaseTx: transaction.BaseTx{
+			Account:         w.ClassicAddress,
+			TransactionType: transaction.PaymentChannelFundTx,
+		},
+		Channel:    testChannelID,
+		Amount:     500,
+		Expiration: testCancelAfter - 60,
+	}, fund)
+
+	closeTx := p.CloseTx()
+	require.Equal(t, w.ClassicAddress, closeTx.Account)
+	require.Equal(t, testChannelID, closeTx.Channel)
+	require.Equal(t, uint32(131072), closeTx.Flags)
+}
+
+func TestPayer_TopUp(t *testing.T) {
+	w := testWallet(t)
+	c := &mockClient{channel: testChannel(w)}
+	c.onSubmit = func(tx transaction.FlatTransaction) {
+		channel := testChannel(w)
+		channel.Amount = 1500
+		c.channel = channel
+	}
+
+	p, err := NewPayer(w, testChannelID, testChannel(w))
+	require.NoError(t, err)
+	_, err = p.Pay(900)
+	require.NoError(t, err)
+
+	_, err = p.TopUp(context.Background(), c, 500, 0)
+	require.NoError(t, err)
+	require.Len(t, c.submitted, 1)
+	require.Equal(t, "PaymentChannelFund", c.submitted[0]["TransactionType"])
+	require.Equal(t, types.XRPCurrencyAmount(1500), p.Channel().Amount)
+	require.Equal(t, types.XRPCurrencyAmount(1000), p.Authorized())
+	require.Equal(t, types.XRPCurrencyAmount(500), p.Remaining())
+
+	_, err = p.RequestClose(context.Background(), c)
+	require.NoError(t, err)
+	require.Len(t, c.submitted, 2)
+	require.Equal(t, uint32(131072), c.submitted[1]["Flags"])
+}
+
+func TestPayer_Update(t *testing.T) {
+	w := testWallet(t)
+	p, err := NewPayer(w, testChannelID, testChannel(w))
+	require.NoError(t, err)
+
+	other, err := wallet.FromSeed("snGHNrPbHrdUcszeuDEigMdC1Lyyd", "")
+	require.NoError(t, err)
+	require.ErrorIs(t, p.Update(testChannel(&other)), ErrNotChannelSource)
+
+	channel := testChannel(w)
+	channel.Balance = 300
+	require.NoError(t, p.Update(channel))
+	require.Equal(t, types.XRPCurrencyAmount(300), p.Authorized())
+}
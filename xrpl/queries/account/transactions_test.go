@@ -5,6 +5,9 @@ import (
 
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccountTransactionsRequest(t *testing.T) {
@@ -84,3 +87,22 @@ func TestAccountTransactionsResponse(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestTransaction_TypedTx(t *testing.T) {
+	tr := Transaction{
+		Tx: transaction.FlatTransaction{
+			"TransactionType": "Payment",
+			"Account":         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			"Destination":     "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH",
+			"DeliverMax":      "1000",
+			"Fee":             "12",
+		},
+	}
+
+	tx, err := tr.TypedTx()
+	require.NoError(t, err)
+	payment, ok := tx.(*transaction.Payment)
+	require.True(t, ok)
+	require.Equal(t, types.Address("rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH"), payment.Destination)
+	require.Equal(t, types.XRPCurrencyAmount(1000), payment.DeliverMax)
+}
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
@@ -15,6 +16,15 @@ type Transaction struct {
 	Validated   bool                        `json:"validated"`
 }
 
+// TypedTx returns the transaction as the typed transaction of its TransactionType,
+// decoding TxBlob with opts if the response is in binary format.
+func (t *Transaction) TypedTx(opts ...binarycodec.Option) (transaction.Tx, error) {
+	if len(t.Tx) == 0 && t.TxBlob != "" {
+		return transaction.FromBlob(t.TxBlob, opts...)
+	}
+	return transaction.FromFlat(t.Tx)
+}
+
 // ############################################################################
 // Request
 // ############################################################################
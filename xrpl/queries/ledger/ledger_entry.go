@@ -0,0 +1,155 @@
+package ledger
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	ledgertypes "github.com/Peersyst/xrpl-go/xrpl/queries/ledger/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+var (
+	ErrNoEntrySelected         = errors.New("no ledger entry selected")
+	ErrMultipleEntriesSelected = errors.New("only one ledger entry can be selected per request")
+	ErrNoBridgeAccount         = errors.New("bridge_account is required to select a bridge")
+	ErrNoDirectoryOwnerOrRoot  = errors.New("directory requires an owner or a dir_root")
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// The ledger_entry method returns a single ledger entry from the XRP Ledger in
+// its raw format. Exactly one of the fields selecting the entry must be set.
+type EntryRequest struct {
+	common.BaseRequest
+	LedgerHash  common.LedgerHash      `json:"ledger_hash,omitempty"`
+	LedgerIndex common.LedgerSpecifier `json:"ledger_index,omitempty"`
+	// If true, return the ledger entry as a hex string instead of JSON.
+	Binary bool `json:"binary,omitempty"`
+
+	// The ID of any ledger entry.
+	Index string `json:"index,omitempty"`
+	// The classic address of an AccountRoot entry.
+	AccountRoot types.Address `json:"account_root,omitempty"`
+	// The trust line between two accounts.
+	RippleState *ledgertypes.RippleStateEntry `json:"ripple_state,omitempty"`
+	Offer       *ledgertypes.OfferEntry       `json:"offer,omitempty"`
+	Escrow      *ledgertypes.EscrowEntry      `json:"escrow,omitempty"`
+	// The ID of a PayChannel entry.
+	PaymentChannel string `json:"payment_channel,omitempty"`
+	// The ID of a Check entry.
+	Check     string                      `json:"check,omitempty"`
+	Ticket    *ledgertypes.TicketEntry    `json:"ticket,omitempty"`
+	Directory *ledgertypes.DirectoryEntry `json:"directory,omitempty"`
+	// The preauthorization granted by an account.
+	DepositPreauth *ledgertypes.DepositPreauthEntry `json:"deposit_preauth,omitempty"`
+	// The ID of an NFTokenPage entry.
+	NFTPage string `json:"nft_page,omitempty"`
+	// The classic address of the account owning a DID entry.
+	DID        types.Address                `json:"did,omitempty"`
+	Oracle     *ledgertypes.OracleEntry     `json:"oracle,omitempty"`
+	Credential *ledgertypes.CredentialEntry `json:"credential,omitempty"`
+	MPToken    *ledgertypes.MPTokenEntry    `json:"mptoken,omitempty"`
+	// The ID of an MPTokenIssuance entry.
+	MPTIssuance string                `json:"mpt_issuance,omitempty"`
+	AMM         *ledgertypes.AMMEntry `json:"amm,omitempty"`
+	// The bridge to return, along with the door account owning it in BridgeAccount.
+	Bridge                          *ledgertypes.BridgeEntry                          `json:"bridge,omitempty"`
+	BridgeAccount                   types.Address                                     `json:"bridge_account,omitempty"`
+	XChainOwnedClaimID              *ledgertypes.XChainOwnedClaimIDEntry              `json:"xchain_owned_claim_id,omitempty"`
+	XChainOwnedCreateAccountClaimID *ledgertypes.XChainOwnedCreateAccountClaimIDEntry `json:"xchain_owned_create_account_claim_id,omitempty"`
+	PermissionedDomain              *ledgertypes.PermissionedDomainEntry              `json:"permissioned_domain,omitempty"`
+}
+
+func (*EntryRequest) Method() string {
+	return "ledger_entry"
+}
+
+func (*EntryRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate checks that exactly one ledger entry is selected by the request.
+func (r *EntryRequest) Validate() error {
+	selected := 0
+	for _, set := range []bool{
+		r.Index != "",
+		r.AccountRoot != "",
+		r.RippleState != nil,
+		r.Offer != nil,
+		r.Escrow != nil,
+		r.PaymentChannel != "",
+		r.Check != "",
+		r.Ticket != nil,
+		r.Directory != nil,
+		r.DepositPreauth != nil,
+		r.NFTPage != "",
+		r.DID != "",
+		r.Oracle != nil,
+		r.Credential != nil,
+		r.MPToken != nil,
+		r.MPTIssuance != "",
+		r.AMM != nil,
+		r.Bridge != nil,
+		r.XChainOwnedClaimID != nil,
+		r.XChainOwnedCreateAccountClaimID != nil,
+		r.PermissionedDomain != nil,
+	} {
+		if set {
+			selected++
+		}
+	}
+	switch {
+	case selected == 0:
+		return ErrNoEntrySelected
+	case selected > 1:
+		return ErrMultipleEntriesSelected
+	case r.Bridge != nil && r.BridgeAccount == "":
+		return ErrNoBridgeAccount
+	case r.Directory != nil && r.Directory.Owner == "" && r.Directory.DirRoot == "":
+		return ErrNoDirectoryOwnerOrRoot
+	}
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// The expected response from the ledger_entry method.
+type EntryResponse struct {
+	// The unique ID of the ledger entry.
+	Index              string             `json:"index"`
+	LedgerIndex        common.LedgerIndex `json:"ledger_index,omitempty"`
+	LedgerCurrentIndex common.LedgerIndex `json:"ledger_current_index,omitempty"`
+	LedgerHash         common.LedgerHash  `json:"ledger_hash,omitempty"`
+	// The ledger entry in JSON format. Omitted if binary was requested.
+	Node ledger.FlatLedgerObject `json:"node,omitempty"`
+	// The ledger entry as a hex string. Only present if binary was requested.
+	NodeBinary string `json:"node_binary,omitempty"`
+	Validated  bool   `json:"validated,omitempty"`
+	// The ledger entry decoded into its type, set by DecodeNode.
+	Object ledger.Object `json:"-"`
+}
+
+// DecodeNode decodes Node into the ledger object matching its LedgerEntryType and
+// stores it in Object. It does nothing for binary responses.
+func (r *EntryResponse) DecodeNode() error {
+	if r.Node == nil {
+		return nil
+	}
+	data, err := json.Marshal(r.Node)
+	if err != nil {
+		return err
+	}
+	obj, err := ledger.UnmarshalLedgerObject(data)
+	if err != nil {
+		return err
+	}
+	r.Object = obj
+	return nil
+}
@@ -0,0 +1,201 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	ledgertypes "github.com/Peersyst/xrpl-go/xrpl/queries/ledger/types"
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLedgerEntryRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		req  EntryRequest
+		json string
+	}{
+		{
+			name: "account root",
+			req: EntryRequest{
+				LedgerIndex: common.Validated,
+				AccountRoot: "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+			},
+			json: `{
+	"ledger_index": "validated",
+	"account_root": "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn"
+}`,
+		},
+		{
+			name: "ripple state",
+			req: EntryRequest{
+				RippleState: &ledgertypes.RippleStateEntry{
+					Accounts: [2]types.Address{"rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn", "rrrrrrrrrrrrrrrrrrrrBZbvji"},
+					Currency: "USD",
+				},
+			},
+			json: `{
+	"ripple_state": {
+		"accounts": [
+			"rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+			"rrrrrrrrrrrrrrrrrrrrBZbvji"
+		],
+		"currency": "USD"
+	}
+}`,
+		},
+		{
+			name: "mptoken",
+			req: EntryRequest{
+				Binary: true,
+				MPToken: &ledgertypes.MPTokenEntry{
+					MPTIssuanceID: "0000012FFD9EE5DA93AC614B4DB94D7E0FCE415CA51BED47",
+					Account:       "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+				},
+			},
+			json: `{
+	"binary": true,
+	"mptoken": {
+		"mpt_issuance_id": "0000012FFD9EE5DA93AC614B4DB94D7E0FCE415CA51BED47",
+		"account": "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn"
+	}
+}`,
+		},
+		{
+			name: "amm",
+			req: EntryRequest{
+				AMM: &ledgertypes.AMMEntry{
+					Asset:  ledger.Asset{Currency: "XRP"},
+					Asset2: ledger.Asset{Currency: "TST", Issuer: "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd"},
+				},
+			},
+			json: `{
+	"amm": {
+		"asset": {
+			"currency": "XRP"
+		},
+		"asset2": {
+			"currency": "TST",
+			"issuer": "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd"
+		}
+	}
+}`,
+		},
+		{
+			name: "xchain owned claim id",
+			req: EntryRequest{
+				XChainOwnedClaimID: &ledgertypes.XChainOwnedClaimIDEntry{
+					BridgeEntry: ledgertypes.BridgeEntry{
+						LockingChainDoor:  "rMAXACCrp3Y8PpswXcg3bKggHX76V3F8M4",
+						LockingChainIssue: ledger.Asset{Currency: "XRP"},
+						IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+						IssuingChainIssue: ledger.Asset{Currency: "XRP"},
+					},
+					XChainOwnedClaimID: 4,
+				},
+			},
+			json: `{
+	"xchain_owned_claim_id": {
+		"LockingChainDoor": "rMAXACCrp3Y8PpswXcg3bKggHX76V3F8M4",
+		"LockingChainIssue": {
+			"currency": "XRP"
+		},
+		"IssuingChainDoor": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"IssuingChainIssue": {
+			"currency": "XRP"
+		},
+		"xchain_owned_claim_id": 4
+	}
+}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := testutil.Serialize(t, tt.req, tt.json); err != nil {
+				t.Error(err)
+			}
+			require.NoError(t, tt.req.Validate())
+		})
+	}
+}
+
+func TestLedgerEntryRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  EntryRequest
+		err  error
+	}{
+		{
+			name: "no entry selected",
+			req:  EntryRequest{LedgerIndex: common.Validated},
+			err:  ErrNoEntrySelected,
+		},
+		{
+			name: "multiple entries selected",
+			req: EntryRequest{
+				Index:       "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+				AccountRoot: "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+			},
+			err: ErrMultipleEntriesSelected,
+		},
+		{
+			name: "bridge without bridge account",
+			req: EntryRequest{
+				Bridge: &ledgertypes.BridgeEntry{
+					LockingChainDoor: "rMAXACCrp3Y8PpswXcg3bKggHX76V3F8M4",
+					IssuingChainDoor: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+				},
+			},
+			err: ErrNoBridgeAccount,
+		},
+		{
+			name: "directory without owner or root",
+			req: EntryRequest{
+				Directory: &ledgertypes.DirectoryEntry{SubIndex: 1},
+			},
+			err: ErrNoDirectoryOwnerOrRoot,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, tt.req.Validate(), tt.err)
+		})
+	}
+}
+
+func TestLedgerEntryResponse_DecodeNode(t *testing.T) {
+	res := EntryResponse{
+		Index: "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+		Node: ledger.FlatLedgerObject{
+			"Account":           "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+			"Balance":           "424021949",
+			"Flags":             0,
+			"LedgerEntryType":   "AccountRoot",
+			"OwnerCount":        4,
+			"PreviousTxnID":     "52A3B2FF5D1F1E1E4C4E2C1E2C4E1E0E4F5E6E7E8E9EAEBECEDEEEFF00112233",
+			"PreviousTxnLgrSeq": 3594,
+			"Sequence":          44,
+			"index":             "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+		},
+	}
+
+	require.NoError(t, res.DecodeNode())
+	require.Equal(t, &ledger.AccountRoot{
+		Index:             "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+		LedgerEntryType:   ledger.AccountRootEntry,
+		Account:           "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+		Balance:           types.XRPCurrencyAmount(424021949),
+		OwnerCount:        4,
+		PreviousTxnID:     "52A3B2FF5D1F1E1E4C4E2C1E2C4E1E0E4F5E6E7E8E9EAEBECEDEEEFF00112233",
+		PreviousTxnLgrSeq: 3594,
+		Sequence:          44,
+	}, res.Object)
+
+	binary := EntryResponse{NodeBinary: "1100612200000000"}
+	require.NoError(t, binary.DecodeNode())
+	require.Nil(t, binary.Object)
+}
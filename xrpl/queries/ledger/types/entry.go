@@ -0,0 +1,105 @@
+package types
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// RippleStateEntry selects the trust line between two accounts in a currency.
+type RippleStateEntry struct {
+	// The two accounts linked by the trust line, in any order.
+	Accounts [2]types.Address `json:"accounts"`
+	Currency string           `json:"currency"`
+}
+
+// OfferEntry selects the offer created by an account with a sequence number.
+type OfferEntry struct {
+	Account types.Address `json:"account"`
+	Seq     uint32        `json:"seq"`
+}
+
+// EscrowEntry selects the escrow created by an owner with a sequence number.
+type EscrowEntry struct {
+	Owner types.Address `json:"owner"`
+	Seq   uint32        `json:"seq"`
+}
+
+// TicketEntry selects the ticket of an account with a ticket sequence number.
+type TicketEntry struct {
+	Account   types.Address `json:"account"`
+	TicketSeq uint32        `json:"ticket_seq"`
+}
+
+// DirectoryEntry selects a page of an owner directory. Either Owner or DirRoot must be set.
+type DirectoryEntry struct {
+	Owner    types.Address `json:"owner,omitempty"`
+	DirRoot  string        `json:"dir_root,omitempty"`
+	SubIndex uint64        `json:"sub_index,omitempty"`
+}
+
+// DepositPreauthEntry selects the preauthorization granted by an owner to an account,
+// or to a set of credentials.
+type DepositPreauthEntry struct {
+	Owner                 types.Address          `json:"owner"`
+	Authorized            types.Address          `json:"authorized,omitempty"`
+	AuthorizedCredentials []AuthorizedCredential `json:"authorized_credentials,omitempty"`
+}
+
+// AuthorizedCredential identifies a credential accepted by a deposit preauthorization.
+type AuthorizedCredential struct {
+	Issuer         types.Address `json:"issuer"`
+	CredentialType string        `json:"credential_type"`
+}
+
+// OracleEntry selects the price oracle of an account with a document ID.
+type OracleEntry struct {
+	Account          types.Address `json:"account"`
+	OracleDocumentID uint32        `json:"oracle_document_id"`
+}
+
+// CredentialEntry selects the credential of a type issued by an issuer to a subject.
+type CredentialEntry struct {
+	Subject types.Address `json:"subject"`
+	Issuer  types.Address `json:"issuer"`
+	// The type of the credential, as a hex string.
+	CredentialType string `json:"credential_type"`
+}
+
+// MPTokenEntry selects the MPToken held by an account for an issuance.
+type MPTokenEntry struct {
+	MPTIssuanceID string        `json:"mpt_issuance_id"`
+	Account       types.Address `json:"account"`
+}
+
+// AMMEntry selects the AMM of an asset pair, in any order.
+type AMMEntry struct {
+	Asset  ledger.Asset `json:"asset"`
+	Asset2 ledger.Asset `json:"asset2"`
+}
+
+// BridgeEntry describes a cross-chain bridge by its door accounts and assets.
+type BridgeEntry struct {
+	LockingChainDoor  types.Address `json:"LockingChainDoor"`
+	LockingChainIssue ledger.Asset  `json:"LockingChainIssue"`
+	IssuingChainDoor  types.Address `json:"IssuingChainDoor"`
+	IssuingChainIssue ledger.Asset  `json:"IssuingChainIssue"`
+}
+
+// XChainOwnedClaimIDEntry selects a cross-chain claim ID of a bridge.
+type XChainOwnedClaimIDEntry struct {
+	BridgeEntry
+	XChainOwnedClaimID uint64 `json:"xchain_owned_claim_id"`
+}
+
+// XChainOwnedCreateAccountClaimIDEntry selects a cross-chain account create claim ID of a bridge.
+type XChainOwnedCreateAccountClaimIDEntry struct {
+	BridgeEntry
+	XChainOwnedCreateAccountClaimID uint64 `json:"xchain_owned_create_account_claim_id"`
+}
+
+// PermissionedDomainEntry selects the permissioned domain created by an account with
+// a sequence number.
+type PermissionedDomainEntry struct {
+	Account types.Address `json:"account"`
+	Seq     uint32        `json:"seq"`
+}
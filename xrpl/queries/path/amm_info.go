@@ -0,0 +1,67 @@
+package path
+
+import (
+	"errors"
+
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+var (
+	ErrNoAMMSelected         = errors.New("either amm_account or asset and asset2 must be set")
+	ErrAMMAccountAndAssetSet = errors.New("amm_account cannot be set along with asset and asset2")
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// The amm_info method gets information about an Automated Market Maker (AMM)
+// instance, selected either by its asset pair or by its account.
+type AMMInfoRequest struct {
+	common.BaseRequest
+	// One of the assets of the AMM to look up. Must be set along with Asset2.
+	Asset *ledger.Asset `json:"asset,omitempty"`
+	// The other asset of the AMM to look up. Must be set along with Asset.
+	Asset2 *ledger.Asset `json:"asset2,omitempty"`
+	// The address of the AMM's special account.
+	AMMAccount  types.Address          `json:"amm_account,omitempty"`
+	LedgerHash  common.LedgerHash      `json:"ledger_hash,omitempty"`
+	LedgerIndex common.LedgerSpecifier `json:"ledger_index,omitempty"`
+}
+
+func (*AMMInfoRequest) Method() string {
+	return "amm_info"
+}
+
+func (*AMMInfoRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+// Validate checks that the AMM is selected either by its asset pair or by its account.
+func (r *AMMInfoRequest) Validate() error {
+	hasAssets := r.Asset != nil && r.Asset2 != nil
+	if r.AMMAccount == "" && !hasAssets {
+		return ErrNoAMMSelected
+	}
+	if r.AMMAccount != "" && (r.Asset != nil || r.Asset2 != nil) {
+		return ErrAMMAccountAndAssetSet
+	}
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// The expected response from the amm_info method.
+type AMMInfoResponse struct {
+	AMM                pathtypes.AMM      `json:"amm"`
+	LedgerCurrentIndex common.LedgerIndex `json:"ledger_current_index,omitempty"`
+	LedgerIndex        common.LedgerIndex `json:"ledger_index,omitempty"`
+	LedgerHash         common.LedgerHash  `json:"ledger_hash,omitempty"`
+	Validated          bool               `json:"validated,omitempty"`
+}
@@ -0,0 +1,167 @@
+package path
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAMMInfoRequest(t *testing.T) {
+	s := AMMInfoRequest{
+		Asset:       &ledger.Asset{Currency: "XRP"},
+		Asset2:      &ledger.Asset{Currency: "TST", Issuer: "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd"},
+		LedgerIndex: common.Validated,
+	}
+
+	j := `{
+	"asset": {
+		"currency": "XRP"
+	},
+	"asset2": {
+		"currency": "TST",
+		"issuer": "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd"
+	},
+	"ledger_index": "validated"
+}`
+
+	if err := testutil.Serialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAMMInfoRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  AMMInfoRequest
+		err  error
+	}{
+		{
+			name: "asset pair",
+			req: AMMInfoRequest{
+				Asset:  &ledger.Asset{Currency: "XRP"},
+				Asset2: &ledger.Asset{Currency: "TST", Issuer: "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd"},
+			},
+		},
+		{
+			name: "amm account",
+			req:  AMMInfoRequest{AMMAccount: "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM"},
+		},
+		{
+			name: "nothing selected",
+			req:  AMMInfoRequest{},
+			err:  ErrNoAMMSelected,
+		},
+		{
+			name: "single asset",
+			req:  AMMInfoRequest{Asset: &ledger.Asset{Currency: "XRP"}},
+			err:  ErrNoAMMSelected,
+		},
+		{
+			name: "amm account and asset",
+			req: AMMInfoRequest{
+				AMMAccount: "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+				Asset:      &ledger.Asset{Currency: "XRP"},
+			},
+			err: ErrAMMAccountAndAssetSet,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestAMMInfoResponse(t *testing.T) {
+	s := AMMInfoResponse{
+		AMM: pathtypes.AMM{
+			Account:      "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+			Amount:       types.XRPCurrencyAmount(296890496),
+			Amount2:      types.IssuedCurrencyAmount{Currency: "TST", Issuer: "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd", Value: "25.81656470648473"},
+			Asset2Frozen: false,
+			AuctionSlot: &pathtypes.AMMAuctionSlot{
+				Account: "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm",
+				AuthAccounts: []pathtypes.AMMAuthAccount{
+					{Account: "r3f2WpQMsAd8k4Zoijv2PZ78EYFJ2EdvgV"},
+				},
+				DiscountedFee: 60,
+				Expiration:    "2023-Jun-26 06:17:30.000000000 UTC",
+				Price: types.IssuedCurrencyAmount{
+					Currency: "039C99CD9AB0B70B32ECDA51EAAE471625608EA2",
+					Issuer:   "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+					Value:    "0",
+				},
+				TimeInterval: 0,
+			},
+			LPToken: types.IssuedCurrencyAmount{
+				Currency: "039C99CD9AB0B70B32ECDA51EAAE471625608EA2",
+				Issuer:   "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+				Value:    "87533.41976112682",
+			},
+			TradingFee: 600,
+			VoteSlots: []pathtypes.AMMVoteSlot{
+				{Account: "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm", TradingFee: 600, VoteWeight: 100000},
+			},
+		},
+		LedgerIndex: 316745,
+		Validated:   true,
+	}
+
+	j := `{
+	"amm": {
+		"account": "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+		"amount": "296890496",
+		"amount2": {
+			"issuer": "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd",
+			"currency": "TST",
+			"value": "25.81656470648473"
+		},
+		"auction_slot": {
+			"account": "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm",
+			"auth_accounts": [
+				{
+					"account": "r3f2WpQMsAd8k4Zoijv2PZ78EYFJ2EdvgV"
+				}
+			],
+			"discounted_fee": 60,
+			"expiration": "2023-Jun-26 06:17:30.000000000 UTC",
+			"price": {
+				"issuer": "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+				"currency": "039C99CD9AB0B70B32ECDA51EAAE471625608EA2",
+				"value": "0"
+			},
+			"time_interval": 0
+		},
+		"lp_token": {
+			"issuer": "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+			"currency": "039C99CD9AB0B70B32ECDA51EAAE471625608EA2",
+			"value": "87533.41976112682"
+		},
+		"trading_fee": 600,
+		"vote_slots": [
+			{
+				"account": "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm",
+				"trading_fee": 600,
+				"vote_weight": 100000
+			}
+		]
+	},
+	"ledger_index": 316745,
+	"validated": true
+}`
+
+	if err := testutil.SerializeAndDeserialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
@@ -21,6 +21,7 @@ type BookOffersRequest struct {
 	LedgerHash  common.LedgerHash           `json:"ledger_hash,omitempty"`
 	LedgerIndex common.LedgerIndex          `json:"ledger_index,omitempty"`
 	Limit       int                         `json:"limit,omitempty"`
+	Marker      any                         `json:"marker,omitempty"`
 }
 
 func (*BookOffersRequest) Method() string {
@@ -47,4 +48,5 @@ type BookOffersResponse struct {
 	LedgerHash         common.LedgerHash     `json:"ledger_hash,omitempty"`
 	Offers             []pathtypes.BookOffer `json:"offers"`
 	Validated          bool                  `json:"validated,omitempty"`
+	Marker             any                   `json:"marker,omitempty"`
 }
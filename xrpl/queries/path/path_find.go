@@ -1,6 +1,8 @@
 package path
 
 import (
+	"encoding/json"
+
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
@@ -96,14 +98,45 @@ func (*FindStatusRequest) Validate() error {
 
 // TODO: Add ID handling (v2)
 
-// The expected response from the path_find method.
+// The expected response from the path_find method. Updates of an open path_find
+// request are sent by the server with the same fields.
 type FindResponse struct {
 	Alternatives       []pathtypes.Alternative `json:"alternatives"`
 	DestinationAccount types.Address           `json:"destination_account"`
-	// DestinationAmount  types.CurrencyAmount    `json:"destination_amount"`
-	DestinationAmount any           `json:"destination_amount"`
-	SourceAccount     types.Address `json:"source_account"`
-	FullReply         bool          `json:"full_reply"`
-	Closed            bool          `json:"closed,omitempty"`
-	Status            bool          `json:"status,omitempty"`
+	DestinationAmount  types.CurrencyAmount    `json:"destination_amount"`
+	SourceAccount      types.Address           `json:"source_account"`
+	FullReply          bool                    `json:"full_reply"`
+	Closed             bool                    `json:"closed,omitempty"`
+	Status             bool                    `json:"status,omitempty"`
+}
+
+// UnmarshalJSON decodes the destination amount into its concrete currency amount type.
+func (r *FindResponse) UnmarshalJSON(data []byte) error {
+	type frHelper struct {
+		Alternatives       []pathtypes.Alternative `json:"alternatives"`
+		DestinationAccount types.Address           `json:"destination_account"`
+		DestinationAmount  json.RawMessage         `json:"destination_amount"`
+		SourceAccount      types.Address           `json:"source_account"`
+		FullReply          bool                    `json:"full_reply"`
+		Closed             bool                    `json:"closed,omitempty"`
+		Status             bool                    `json:"status,omitempty"`
+	}
+	var h frHelper
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	amount, err := types.UnmarshalCurrencyAmount(h.DestinationAmount)
+	if err != nil {
+		return err
+	}
+	*r = FindResponse{
+		Alternatives:       h.Alternatives,
+		DestinationAccount: h.DestinationAccount,
+		DestinationAmount:  amount,
+		SourceAccount:      h.SourceAccount,
+		FullReply:          h.FullReply,
+		Closed:             h.Closed,
+		Status:             h.Status,
+	}
+	return nil
 }
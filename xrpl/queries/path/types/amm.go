@@ -0,0 +1,99 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// AMM describes the current state of an Automated Market Maker instance.
+type AMM struct {
+	// The address of the special account that holds the AMM's assets.
+	Account types.Address `json:"account"`
+	// The total amount of one asset in the AMM's pool.
+	Amount types.CurrencyAmount `json:"amount"`
+	// The total amount of the other asset in the AMM's pool.
+	Amount2 types.CurrencyAmount `json:"amount2"`
+	// Whether the first asset is frozen. Omitted for XRP.
+	AssetFrozen bool `json:"asset_frozen,omitempty"`
+	// Whether the second asset is frozen. Omitted for XRP.
+	Asset2Frozen bool `json:"asset2_frozen,omitempty"`
+	// The current owner of the auction slot, if any.
+	AuctionSlot *AMMAuctionSlot `json:"auction_slot,omitempty"`
+	// The total amount of LP Tokens issued by the AMM.
+	LPToken types.IssuedCurrencyAmount `json:"lp_token"`
+	// The fee charged for trades against the AMM, in units of 1/100,000.
+	TradingFee uint16 `json:"trading_fee"`
+	// The current votes on the trading fee.
+	VoteSlots []AMMVoteSlot `json:"vote_slots,omitempty"`
+}
+
+// UnmarshalJSON decodes the pool amounts into their concrete currency amount types.
+func (a *AMM) UnmarshalJSON(data []byte) error {
+	type ammHelper struct {
+		Account      types.Address              `json:"account"`
+		Amount       json.RawMessage            `json:"amount"`
+		Amount2      json.RawMessage            `json:"amount2"`
+		AssetFrozen  bool                       `json:"asset_frozen,omitempty"`
+		Asset2Frozen bool                       `json:"asset2_frozen,omitempty"`
+		AuctionSlot  *AMMAuctionSlot            `json:"auction_slot,omitempty"`
+		LPToken      types.IssuedCurrencyAmount `json:"lp_token"`
+		TradingFee   uint16                     `json:"trading_fee"`
+		VoteSlots    []AMMVoteSlot              `json:"vote_slots,omitempty"`
+	}
+	var h ammHelper
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	amount, err := types.UnmarshalCurrencyAmount(h.Amount)
+	if err != nil {
+		return err
+	}
+	amount2, err := types.UnmarshalCurrencyAmount(h.Amount2)
+	if err != nil {
+		return err
+	}
+	*a = AMM{
+		Account:      h.Account,
+		Amount:       amount,
+		Amount2:      amount2,
+		AssetFrozen:  h.AssetFrozen,
+		Asset2Frozen: h.Asset2Frozen,
+		AuctionSlot:  h.AuctionSlot,
+		LPToken:      h.LPToken,
+		TradingFee:   h.TradingFee,
+		VoteSlots:    h.VoteSlots,
+	}
+	return nil
+}
+
+// AMMAuctionSlot describes the current owner of an AMM's auction slot.
+type AMMAuctionSlot struct {
+	// The account that owns the auction slot.
+	Account types.Address `json:"account"`
+	// Additional accounts the owner allows to trade at the discounted fee.
+	AuthAccounts []AMMAuthAccount `json:"auth_accounts,omitempty"`
+	// The discounted trading fee for the owner and the authorized accounts, in units of 1/100,000.
+	DiscountedFee uint32 `json:"discounted_fee"`
+	// The time when the slot expires, as an ISO 8601 UTC timestamp.
+	Expiration string `json:"expiration"`
+	// The amount, in LP Tokens, the owner paid to win the slot.
+	Price types.IssuedCurrencyAmount `json:"price"`
+	// The current 72-minute time interval of the auction slot, from 0 to 19.
+	TimeInterval uint32 `json:"time_interval"`
+}
+
+// AMMAuthAccount is an account allowed to trade at the discounted fee of an auction slot.
+type AMMAuthAccount struct {
+	Account types.Address `json:"account"`
+}
+
+// AMMVoteSlot is a liquidity provider's vote on the trading fee of an AMM.
+type AMMVoteSlot struct {
+	// The account that cast the vote.
+	Account types.Address `json:"account"`
+	// The proposed trading fee, in units of 1/100,000.
+	TradingFee uint16 `json:"trading_fee"`
+	// The weight of the vote, in units of 1/100,000.
+	VoteWeight uint32 `json:"vote_weight"`
+}
@@ -1,15 +1,44 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
 type Alternative struct {
-	PathsComputed [][]transaction.PathStep `json:"paths_computed"`
-	// SourceAmount      types.CurrencyAmount     `json:"source_amount"`
-	SourceAmount any `json:"source_amount"`
-	// DestinationAmount types.CurrencyAmount     `json:"destination_amount,omitempty"`
-	DestinationAmount any `json:"destination_amount,omitempty"`
+	PathsComputed     [][]transaction.PathStep `json:"paths_computed"`
+	SourceAmount      types.CurrencyAmount     `json:"source_amount"`
+	DestinationAmount types.CurrencyAmount     `json:"destination_amount,omitempty"`
+}
+
+// UnmarshalJSON decodes the source and destination amounts into their concrete
+// currency amount types.
+func (a *Alternative) UnmarshalJSON(data []byte) error {
+	type altHelper struct {
+		PathsComputed     [][]transaction.PathStep `json:"paths_computed"`
+		SourceAmount      json.RawMessage          `json:"source_amount"`
+		DestinationAmount json.RawMessage          `json:"destination_amount"`
+	}
+	var h altHelper
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	source, err := types.UnmarshalCurrencyAmount(h.SourceAmount)
+	if err != nil {
+		return err
+	}
+	destination, err := types.UnmarshalCurrencyAmount(h.DestinationAmount)
+	if err != nil {
+		return err
+	}
+	*a = Alternative{
+		PathsComputed:     h.PathsComputed,
+		SourceAmount:      source,
+		DestinationAmount: destination,
+	}
+	return nil
 }
 
 type RippleAlternative struct {
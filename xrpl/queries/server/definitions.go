@@ -0,0 +1,60 @@
+package server
+
+import (
+	"encoding/json"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+)
+
+// ############################################################################
+// Request
+// ############################################################################
+
+// The server_definitions method returns the definitions the server uses to
+// encode and decode transactions and ledger entries in binary format.
+type DefinitionsRequest struct {
+	common.BaseRequest
+	// (Optional) The hash of definitions the client already has. When it
+	// matches the server definitions, only the hash is returned.
+	Hash string `json:"hash,omitempty"`
+}
+
+func (*DefinitionsRequest) Method() string {
+	return "server_definitions"
+}
+
+func (*DefinitionsRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+func (*DefinitionsRequest) Validate() error {
+	return nil
+}
+
+// ############################################################################
+// Response
+// ############################################################################
+
+// The expected response from the server_definitions method.
+type DefinitionsResponse struct {
+	// The hash of the definitions.
+	Hash               string           `json:"hash"`
+	Types              map[string]int32 `json:"TYPES,omitempty"`
+	LedgerEntryTypes   map[string]int32 `json:"LEDGER_ENTRY_TYPES,omitempty"`
+	TransactionResults map[string]int32 `json:"TRANSACTION_RESULTS,omitempty"`
+	TransactionTypes   map[string]int32 `json:"TRANSACTION_TYPES,omitempty"`
+	// Each field as a [name, info] pair.
+	Fields [][]any `json:"FIELDS,omitempty"`
+}
+
+// Definitions builds binary codec definitions from the response. It returns
+// an error if the response only holds the hash.
+func (r *DefinitionsResponse) Definitions() (*definitions.Definitions, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	return definitions.FromJSON(data)
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/xrpl/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefinitionsRequest(t *testing.T) {
+	s := DefinitionsRequest{
+		Hash: "3F3E5C5A0DF7E3A3E26C3B0A8D3D19D3C0D5E8A8F0C2B9E5A4B8D3C8E1F0A2B4",
+	}
+
+	j := `{
+	"hash": "3F3E5C5A0DF7E3A3E26C3B0A8D3D19D3C0D5E8A8F0C2B9E5A4B8D3C8E1F0A2B4"
+}`
+
+	if err := testutil.SerializeAndDeserialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDefinitionsResponse(t *testing.T) {
+	s := DefinitionsResponse{
+		Hash:             "3F3E5C5A0DF7E3A3E26C3B0A8D3D19D3C0D5E8A8F0C2B9E5A4B8D3C8E1F0A2B4",
+		Types:            map[string]int32{"UInt32": 2},
+		TransactionTypes: map[string]int32{"Payment": 0},
+		Fields: [][]any{
+			{"Sequence", map[string]any{
+				"isSerialized":   true,
+				"isSigningField": true,
+				"isVLEncoded":    false,
+				"nth":            float64(4),
+				"type":           "UInt32",
+			}},
+		},
+	}
+
+	j := `{
+	"hash": "3F3E5C5A0DF7E3A3E26C3B0A8D3D19D3C0D5E8A8F0C2B9E5A4B8D3C8E1F0A2B4",
+	"TYPES": {
+		"UInt32": 2
+	},
+	"TRANSACTION_TYPES": {
+		"Payment": 0
+	},
+	"FIELDS": [
+		[
+			"Sequence",
+			{
+				"isSerialized": true,
+				"isSigningField": true,
+				"isVLEncoded": false,
+				"nth": 4,
+				"type": "UInt32"
+			}
+		]
+	]
+}`
+
+	if err := testutil.SerializeAndDeserialize(t, s, j); err != nil {
+		t.Error(err)
+	}
+
+	d, err := s.Definitions()
+	require.NoError(t, err)
+	require.Equal(t, &definitions.FieldHeader{TypeCode: 2, FieldCode: 4}, d.Fields["Sequence"].FieldHeader)
+	require.Equal(t, int32(0), d.TransactionTypes["Payment"])
+}
+
+func TestDefinitionsResponse_HashOnly(t *testing.T) {
+	s := DefinitionsResponse{Hash: "3F3E5C5A0DF7E3A3E26C3B0A8D3D19D3C0D5E8A8F0C2B9E5A4B8D3C8E1F0A2B4"}
+	_, err := s.Definitions()
+	require.ErrorIs(t, err, definitions.ErrIncompleteDefinitions)
+}
@@ -20,6 +20,9 @@ type ValidationStream struct {
 	// usually indicates that multiple servers are incorrectly configured to use the same
 	// validation key pair.
 	Cookie interface{} `json:"cookie,omitempty"`
+	// (May be omitted) The signed validation message in binary format, as a hex string.
+	// It can be decoded and verified with the validator package.
+	Data string `json:"data,omitempty"`
 	// Bit-mask of flags added to this validation message. The flag 0x80000000 indicates
 	// that the validation signature is fully-canonical. The flag 0x00000001 indicates
 	// that this is a full validation; otherwise it's a partial validation. Partial
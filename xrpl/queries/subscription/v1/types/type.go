@@ -9,4 +9,5 @@ const (
 	PeerStatusStreamType  Type = "peerStatusChange"
 	OrderBookStreamType   Type = TransactionStreamType
 	ConsensusStreamType   Type = "consensusPhase"
+	BookChangesStreamType Type = "bookChanges"
 )
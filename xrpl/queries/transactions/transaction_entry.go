@@ -32,3 +32,8 @@ type EntryResponse struct {
 	Metadata    transaction.TxObjMeta       `json:"metadata"`
 	Tx          transaction.FlatTransaction `json:"tx_json"`
 }
+
+// TypedTx returns the transaction as the typed transaction of its TransactionType.
+func (r *EntryResponse) TypedTx() (transaction.Tx, error) {
+	return transaction.FromFlat(r.Tx)
+}
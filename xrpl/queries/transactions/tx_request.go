@@ -1,6 +1,7 @@
 package transactions
 
 import (
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
@@ -46,5 +47,16 @@ type TxResponse struct {
 	// TODO: Improve Meta parsing
 	Meta      any                         `json:"meta"`
 	Validated bool                        `json:"validated"`
-	Tx        transaction.FlatTransaction `json:",omitempty"`
+	Tx        transaction.FlatTransaction `json:"tx_json,omitempty"`
+	// The transaction in binary format, when the request is binary.
+	TxBlob string `json:"tx_blob,omitempty"`
+}
+
+// TypedTx returns the transaction as the typed transaction of its TransactionType,
+// decoding TxBlob with opts if the response is in binary format.
+func (r *TxResponse) TypedTx(opts ...binarycodec.Option) (transaction.Tx, error) {
+	if len(r.Tx) == 0 && r.TxBlob != "" {
+		return transaction.FromBlob(r.TxBlob, opts...)
+	}
+	return transaction.FromFlat(r.Tx)
 }
@@ -0,0 +1,53 @@
+package transactions
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testPaymentBlob = "1200002280000000240000016861D4838D7EA4C6800000000000000000000000000055534400000000004B4E9C06F24296074F7BC48F92A97916C6DC5EA9684000000000002710732103AB40A0490F9B7ED8DF29D246BF2D6269820A0EE7742ACDD457BEA7C7D0931EDB7446304402200E5C2DD81FDF0BE9AB2A8D797885ED49E804DBF28E806604D878756410CA98B102203349581946B0DDA06B36B35DBC20EDA27552C1F167BCF5C6ECFF49C6A46F858081144B4E9C06F24296074F7BC48F92A97916C6DC5EA983143E9D4A2B8AA0780F682D136F7A56D6724EF53754"
+
+func TestTxResponse_TypedTx(t *testing.T) {
+	t.Run("pass - tx_json", func(t *testing.T) {
+		r := TxResponse{
+			Tx: transaction.FlatTransaction{
+				"TransactionType": "AccountSet",
+				"Account":         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+				"Fee":             "12",
+				"Sequence":        float64(5),
+				"SetFlag":         float64(8),
+			},
+		}
+
+		tx, err := r.TypedTx()
+		require.NoError(t, err)
+		require.IsType(t, &transaction.AccountSet{}, tx)
+		require.Equal(t, uint32(5), tx.(*transaction.AccountSet).Sequence)
+		require.Equal(t, uint32(8), tx.(*transaction.AccountSet).SetFlag)
+	})
+
+	t.Run("pass - tx_blob", func(t *testing.T) {
+		r := TxResponse{TxBlob: testPaymentBlob}
+
+		tx, err := r.TypedTx()
+		require.NoError(t, err)
+		payment, ok := tx.(*transaction.Payment)
+		require.True(t, ok)
+		require.Equal(t, types.Address("rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn"), payment.Account)
+		require.Equal(t, types.IssuedCurrencyAmount{
+			Currency: "USD",
+			Issuer:   "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+			Value:    "1",
+		}, payment.Amount)
+	})
+
+	t.Run("fail - empty response", func(t *testing.T) {
+		r := TxResponse{}
+
+		_, err := r.TypedTx()
+		require.ErrorIs(t, err, transaction.ErrInvalidTransactionType)
+	})
+}
@@ -6,10 +6,14 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
 	"github.com/Peersyst/xrpl-go/xrpl/common"
 )
 
-var ErrEmptyURL = errors.New("empty port and IP provided")
+var (
+	ErrEmptyURL    = errors.New("empty port and IP provided")
+	ErrNoEndpoints = errors.New("no endpoints provided")
+)
 
 type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
@@ -31,7 +35,15 @@ type Config struct {
 	// Faucet config
 	faucetProvider common.FaucetProvider
 
+	// Binary codec config
+	definitions *definitions.Definitions
+
 	timeout time.Duration
+
+	// Endpoint pool config
+	endpoints           []Endpoint
+	maxLedgerLag        uint32
+	healthCheckInterval time.Duration
 }
 
 type ConfigOpt func(c *Config)
@@ -60,6 +72,14 @@ func WithFaucetProvider(fp common.FaucetProvider) ConfigOpt {
 	}
 }
 
+// WithDefinitions sets the binary codec definitions the client encodes and decodes
+// transactions with, instead of the embedded ones.
+func WithDefinitions(d *definitions.Definitions) ConfigOpt {
+	return func(c *Config) {
+		c.definitions = d
+	}
+}
+
 func WithTimeout(timeout time.Duration) ConfigOpt {
 	return func(c *Config) {
 		c.timeout = timeout
@@ -69,6 +89,54 @@ func WithTimeout(timeout time.Duration) ConfigOpt {
 	}
 }
 
+// WithMaxLedgerLag sets how many ledgers a pool endpoint can fall behind the most
+// advanced one before it is considered unhealthy.
+func WithMaxLedgerLag(maxLedgerLag uint32) ConfigOpt {
+	return func(c *Config) {
+		c.maxLedgerLag = maxLedgerLag
+	}
+}
+
+// WithHealthCheckInterval sets how often the health of the pool endpoints is checked.
+func WithHealthCheckInterval(interval time.Duration) ConfigOpt {
+	return func(c *Config) {
+		c.healthCheckInterval = interval
+	}
+}
+
+// NewPoolConfig creates a config for a client that balances requests over several
+// rippled and Clio endpoints, failing over to another one when an endpoint errors
+// or falls behind.
+func NewPoolConfig(endpoints []Endpoint, opts ...ConfigOpt) (*Config, error) {
+	if len(endpoints) == 0 {
+		return nil, ErrNoEndpoints
+	}
+
+	normalized := make([]Endpoint, len(endpoints))
+	for i, e := range endpoints {
+		if len(e.URL) == 0 {
+			return nil, ErrEmptyURL
+		}
+		if !strings.HasSuffix(e.URL, "/") {
+			e.URL += "/"
+		}
+		normalized[i] = e
+	}
+
+	opts = append([]ConfigOpt{
+		WithMaxLedgerLag(common.DefaultMaxLedgerLag),
+		WithHealthCheckInterval(common.DefaultHealthCheckInterval),
+	}, opts...)
+
+	cfg, err := NewClientConfig(normalized[0].URL, opts...)
+	if err != nil {
+		return nil, err
+	}
+	cfg.endpoints = normalized
+
+	return cfg, nil
+}
+
 func NewClientConfig(url string, opts ...ConfigOpt) (*Config, error) {
 
 	// validate a url has been passed in
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
 	"github.com/Peersyst/xrpl-go/xrpl/common"
 	"github.com/Peersyst/xrpl-go/xrpl/faucet"
 	"github.com/stretchr/testify/assert"
@@ -85,3 +86,11 @@ func TestWithTimeout(t *testing.T) {
 
 	require.Equal(t, timeOut, cfg.timeout)
 }
+
+func TestWithDefinitions(t *testing.T) {
+	d := definitions.Get()
+	cfg, _ := NewClientConfig("http://s1.ripple.com:51234", WithDefinitions(d))
+
+	require.Equal(t, d, cfg.definitions)
+	require.Equal(t, d, NewClient(cfg).Definitions())
+}
@@ -1,31 +1,33 @@
 package rpc
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/Peersyst/xrpl-go/xrpl/client"
+)
 
 // Static errors
 var (
-	ErrIncorrectID                            = errors.New("incorrect id")
-	ErrMissingTxSignatureOrSigningPubKey      = errors.New("transaction must have a TxSignature or SigningPubKey set")
-	ErrSignerDataIsEmpty                      = errors.New("signer data is empty")
-	ErrCannotFundWalletWithoutClassicAddress  = errors.New("cannot fund wallet without classic address")
-	ErrMissingLastLedgerSequenceInTransaction = errors.New("missing LastLedgerSequence in transaction")
-	ErrMissingWallet                          = errors.New("wallet must be provided when submitting an unsigned transaction")
-
-	ErrRawTransactionsFieldIsNotAnArray = errors.New("RawTransactions field is not an array")
-	ErrRawTransactionFieldIsNotAnObject = errors.New("RawTransaction field is not an object")
-
-	ErrSigningPubKeyFieldMustBeEmpty = errors.New("SigningPubKey field must be empty")
-	ErrTxnSignatureFieldMustBeEmpty  = errors.New("TxnSignature field must be empty")
-	ErrSignersFieldMustBeEmpty       = errors.New("Signers field must be empty")
-	ErrAccountFieldIsNotAString      = errors.New("Account field is not a string")
+	ErrIncorrectID = errors.New("incorrect id")
+
+	// Errors returned by the autofill and submission methods of client.Core.
+	ErrMissingTxSignatureOrSigningPubKey      = client.ErrMissingTxSignatureOrSigningPubKey
+	ErrSignerDataIsEmpty                      = client.ErrSignerDataIsEmpty
+	ErrCannotFundWalletWithoutClassicAddress  = client.ErrCannotFundWalletWithoutClassicAddress
+	ErrMissingLastLedgerSequenceInTransaction = client.ErrMissingLastLedgerSequenceInTransaction
+	ErrMissingWallet                          = client.ErrMissingWallet
+
+	ErrRawTransactionsFieldIsNotAnArray = client.ErrRawTransactionsFieldIsNotAnArray
+	ErrRawTransactionFieldIsNotAnObject = client.ErrRawTransactionFieldIsNotAnObject
+
+	ErrSigningPubKeyFieldMustBeEmpty = client.ErrSigningPubKeyFieldMustBeEmpty
+	ErrTxnSignatureFieldMustBeEmpty  = client.ErrTxnSignatureFieldMustBeEmpty
+	ErrSignersFieldMustBeEmpty       = client.ErrSignersFieldMustBeEmpty
+	ErrAccountFieldIsNotAString      = client.ErrAccountFieldIsNotAString
 )
 
 // Dynamic errors
 
-type ClientError struct {
-	ErrorString string
-}
-
-func (e *ClientError) Error() string {
-	return e.ErrorString
-}
+// ClientError is an error reported by the client. It is an alias of client.ClientError,
+// so errors returned by the embedded client.Core match it with errors.As.
+type ClientError = client.ClientError
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/Peersyst/xrpl-go/xrpl/client"
 	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	utility "github.com/Peersyst/xrpl-go/xrpl/queries/utility"
@@ -108,6 +109,21 @@ func TestCheckForError(t *testing.T) {
 		assert.Equal(t, expError, err)
 	})
 
+	t.Run("Rate limited response", func(t *testing.T) {
+
+		json := `{"result": {"error": "slowDown", "status": "error"}}`
+
+		b := io.NopCloser(bytes.NewReader([]byte(json)))
+		res := &http.Response{
+			StatusCode: 200,
+			Body:       b,
+		}
+
+		_, err := checkForError(res)
+		assert.EqualError(t, err, "slowDown")
+		assert.ErrorIs(t, err, client.ErrSlowDown)
+	})
+
 	t.Run("Error Response with error code", func(t *testing.T) {
 
 		json := "Null Method" // https://xrpl.org/error-formatting.html#universal-errors
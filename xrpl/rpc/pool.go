@@ -0,0 +1,145 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Peersyst/xrpl-go/xrpl/client"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/utility"
+)
+
+var (
+	ErrNoClioEndpoint        = client.ErrNoClioEndpoint
+	ErrAllEndpointsFailed    = client.ErrAllEndpointsFailed
+	ErrEndpointLagging       = client.ErrEndpointLagging
+	ErrEndpointNotSynced     = client.ErrEndpointNotSynced
+	ErrEndpointStatusInvalid = errors.New("endpoint returned an unexpected status")
+)
+
+// EndpointKind is the server software behind a pool endpoint.
+type EndpointKind = client.EndpointKind
+
+const (
+	RippledEndpoint = client.RippledEndpoint
+	ClioEndpoint    = client.ClioEndpoint
+)
+
+// Endpoint is a JSON-RPC server the client can send requests to.
+type Endpoint = client.Endpoint
+
+// EndpointStatus is the last known health of a pool endpoint.
+type EndpointStatus = client.EndpointStatus
+
+// failoverErrors are the XRPL errors returned by a server that is unable to serve
+// any request at the moment, so the request is retried on another endpoint.
+var failoverErrors = map[string]struct{}{
+	"tooBusy":          {},
+	"noNetwork":        {},
+	"noCurrent":        {},
+	"noClosed":         {},
+	"amendmentBlocked": {},
+	"notSynced":        {},
+}
+
+// EndpointStatuses returns the last known health of every endpoint of a pool client.
+// It returns nil if the client was not created with NewPoolConfig.
+func (c *Client) EndpointStatuses() []EndpointStatus {
+	if c.pool == nil {
+		return nil
+	}
+	return c.pool.Statuses()
+}
+
+// CheckEndpoints checks the health of every endpoint of a pool client right away,
+// instead of waiting for the health check interval, and returns their statuses.
+// It returns nil if the client was not created with NewPoolConfig.
+func (c *Client) CheckEndpoints() []EndpointStatus {
+	return c.CheckEndpointsWithContext(context.Background())
+}
+
+// CheckEndpointsWithContext is like CheckEndpoints but uses ctx for cancellation and deadlines.
+func (c *Client) CheckEndpointsWithContext(ctx context.Context) []EndpointStatus {
+	if c.pool == nil {
+		return nil
+	}
+	return c.pool.Check(ctx, c.checkEndpoint)
+}
+
+// checkEndpoint pings the endpoint and returns its latest validated ledger from server_info.
+func (c *Client) checkEndpoint(ctx context.Context, e Endpoint) (common.LedgerIndex, error) {
+	if _, err := c.requestEndpoint(ctx, e.URL, &utility.PingRequest{}); err != nil {
+		return 0, err
+	}
+	res, err := c.requestEndpoint(ctx, e.URL, &server.InfoRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return client.ValidatedLedgerIndex(e.Kind, res)
+}
+
+// requestEndpoint sends a request to a single endpoint, without failing over.
+func (c *Client) requestEndpoint(ctx context.Context, url string, reqParams XRPLRequest) (*Response, error) {
+	body, err := createRequest(reqParams)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.doRequest(ctx, url, body)
+	if err != nil {
+		return nil, err
+	}
+	jr, err := checkForError(response)
+	if err != nil {
+		return nil, err
+	}
+	return &jr, nil
+}
+
+// poolRequest sends body to the pool endpoints, moving on to the next one when an
+// endpoint can't be reached, returns a server error or reports it can't serve requests.
+func (c *Client) poolRequest(ctx context.Context, method string, body []byte) (XRPLResponse, error) {
+	// The request that triggers a health check uses the current statuses.
+	c.pool.CheckInBackground(c.checkEndpoint)
+
+	endpoints, err := c.pool.Candidates(method)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+	for _, e := range endpoints {
+		response, err := c.doRequest(ctx, e.URL, body)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			c.pool.MarkUnhealthy(e.URL, err)
+			lastErr = err
+			continue
+		}
+		if response.StatusCode >= http.StatusInternalServerError || response.StatusCode == http.StatusTooManyRequests {
+			err = fmt.Errorf("%w: %s", ErrEndpointStatusInvalid, response.Status)
+			c.pool.MarkUnhealthy(e.URL, err)
+			lastErr = err
+			continue
+		}
+
+		jr, err := checkForError(response)
+		if err != nil {
+			var clientErr *ClientError
+			if errors.As(err, &clientErr) {
+				if _, ok := failoverErrors[clientErr.ErrorString]; ok {
+					c.pool.MarkUnhealthy(e.URL, err)
+					lastErr = err
+					continue
+				}
+			}
+			return nil, err
+		}
+		return &jr, nil
+	}
+	return nil, fmt.Errorf("%w: %w", ErrAllEndpointsFailed, lastErr)
+}
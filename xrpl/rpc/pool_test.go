@@ -0,0 +1,312 @@
+package rpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/clio"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/utility"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNode is a JSON-RPC server answering ping and server_info, recording the
+// methods it receives.
+type fakeNode struct {
+	mu          sync.Mutex
+	calls       []string
+	serverState string
+	ledgerIndex uint32
+	// Result returned for methods other than ping and server_info.
+	result map[string]any
+	status int
+	// When set, server_info waits for it to be closed before answering.
+	serverInfoGate chan struct{}
+}
+
+func newFakeNode(t *testing.T, ledgerIndex uint32) (*fakeNode, *httptest.Server) {
+	n := &fakeNode{serverState: "full", ledgerIndex: ledgerIndex, result: map[string]any{"status": "success"}, status: http.StatusOK}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req Request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		n.mu.Lock()
+		gate := n.serverInfoGate
+		n.mu.Unlock()
+		if req.Method == "server_info" && gate != nil {
+			<-gate
+		}
+
+		n.mu.Lock()
+		n.calls = append(n.calls, req.Method)
+		result, status := n.result, n.status
+		if req.Method == "server_info" {
+			result = map[string]any{"info": map[string]any{
+				"server_state":     n.serverState,
+				"validated_ledger": map[string]any{"seq": n.ledgerIndex},
+			}}
+			status = http.StatusOK
+		}
+		if req.Method == "ping" {
+			result = map[string]any{}
+			status = http.StatusOK
+		}
+		n.mu.Unlock()
+
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": result})
+	}))
+	t.Cleanup(s.Close)
+	return n, s
+}
+
+func (n *fakeNode) count(method string) int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	count := 0
+	for _, m := range n.calls {
+		if m == method {
+			count++
+		}
+	}
+	return count
+}
+
+func newPoolClient(t *testing.T, endpoints ...Endpoint) *Client {
+	cfg, err := NewPoolConfig(endpoints, WithHealthCheckInterval(0))
+	require.NoError(t, err)
+	return NewClient(cfg)
+}
+
+func TestNewPoolConfig(t *testing.T) {
+	t.Run("no endpoints", func(t *testing.T) {
+		cfg, err := NewPoolConfig(nil)
+		require.Nil(t, cfg)
+		require.ErrorIs(t, err, ErrNoEndpoints)
+	})
+
+	t.Run("empty url", func(t *testing.T) {
+		cfg, err := NewPoolConfig([]Endpoint{{URL: "http://s1.ripple.com:51234"}, {URL: ""}})
+		require.Nil(t, cfg)
+		require.ErrorIs(t, err, ErrEmptyURL)
+	})
+
+	t.Run("valid endpoints", func(t *testing.T) {
+		cfg, err := NewPoolConfig([]Endpoint{
+			{URL: "http://s1.ripple.com:51234"},
+			{URL: "https://clio.example.com/", Kind: ClioEndpoint},
+		}, WithMaxLedgerLag(10))
+		require.NoError(t, err)
+		require.Equal(t, "http://s1.ripple.com:51234/", cfg.URL)
+		require.Equal(t, []Endpoint{
+			{URL: "http://s1.ripple.com:51234/"},
+			{URL: "https://clio.example.com/", Kind: ClioEndpoint},
+		}, cfg.endpoints)
+		require.Equal(t, uint32(10), cfg.maxLedgerLag)
+	})
+}
+
+func TestClient_PoolBalancesRequests(t *testing.T) {
+	n1, s1 := newFakeNode(t, 100)
+	n2, s2 := newFakeNode(t, 100)
+	c := newPoolClient(t, Endpoint{URL: s1.URL}, Endpoint{URL: s2.URL})
+
+	for i := 0; i < 4; i++ {
+		_, err := c.Ping(&utility.PingRequest{})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, n1.count("ping"))
+	require.Equal(t, 2, n2.count("ping"))
+}
+
+func TestClient_PoolFailsOver(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		result map[string]any
+	}{
+		{
+			name:   "server error",
+			status: http.StatusInternalServerError,
+			result: map[string]any{},
+		},
+		{
+			name:   "server too busy",
+			status: http.StatusOK,
+			result: map[string]any{"error": "tooBusy", "status": "error"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failing, s1 := newFakeNode(t, 100)
+			failing.status = tt.status
+			failing.result = tt.result
+			healthy, s2 := newFakeNode(t, 100)
+			c := newPoolClient(t, Endpoint{URL: s1.URL}, Endpoint{URL: s2.URL})
+
+			for i := 0; i < 3; i++ {
+				_, err := c.GetRandom(&utility.RandomRequest{})
+				require.NoError(t, err)
+			}
+			// The failing endpoint is tried once, then skipped until the next health check.
+			require.Equal(t, 1, failing.count("random"))
+			require.Equal(t, 3, healthy.count("random"))
+
+			statuses := c.EndpointStatuses()
+			require.False(t, statuses[0].Healthy)
+			require.Error(t, statuses[0].Err)
+			require.True(t, statuses[1].Healthy)
+		})
+	}
+
+	t.Run("all endpoints failing", func(t *testing.T) {
+		n, s := newFakeNode(t, 100)
+		n.status = http.StatusServiceUnavailable
+		c := newPoolClient(t, Endpoint{URL: s.URL})
+
+		_, err := c.GetRandom(&utility.RandomRequest{})
+		require.ErrorIs(t, err, ErrAllEndpointsFailed)
+		require.ErrorIs(t, err, ErrEndpointStatusInvalid)
+	})
+
+	t.Run("request errors are not retried", func(t *testing.T) {
+		n1, s1 := newFakeNode(t, 100)
+		n1.result = map[string]any{"error": "invalidParams", "status": "error"}
+		n2, s2 := newFakeNode(t, 100)
+		n2.result = map[string]any{"error": "invalidParams", "status": "error"}
+		c := newPoolClient(t, Endpoint{URL: s1.URL}, Endpoint{URL: s2.URL})
+
+		_, err := c.GetRandom(&utility.RandomRequest{})
+		require.EqualError(t, err, "invalidParams")
+		require.Equal(t, 1, n1.count("random")+n2.count("random"))
+	})
+}
+
+func TestClient_PoolRoutesClioOnlyMethods(t *testing.T) {
+	rippled, s1 := newFakeNode(t, 100)
+	clioNode, s2 := newFakeNode(t, 100)
+	clioNode.result = map[string]any{"nft_id": "000800006203F49C21D5D6E022CB16DE3538F248662FC73C00000002"}
+	c := newPoolClient(t, Endpoint{URL: s1.URL}, Endpoint{URL: s2.URL, Kind: ClioEndpoint})
+
+	for i := 0; i < 2; i++ {
+		res, err := c.GetNFTInfo(&clio.NFTInfoRequest{NFTokenID: "000800006203F49C21D5D6E022CB16DE3538F248662FC73C00000002"})
+		require.NoError(t, err)
+		require.Equal(t, "000800006203F49C21D5D6E022CB16DE3538F248662FC73C00000002", string(res.NFTokenID))
+	}
+	require.Equal(t, 0, rippled.count("nft_info"))
+	require.Equal(t, 2, clioNode.count("nft_info"))
+
+	t.Run("no Clio endpoint", func(t *testing.T) {
+		c := newPoolClient(t, Endpoint{URL: s1.URL})
+		_, err := c.GetNFTInfo(&clio.NFTInfoRequest{NFTokenID: "000800006203F49C21D5D6E022CB16DE3538F248662FC73C00000002"})
+		require.ErrorIs(t, err, ErrNoClioEndpoint)
+	})
+}
+
+func TestClient_CheckEndpoints(t *testing.T) {
+	healthy, s1 := newFakeNode(t, 100)
+	_, lagging := newFakeNode(t, 90)
+	syncing, s3 := newFakeNode(t, 100)
+	syncing.serverState = "syncing"
+	clioNode, s4 := newFakeNode(t, 101)
+	clioNode.serverState = ""
+	_, s5 := newFakeNode(t, 100)
+	s5.Close()
+
+	c := newPoolClient(t,
+		Endpoint{URL: s1.URL},
+		Endpoint{URL: lagging.URL},
+		Endpoint{URL: s3.URL},
+		Endpoint{URL: s4.URL, Kind: ClioEndpoint},
+		Endpoint{URL: s5.URL},
+	)
+
+	statuses := c.CheckEndpoints()
+	require.Len(t, statuses, 5)
+
+	require.True(t, statuses[0].Healthy)
+	require.Equal(t, common.LedgerIndex(100), statuses[0].LedgerIndex)
+
+	require.False(t, statuses[1].Healthy)
+	require.ErrorIs(t, statuses[1].Err, ErrEndpointLagging)
+
+	require.False(t, statuses[2].Healthy)
+	require.ErrorIs(t, statuses[2].Err, ErrEndpointNotSynced)
+
+	require.True(t, statuses[3].Healthy)
+	require.Equal(t, common.LedgerIndex(101), statuses[3].LedgerIndex)
+
+	require.False(t, statuses[4].Healthy)
+	require.Error(t, statuses[4].Err)
+
+	// Requests only go to the healthy endpoints.
+	for i := 0; i < 4; i++ {
+		_, err := c.GetRandom(&utility.RandomRequest{})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, healthy.count("random"))
+	require.Equal(t, 0, syncing.count("random"))
+	require.Equal(t, 2, clioNode.count("random"))
+}
+
+func TestClient_PoolChecksEndpointsInBackground(t *testing.T) {
+	n, s := newFakeNode(t, 100)
+	n.serverState = "syncing"
+	n.serverInfoGate = make(chan struct{})
+
+	cfg, err := NewPoolConfig([]Endpoint{{URL: s.URL}}, WithHealthCheckInterval(time.Hour))
+	require.NoError(t, err)
+	c := NewClient(cfg)
+
+	// The request doesn't wait for the health check it triggers.
+	_, err = c.GetRandom(&utility.RandomRequest{})
+	require.NoError(t, err)
+	require.True(t, c.EndpointStatuses()[0].Healthy)
+
+	close(n.serverInfoGate)
+	require.Eventually(t, func() bool {
+		return !c.EndpointStatuses()[0].Healthy
+	}, time.Second, 10*time.Millisecond)
+	require.ErrorIs(t, c.EndpointStatuses()[0].Err, ErrEndpointNotSynced)
+}
+
+func TestClient_CheckEndpointsKeepsFailuresDuringCheck(t *testing.T) {
+	n1, s1 := newFakeNode(t, 100)
+	n1.serverInfoGate = make(chan struct{})
+	n2, s2 := newFakeNode(t, 100)
+	c := newPoolClient(t, Endpoint{URL: s1.URL}, Endpoint{URL: s2.URL})
+
+	done := make(chan []EndpointStatus)
+	go func() {
+		done <- c.CheckEndpoints()
+	}()
+	require.Eventually(t, func() bool {
+		return n1.count("ping") == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// A request fails on the first endpoint while the check is waiting for it.
+	n1.mu.Lock()
+	n1.status = http.StatusInternalServerError
+	n1.mu.Unlock()
+	for i := 0; i < 2; i++ {
+		_, err := c.GetRandom(&utility.RandomRequest{})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1, n1.count("random"))
+	require.Equal(t, 2, n2.count("random"))
+
+	close(n1.serverInfoGate)
+	statuses := <-done
+	require.False(t, statuses[0].Healthy)
+	require.ErrorIs(t, statuses[0].Err, ErrEndpointStatusInvalid)
+	require.True(t, statuses[1].Healthy)
+}
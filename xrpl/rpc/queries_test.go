@@ -18,6 +18,7 @@ import (
 	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
 	server "github.com/Peersyst/xrpl-go/xrpl/queries/server"
 	servertypes "github.com/Peersyst/xrpl-go/xrpl/queries/server/types"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
 	utility "github.com/Peersyst/xrpl-go/xrpl/queries/utility"
 	"github.com/Peersyst/xrpl-go/xrpl/rpc/testutil"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
@@ -1322,6 +1323,90 @@ func TestClient_GetLedgerData(t *testing.T) {
 	}
 }
 
+func TestClient_GetLedgerEntry(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockResponse  string
+		request       *ledgerqueries.EntryRequest
+		expected      ledger.Object
+		expectedError string
+	}{
+		{
+			name: "successful response",
+			mockResponse: `{
+				"result": {
+					"index": "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+					"ledger_index": 61966165,
+					"node": {
+						"Account": "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+						"Balance": "424021949",
+						"Flags": 0,
+						"LedgerEntryType": "AccountRoot",
+						"OwnerCount": 4,
+						"Sequence": 44,
+						"index": "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8"
+					},
+					"validated": true
+				}
+			}`,
+			request: &ledgerqueries.EntryRequest{
+				AccountRoot: "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+			},
+			expected: &ledger.AccountRoot{
+				Index:           "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+				LedgerEntryType: ledger.AccountRootEntry,
+				Account:         "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+				Balance:         types.XRPCurrencyAmount(424021949),
+				OwnerCount:      4,
+				Sequence:        44,
+			},
+		},
+		{
+			name: "error response",
+			mockResponse: `{
+				"result": {
+					"error": "entryNotFound",
+					"status": "error"
+				}
+			}`,
+			request: &ledgerqueries.EntryRequest{
+				Index: "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+			},
+			expectedError: "entryNotFound",
+		},
+		{
+			name:          "invalid request",
+			request:       &ledgerqueries.EntryRequest{},
+			expectedError: ledgerqueries.ErrNoEntrySelected.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := testutil.JSONRPCMockClient{}
+			mc.DoFunc = testutil.MockResponse(tt.mockResponse, 200, &mc)
+
+			cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc))
+			require.NoError(t, err)
+
+			client := NewClient(cfg)
+
+			resp, err := client.GetLedgerEntry(tt.request)
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, common.LedgerIndex(61966165), resp.LedgerIndex)
+			require.True(t, resp.Validated)
+			require.Equal(t, tt.expected, resp.Object)
+		})
+	}
+}
+
 func TestClient_GetLedger(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -1768,6 +1853,140 @@ func TestClient_GetDepositAuthorized(t *testing.T) {
 	}
 }
 
+func TestClient_GetAMMInfo(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockResponse  string
+		request       *path.AMMInfoRequest
+		expected      *path.AMMInfoResponse
+		expectedError string
+	}{
+		{
+			name: "successful response",
+			mockResponse: `{
+				"result": {
+					"amm": {
+						"account": "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+						"amount": "296890496",
+						"amount2": {
+							"currency": "TST",
+							"issuer": "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd",
+							"value": "25.81656470648473"
+						},
+						"asset2_frozen": false,
+						"auction_slot": {
+							"account": "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm",
+							"auth_accounts": [
+								{
+									"account": "r3f2WpQMsAd8k4Zoijv2PZ78EYFJ2EdvgV"
+								}
+							],
+							"discounted_fee": 60,
+							"expiration": "2023-Jun-26 06:17:30.000000000 UTC",
+							"price": {
+								"currency": "039C99CD9AB0B70B32ECDA51EAAE471625608EA2",
+								"issuer": "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+								"value": "0"
+							},
+							"time_interval": 0
+						},
+						"lp_token": {
+							"currency": "039C99CD9AB0B70B32ECDA51EAAE471625608EA2",
+							"issuer": "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+							"value": "87533.41976112682"
+						},
+						"trading_fee": 600,
+						"vote_slots": [
+							{
+								"account": "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm",
+								"trading_fee": 600,
+								"vote_weight": 100000
+							}
+						]
+					},
+					"ledger_current_index": 316745,
+					"validated": false
+				}
+			}`,
+			request: &path.AMMInfoRequest{
+				Asset:  &ledger.Asset{Currency: "XRP"},
+				Asset2: &ledger.Asset{Currency: "TST", Issuer: "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd"},
+			},
+			expected: &path.AMMInfoResponse{
+				AMM: pathtypes.AMM{
+					Account: "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+					Amount:  types.XRPCurrencyAmount(296890496),
+					Amount2: types.IssuedCurrencyAmount{
+						Currency: "TST",
+						Issuer:   "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd",
+						Value:    "25.81656470648473",
+					},
+					AuctionSlot: &pathtypes.AMMAuctionSlot{
+						Account:       "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm",
+						AuthAccounts:  []pathtypes.AMMAuthAccount{{Account: "r3f2WpQMsAd8k4Zoijv2PZ78EYFJ2EdvgV"}},
+						DiscountedFee: 60,
+						Expiration:    "2023-Jun-26 06:17:30.000000000 UTC",
+						Price: types.IssuedCurrencyAmount{
+							Currency: "039C99CD9AB0B70B32ECDA51EAAE471625608EA2",
+							Issuer:   "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+							Value:    "0",
+						},
+					},
+					LPToken: types.IssuedCurrencyAmount{
+						Currency: "039C99CD9AB0B70B32ECDA51EAAE471625608EA2",
+						Issuer:   "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+						Value:    "87533.41976112682",
+					},
+					TradingFee: 600,
+					VoteSlots: []pathtypes.AMMVoteSlot{
+						{Account: "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm", TradingFee: 600, VoteWeight: 100000},
+					},
+				},
+				LedgerCurrentIndex: 316745,
+			},
+		},
+		{
+			name: "error response",
+			mockResponse: `{
+				"result": {
+					"error": "actNotFound",
+					"status": "error"
+				}
+			}`,
+			request:       &path.AMMInfoRequest{AMMAccount: "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM"},
+			expectedError: "actNotFound",
+		},
+		{
+			name:          "invalid request",
+			request:       &path.AMMInfoRequest{},
+			expectedError: path.ErrNoAMMSelected.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := testutil.JSONRPCMockClient{}
+			mc.DoFunc = testutil.MockResponse(tt.mockResponse, 200, &mc)
+
+			cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc))
+			require.NoError(t, err)
+
+			client := NewClient(cfg)
+
+			resp, err := client.GetAMMInfo(tt.request)
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, resp)
+		})
+	}
+}
+
 func TestClient_FindPathCreate(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -1825,19 +2044,19 @@ func TestClient_FindPathCreate(t *testing.T) {
 								},
 							},
 						},
-						SourceAmount: "207669",
-						DestinationAmount: map[string]any{
-							"currency": "USD",
-							"issuer":   "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
-							"value":    "100",
+						SourceAmount: types.XRPCurrencyAmount(207669),
+						DestinationAmount: types.IssuedCurrencyAmount{
+							Currency: "USD",
+							Issuer:   "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
+							Value:    "100",
 						},
 					},
 				},
 				DestinationAccount: "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59",
-				DestinationAmount: map[string]any{
-					"currency": "USD",
-					"issuer":   "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
-					"value":    "100",
+				DestinationAmount: types.IssuedCurrencyAmount{
+					Currency: "USD",
+					Issuer:   "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
+					Value:    "100",
 				},
 				SourceAccount: "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59",
 				FullReply:     true,
@@ -1937,10 +2156,10 @@ func TestClient_FindPathClose(t *testing.T) {
 								},
 							},
 						},
-						SourceAmount: map[string]any{
-							"currency": "USD",
-							"issuer":   "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
-							"value":    "100",
+						SourceAmount: types.IssuedCurrencyAmount{
+							Currency: "USD",
+							Issuer:   "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
+							Value:    "100",
 						},
 					},
 				},
@@ -2049,19 +2268,19 @@ func TestClient_FindPathStatus(t *testing.T) {
 								},
 							},
 						},
-						SourceAmount: "207669",
-						DestinationAmount: map[string]any{
-							"value":    "100",
-							"currency": "USD",
-							"issuer":   "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
+						SourceAmount: types.XRPCurrencyAmount(207669),
+						DestinationAmount: types.IssuedCurrencyAmount{
+							Currency: "USD",
+							Issuer:   "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
+							Value:    "100",
 						},
 					},
 				},
 				DestinationAccount: "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59",
-				DestinationAmount: map[string]any{
-					"value":    "100",
-					"currency": "USD",
-					"issuer":   "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
+				DestinationAmount: types.IssuedCurrencyAmount{
+					Currency: "USD",
+					Issuer:   "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B",
+					Value:    "100",
 				},
 				SourceAccount: "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59",
 				FullReply:     true,
@@ -2768,6 +2987,37 @@ func TestClient_GetServerState(t *testing.T) {
 	}
 }
 
+func TestClient_GetServerDefinitions(t *testing.T) {
+	mc := testutil.JSONRPCMockClient{}
+	mc.DoFunc = testutil.MockResponse(`{
+		"result": {
+			"hash": "3F3E5C5A0DF7E3A3E26C3B0A8D3D19D3C0D5E8A8F0C2B9E5A4B8D3C8E1F0A2B4",
+			"TYPES": {"UInt32": 2, "AccountID": 8},
+			"TRANSACTION_TYPES": {"Payment": 0, "CustomTx": 250},
+			"FIELDS": [
+				["Sequence", {"nth": 4, "isVLEncoded": false, "isSerialized": true, "isSigningField": true, "type": "UInt32"}],
+				["Account", {"nth": 1, "isVLEncoded": true, "isSerialized": true, "isSigningField": true, "type": "AccountID"}]
+			]
+		}
+	}`, 200, &mc)
+
+	cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc))
+	require.NoError(t, err)
+	client := NewClient(cfg)
+
+	res, err := client.GetServerDefinitions(&server.DefinitionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "3F3E5C5A0DF7E3A3E26C3B0A8D3D19D3C0D5E8A8F0C2B9E5A4B8D3C8E1F0A2B4", res.Hash)
+	require.Equal(t, int32(250), res.TransactionTypes["CustomTx"])
+
+	d, err := res.Definitions()
+	require.NoError(t, err)
+	require.Equal(t, int32(8<<16|1), d.Fields["Account"].Ordinal)
+
+	client.SetDefinitions(d)
+	require.Equal(t, d, client.Definitions())
+}
+
 func TestClient_GetAggregatePrice(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -2966,3 +3216,75 @@ func TestClient_GetRandom(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_GetTx(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockResponse  string
+		expected      *requests.TxResponse
+		expectedError string
+	}{
+		{
+			name: "successful response",
+			mockResponse: `{
+				"result": {
+					"hash": "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB74A9",
+					"ledger_index": 56865245,
+					"tx_json": {
+						"Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+						"Fee": "10",
+						"Sequence": 5,
+						"TransactionType": "EscrowCreate"
+					},
+					"validated": true
+				}
+			}`,
+			expected: &requests.TxResponse{
+				Hash:        "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB74A9",
+				LedgerIndex: 56865245,
+				Tx: transaction.FlatTransaction{
+					"Account":         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					"Fee":             "10",
+					"Sequence":        json.Number("5"),
+					"TransactionType": "EscrowCreate",
+				},
+				Validated: true,
+			},
+		},
+		{
+			name: "error response",
+			mockResponse: `{
+				"result": {
+					"error": "txnNotFound",
+					"status": "error"
+				}
+			}`,
+			expectedError: "txnNotFound",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := testutil.JSONRPCMockClient{}
+			mc.DoFunc = testutil.MockResponse(tt.mockResponse, 200, &mc)
+
+			cfg, err := NewClientConfig("http://testnode/", WithHTTPClient(&mc))
+			require.NoError(t, err)
+
+			client := NewClient(cfg)
+
+			resp, err := client.GetTx(&requests.TxRequest{
+				Transaction: "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB74A9",
+			})
+
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, resp)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"github.com/Peersyst/xrpl-go/xrpl/common"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
 	"github.com/mitchellh/mapstructure"
 )
@@ -28,7 +29,10 @@ type APIWarning struct {
 
 func (r Response) GetResult(v any) error {
 	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{TagName: "json",
-		Result: &v, DecodeHook: mapstructure.TextUnmarshallerHookFunc()})
+		Result: &v, DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			mapstructure.TextUnmarshallerHookFunc(),
+			common.CurrencyAmountHookFunc(),
+		)})
 
 	if err != nil {
 		return err
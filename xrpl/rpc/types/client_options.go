@@ -1,11 +1,7 @@
 package types
 
 import (
-	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/Peersyst/xrpl-go/xrpl/client"
 )
 
-type SubmitOptions struct {
-	Autofill bool
-	Wallet   *wallet.Wallet
-	FailHard bool
-}
+type SubmitOptions = client.SubmitOptions
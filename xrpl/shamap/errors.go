@@ -0,0 +1,13 @@
+package shamap
+
+import "errors"
+
+var (
+	ErrInvalidIndex       = errors.New("index must be a 64-character hex string")
+	ErrInvalidLeafType    = errors.New("invalid leaf type")
+	ErrDuplicateIndex     = errors.New("an item with the same index is already in the map")
+	ErrItemNotFound       = errors.New("item not found in the map")
+	ErrInvalidProof       = errors.New("proof does not lead to the root hash")
+	ErrInvalidTransaction = errors.New("transaction must have tx_blob and meta_blob or meta in binary form")
+	ErrInvalidStateEntry  = errors.New("state entry must have index and data in binary form")
+)
@@ -0,0 +1,64 @@
+package shamap
+
+import (
+	"github.com/Peersyst/xrpl-go/binary-codec/serdes"
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+)
+
+// NewTransactionMap builds the transaction tree of a ledger from the
+// transactions of a ledger response requested with expand and binary set.
+// Its hash is the transaction_hash of the ledger header.
+func NewTransactionMap(txs []interface{}) (*SHAMap, error) {
+	m := New()
+	for _, tx := range txs {
+		fields, ok := tx.(map[string]interface{})
+		if !ok {
+			return nil, ErrInvalidTransaction
+		}
+		txBlob, ok := fields["tx_blob"].(string)
+		if !ok {
+			return nil, ErrInvalidTransaction
+		}
+		// API v2 returns binary metadata as meta_blob, API v1 as meta.
+		meta, ok := fields["meta_blob"].(string)
+		if !ok {
+			if meta, ok = fields["meta"].(string); !ok {
+				return nil, ErrInvalidTransaction
+			}
+		}
+		if err := m.AddTransaction(txBlob, meta); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// NewAccountStateMap builds the state tree of a ledger from the accountState
+// of a ledger response requested with expand and binary set.
+// Its hash is the account_hash of the ledger header.
+func NewAccountStateMap(state []ledger.FlatLedgerObject) (*SHAMap, error) {
+	m := New()
+	for _, entry := range state {
+		index, ok := entry["index"].(string)
+		if !ok {
+			return nil, ErrInvalidStateEntry
+		}
+		data, ok := entry["data"].(string)
+		if !ok {
+			return nil, ErrInvalidStateEntry
+		}
+		if err := m.AddLedgerEntry(index, data); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// appendVariableLength appends b to dst, prefixed with its length.
+func appendVariableLength(dst, b []byte) ([]byte, error) {
+	vl, err := serdes.EncodeVariableLength(len(b))
+	if err != nil {
+		return nil, err
+	}
+	return append(append(dst, vl...), b...), nil
+}
@@ -0,0 +1,62 @@
+package shamap
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccountStateMap(t *testing.T) {
+	m, err := NewAccountStateMap([]ledger.FlatLedgerObject{
+		{"index": testIndexes[0], "data": "1100612200000000"},
+		{"index": testIndexes[1], "data": "1100642200000000"},
+	})
+	require.NoError(t, err)
+
+	expected := New()
+	require.NoError(t, expected.AddLedgerEntry(testIndexes[0], "1100612200000000"))
+	require.NoError(t, expected.AddLedgerEntry(testIndexes[1], "1100642200000000"))
+	require.Equal(t, expected.Hash(), m.Hash())
+
+	_, err = NewAccountStateMap([]ledger.FlatLedgerObject{{"index": testIndexes[0]}})
+	require.ErrorIs(t, err, ErrInvalidStateEntry)
+}
+
+func TestNewTransactionMap(t *testing.T) {
+	const (
+		txBlob = "120000228000000024000000016140000000000003E868400000000000000A81144B4E9C06F24296074F7BC48F92A97916C6DC5EA9"
+		meta   = "201C00000000F8E5110061E1E1F1031000"
+	)
+
+	expected := New()
+	require.NoError(t, expected.AddTransaction(txBlob, meta))
+
+	tests := []struct {
+		name string
+		tx   map[string]interface{}
+	}{
+		{
+			name: "api v2",
+			tx:   map[string]interface{}{"tx_blob": txBlob, "meta_blob": meta},
+		},
+		{
+			name: "api v1",
+			tx:   map[string]interface{}{"tx_blob": txBlob, "meta": meta},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewTransactionMap([]interface{}{tt.tx})
+			require.NoError(t, err)
+			require.Equal(t, expected.Hash(), m.Hash())
+		})
+	}
+
+	_, err := NewTransactionMap([]interface{}{map[string]interface{}{"tx_blob": txBlob}})
+	require.ErrorIs(t, err, ErrInvalidTransaction)
+
+	_, err = NewTransactionMap([]interface{}{"E3FE6EA3D48F0C2B639448020EA4F03D4F4F8FFDB243A852A0F59177921B4879"})
+	require.ErrorIs(t, err, ErrInvalidTransaction)
+}
@@ -0,0 +1,114 @@
+package shamap
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+)
+
+// Proof shows that a leaf is part of a SHAMap with a given root hash. It holds
+// the leaf and the child hashes of every inner node from the root down to it.
+type Proof struct {
+	// The index of the leaf, as a hex string.
+	Index string `json:"index"`
+	// The type of the leaf, which selects its hash prefix.
+	LeafType LeafType `json:"leaf_type"`
+	// The data of the leaf, as a hex string.
+	Data string `json:"data"`
+	// The child hashes of each inner node on the path, starting at the root.
+	// Empty branches are all zeros.
+	Path [][branchFactor]string `json:"path"`
+}
+
+// Proof returns the inclusion proof of the leaf with the given index.
+// It returns ErrItemNotFound if the map has no such leaf.
+func (m *SHAMap) Proof(index string) (*Proof, error) {
+	idx, err := decodeIndex(index)
+	if err != nil {
+		return nil, err
+	}
+
+	var path [][branchFactor]string
+	n := m.root
+	for {
+		var level [branchFactor]string
+		for i, h := range n.childHashes() {
+			if h == nil {
+				h = make([]byte, hashSize)
+			}
+			level[i] = encodeHash(h)
+		}
+		path = append(path, level)
+
+		switch child := n.children[nibble(idx, n.depth)].(type) {
+		case *innerNode:
+			n = child
+		case *leafNode:
+			if !bytes.Equal(child.index, idx) {
+				return nil, ErrItemNotFound
+			}
+			return &Proof{
+				Index:    encodeHash(child.index),
+				LeafType: child.leafType,
+				Data:     encodeHash(child.data),
+				Path:     path,
+			}, nil
+		default:
+			return nil, ErrItemNotFound
+		}
+	}
+}
+
+// VerifyProof checks that proof leads from its leaf to rootHash. It returns
+// ErrInvalidProof if any hash on the path does not match.
+func VerifyProof(rootHash string, proof *Proof) error {
+	idx, err := decodeIndex(proof.Index)
+	if err != nil {
+		return err
+	}
+	data, err := hex.DecodeString(proof.Data)
+	if err != nil {
+		return err
+	}
+	prefix, err := proof.LeafType.prefix()
+	if err != nil {
+		return err
+	}
+	if len(proof.Path) == 0 || len(proof.Path) > 2*hashSize {
+		return ErrInvalidProof
+	}
+
+	h := leafHash(prefix, data, idx)
+	for depth := len(proof.Path) - 1; depth >= 0; depth-- {
+		level := proof.Path[depth]
+		if !strings.EqualFold(level[nibble(idx, depth)], encodeHash(h)) {
+			return ErrInvalidProof
+		}
+
+		var children [branchFactor][]byte
+		for i, c := range level {
+			b, err := hex.DecodeString(c)
+			if err != nil || len(b) != hashSize {
+				return ErrInvalidProof
+			}
+			if !isZero(b) {
+				children[i] = b
+			}
+		}
+		h = innerHash(children)
+	}
+
+	if !strings.EqualFold(rootHash, encodeHash(h)) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+func isZero(b []byte) bool {
+	for _, v := range b {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,91 @@
+package shamap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMap(t *testing.T) *SHAMap {
+	t.Helper()
+	m := New()
+	for i, index := range testIndexes {
+		require.NoError(t, m.AddItem(index, []byte{byte(i), 0xFF}, AccountState))
+	}
+	return m
+}
+
+func TestSHAMap_Proof(t *testing.T) {
+	m := newTestMap(t)
+	root := m.Hash()
+
+	for _, index := range testIndexes {
+		t.Run(index, func(t *testing.T) {
+			proof, err := m.Proof(index)
+			require.NoError(t, err)
+			require.Equal(t, index, proof.Index)
+			require.Equal(t, AccountState, proof.LeafType)
+			require.NoError(t, VerifyProof(root, proof))
+		})
+	}
+}
+
+func TestSHAMap_ProofNotFound(t *testing.T) {
+	m := newTestMap(t)
+
+	// Shares its first nibbles with other leaves but is not in the map.
+	_, err := m.Proof("B92891FE4EF6CEE585FDC6FDA1E09EB4D386363158EC3321B8123E5A772C6CA9")
+	require.ErrorIs(t, err, ErrItemNotFound)
+
+	// Falls on an empty branch of the root.
+	_, err = m.Proof("0000000000000000000000000000000000000000000000000000000000000000")
+	require.ErrorIs(t, err, ErrItemNotFound)
+}
+
+func TestVerifyProof_Invalid(t *testing.T) {
+	m := newTestMap(t)
+	root := m.Hash()
+
+	tests := []struct {
+		name   string
+		root   string
+		tamper func(p *Proof)
+	}{
+		{
+			name:   "tampered data",
+			root:   root,
+			tamper: func(p *Proof) { p.Data = "DEADBEEF" },
+		},
+		{
+			name:   "wrong leaf type",
+			root:   root,
+			tamper: func(p *Proof) { p.LeafType = TransactionWithMetadata },
+		},
+		{
+			name: "tampered sibling",
+			root: root,
+			tamper: func(p *Proof) {
+				p.Path[0][0] = "1111111111111111111111111111111111111111111111111111111111111111"
+			},
+		},
+		{
+			name:   "truncated path",
+			root:   root,
+			tamper: func(p *Proof) { p.Path = p.Path[1:] },
+		},
+		{
+			name:   "other root",
+			root:   New().Hash(),
+			tamper: func(*Proof) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proof, err := m.Proof(testIndexes[0])
+			require.NoError(t, err)
+			tt.tamper(proof)
+			require.ErrorIs(t, VerifyProof(tt.root, proof), ErrInvalidProof)
+		})
+	}
+}
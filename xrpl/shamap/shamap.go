@@ -0,0 +1,224 @@
+package shamap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+)
+
+const (
+	// The number of children of an inner node, one per nibble value.
+	branchFactor = 16
+	// The size in bytes of a leaf index and of a node hash.
+	hashSize = 32
+)
+
+// LeafType selects the hash prefix of a leaf.
+type LeafType uint8
+
+const (
+	// TransactionWithMetadata leaves hold a transaction followed by its metadata,
+	// each prefixed with its length. They make up the transaction tree of a ledger.
+	TransactionWithMetadata LeafType = iota + 1
+	// AccountState leaves hold a serialized ledger entry. They make up the state
+	// tree of a ledger.
+	AccountState
+)
+
+func (t LeafType) prefix() (uint32, error) {
+	switch t {
+	case TransactionWithMetadata:
+		return hash.TransactionNodePrefix, nil
+	case AccountState:
+		return hash.LeafNodePrefix, nil
+	default:
+		return 0, ErrInvalidLeafType
+	}
+}
+
+// SHAMap is the radix-16 Merkle tree the XRP Ledger uses to hash the
+// transactions and the state of a ledger. Leaves are placed by the nibbles of
+// their 256-bit index, so the root hash does not depend on insertion order.
+type SHAMap struct {
+	root *innerNode
+}
+
+// New returns an empty SHAMap. Its hash is all zeros.
+func New() *SHAMap {
+	return &SHAMap{root: &innerNode{}}
+}
+
+// AddItem adds a leaf with the given hex index and data to the map.
+// It returns an error if the index is invalid or already in the map.
+func (m *SHAMap) AddItem(index string, data []byte, leafType LeafType) error {
+	idx, err := decodeIndex(index)
+	if err != nil {
+		return err
+	}
+	if _, err := leafType.prefix(); err != nil {
+		return err
+	}
+	return m.root.add(&leafNode{index: idx, data: data, leafType: leafType})
+}
+
+// AddTransaction adds a transaction and its metadata, both hex encoded, to the
+// map. The leaf is indexed by the transaction hash.
+func (m *SHAMap) AddTransaction(txBlob, meta string) error {
+	tx, err := hex.DecodeString(txBlob)
+	if err != nil {
+		return err
+	}
+	metadata, err := hex.DecodeString(meta)
+	if err != nil {
+		return err
+	}
+
+	data, err := appendVariableLength(nil, tx)
+	if err != nil {
+		return err
+	}
+	data, err = appendVariableLength(data, metadata)
+	if err != nil {
+		return err
+	}
+
+	txID := hashWithPrefix(hash.TransactionPrefix, tx)
+	return m.AddItem(hex.EncodeToString(txID), data, TransactionWithMetadata)
+}
+
+// AddLedgerEntry adds a hex encoded ledger entry with the given index to the map.
+func (m *SHAMap) AddLedgerEntry(index, data string) error {
+	entry, err := hex.DecodeString(data)
+	if err != nil {
+		return err
+	}
+	return m.AddItem(index, entry, AccountState)
+}
+
+// Hash returns the root hash of the map as an uppercase hex string.
+func (m *SHAMap) Hash() string {
+	return encodeHash(m.root.hash())
+}
+
+// ############################################################################
+// Nodes
+// ############################################################################
+
+type node interface {
+	hash() []byte
+}
+
+type leafNode struct {
+	index    []byte
+	data     []byte
+	leafType LeafType
+}
+
+func (l *leafNode) hash() []byte {
+	prefix, _ := l.leafType.prefix()
+	return leafHash(prefix, l.data, l.index)
+}
+
+type innerNode struct {
+	depth    int
+	children [branchFactor]node
+}
+
+func (n *innerNode) add(leaf *leafNode) error {
+	branch := nibble(leaf.index, n.depth)
+	switch child := n.children[branch].(type) {
+	case nil:
+		n.children[branch] = leaf
+	case *innerNode:
+		return child.add(leaf)
+	case *leafNode:
+		if bytes.Equal(child.index, leaf.index) {
+			return ErrDuplicateIndex
+		}
+		// Push both leaves one level down. They end up in the first inner node
+		// where their indexes differ.
+		inner := &innerNode{depth: n.depth + 1}
+		if err := inner.add(child); err != nil {
+			return err
+		}
+		if err := inner.add(leaf); err != nil {
+			return err
+		}
+		n.children[branch] = inner
+	}
+	return nil
+}
+
+func (n *innerNode) childHashes() [branchFactor][]byte {
+	var hashes [branchFactor][]byte
+	for i, child := range n.children {
+		if child != nil {
+			hashes[i] = child.hash()
+		}
+	}
+	return hashes
+}
+
+func (n *innerNode) hash() []byte {
+	return innerHash(n.childHashes())
+}
+
+// ############################################################################
+// Hashing
+// ############################################################################
+
+// innerHash hashes the children of an inner node. Empty branches are nil and
+// hash as zeros. An inner node without children hashes to zero.
+func innerHash(children [branchFactor][]byte) []byte {
+	payload := binary.BigEndian.AppendUint32(nil, hash.InnerNodePrefix)
+	empty := true
+	for _, h := range children {
+		if h == nil {
+			payload = append(payload, make([]byte, hashSize)...)
+			continue
+		}
+		empty = false
+		payload = append(payload, h...)
+	}
+	if empty {
+		return make([]byte, hashSize)
+	}
+	return crypto.Sha512Half(payload)
+}
+
+func leafHash(prefix uint32, data, index []byte) []byte {
+	payload := binary.BigEndian.AppendUint32(nil, prefix)
+	payload = append(payload, data...)
+	payload = append(payload, index...)
+	return crypto.Sha512Half(payload)
+}
+
+func hashWithPrefix(prefix uint32, data []byte) []byte {
+	payload := binary.BigEndian.AppendUint32(nil, prefix)
+	return crypto.Sha512Half(append(payload, data...))
+}
+
+// nibble returns the 4 bits of index that select the branch at depth.
+func nibble(index []byte, depth int) int {
+	b := index[depth/2]
+	if depth%2 == 0 {
+		return int(b >> 4)
+	}
+	return int(b & 0x0F)
+}
+
+func decodeIndex(index string) ([]byte, error) {
+	idx, err := hex.DecodeString(index)
+	if err != nil || len(idx) != hashSize {
+		return nil, ErrInvalidIndex
+	}
+	return idx, nil
+}
+
+func encodeHash(h []byte) string {
+	return strings.ToUpper(hex.EncodeToString(h))
+}
@@ -0,0 +1,154 @@
+package shamap
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+	"github.com/stretchr/testify/require"
+)
+
+var testIndexes = []string{
+	"B92891FE4EF6CEE585FDC6FDA1E09EB4D386363158EC3321B8123E5A772C6CA8",
+	"B92881FE4EF6CEE585FDC6FDA0E09EB4D386363158EC3321B8123E5A772C6CA8",
+	"B92691FE4EF6CEE585FDC6FDA1E09EB4D386363158EC3321B8123E5A772C6CA8",
+	"B92791FE4EF6CEE585FDC6FDA1E09EB4D386363158EC3321B8123E5A772C6CA8",
+	"B91891FE4EF6CEE585FDC6FDA1E09EB4D386363158EC3321B8123E5A772C6CA8",
+	"B99891FE4EF6CEE585FDC6FDA1E09EB4D386363158EC3321B8123E5A772C6CA8",
+	"F22891FE4EF6CEE585FDC6FDA1E09EB4D386363158EC3321B8123E5A772C6CA8",
+	"292891FE4EF6CEE585FDC6FDA1E09EB4D386363158EC3321B8123E5A772C6CA8",
+}
+
+func sha512HalfWithPrefix(prefix uint32, parts ...[]byte) []byte {
+	payload := binary.BigEndian.AppendUint32(nil, prefix)
+	for _, p := range parts {
+		payload = append(payload, p...)
+	}
+	return crypto.Sha512Half(payload)
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestSHAMap_Empty(t *testing.T) {
+	require.Equal(t, strings.Repeat("0", 64), New().Hash())
+}
+
+func TestSHAMap_SingleLeaf(t *testing.T) {
+	index := testIndexes[0]
+	data := []byte{0xDE, 0xAD, 0xBE, 0xEF}
+
+	m := New()
+	require.NoError(t, m.AddItem(index, data, AccountState))
+
+	leaf := sha512HalfWithPrefix(hash.LeafNodePrefix, data, decodeHex(t, index))
+	children := make([]byte, 16*32)
+	// The index starts with B, so the leaf hangs from branch 11 of the root.
+	copy(children[11*32:], leaf)
+	expected := sha512HalfWithPrefix(hash.InnerNodePrefix, children)
+
+	require.Equal(t, strings.ToUpper(hex.EncodeToString(expected)), m.Hash())
+}
+
+func TestSHAMap_SharedPrefix(t *testing.T) {
+	// Both indexes start with B92, so they split in an inner node at depth 3.
+	a, b := testIndexes[2], testIndexes[3]
+	data := []byte{0x01}
+
+	m := New()
+	require.NoError(t, m.AddItem(a, data, AccountState))
+	require.NoError(t, m.AddItem(b, data, AccountState))
+
+	inner := func(branch int, child []byte) []byte {
+		children := make([]byte, 16*32)
+		copy(children[branch*32:], child)
+		return children
+	}
+
+	children := make([]byte, 16*32)
+	copy(children[6*32:], sha512HalfWithPrefix(hash.LeafNodePrefix, data, decodeHex(t, a)))
+	copy(children[7*32:], sha512HalfWithPrefix(hash.LeafNodePrefix, data, decodeHex(t, b)))
+	depth3 := sha512HalfWithPrefix(hash.InnerNodePrefix, children)
+	depth2 := sha512HalfWithPrefix(hash.InnerNodePrefix, inner(2, depth3))
+	depth1 := sha512HalfWithPrefix(hash.InnerNodePrefix, inner(9, depth2))
+	root := sha512HalfWithPrefix(hash.InnerNodePrefix, inner(11, depth1))
+
+	require.Equal(t, strings.ToUpper(hex.EncodeToString(root)), m.Hash())
+}
+
+func TestSHAMap_InsertionOrder(t *testing.T) {
+	forward := New()
+	for i, index := range testIndexes {
+		require.NoError(t, forward.AddItem(index, []byte{byte(i)}, AccountState))
+	}
+
+	backward := New()
+	for i := len(testIndexes) - 1; i >= 0; i-- {
+		require.NoError(t, backward.AddItem(testIndexes[i], []byte{byte(i)}, AccountState))
+	}
+
+	require.Equal(t, forward.Hash(), backward.Hash())
+}
+
+func TestSHAMap_LeafType(t *testing.T) {
+	state := New()
+	require.NoError(t, state.AddItem(testIndexes[0], []byte{0x01}, AccountState))
+
+	txs := New()
+	require.NoError(t, txs.AddItem(testIndexes[0], []byte{0x01}, TransactionWithMetadata))
+
+	require.NotEqual(t, state.Hash(), txs.Hash())
+}
+
+func TestSHAMap_AddItemErrors(t *testing.T) {
+	m := New()
+	require.NoError(t, m.AddItem(testIndexes[0], []byte{0x01}, AccountState))
+
+	require.ErrorIs(t, m.AddItem(testIndexes[0], []byte{0x02}, AccountState), ErrDuplicateIndex)
+	require.ErrorIs(t, m.AddItem("ABCD", []byte{0x01}, AccountState), ErrInvalidIndex)
+	require.ErrorIs(t, m.AddItem(testIndexes[1], []byte{0x01}, LeafType(0)), ErrInvalidLeafType)
+}
+
+func TestSHAMap_AddTransaction(t *testing.T) {
+	txBlob, err := binarycodec.Encode(map[string]any{
+		"TransactionType": "Payment",
+		"Account":         "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH",
+		"Destination":     "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		"Amount":          "1000",
+		"Fee":             "10",
+		"Sequence":        uint32(1),
+		"Flags":           uint32(0),
+		"SigningPubKey":   "ED5F5AC8B98974A3CA843326D9B88CEBD0560177B973EE0B149F782CFAA06DC66A",
+		"TxnSignature":    "30440220702ABC11419AD4940969CC32EB4D1BFDBFCA651F064F30D6E1646D74FBFC493902204E5B451B447B0F69904127F04FE71634BD825A8970B9467871DA89EEC4B021F8",
+	})
+	require.NoError(t, err)
+	meta := "201C00000000F8E5110061E1E1F1031000"
+
+	txID, err := hash.SignTxBlob(txBlob)
+	require.NoError(t, err)
+
+	m := New()
+	require.NoError(t, m.AddTransaction(txBlob, meta))
+
+	tx := decodeHex(t, txBlob)
+	metadata := decodeHex(t, meta)
+	data := append([]byte{byte(len(tx))}, tx...)
+	data = append(data, byte(len(metadata)))
+	data = append(data, metadata...)
+
+	expected := New()
+	require.NoError(t, expected.AddItem(txID, data, TransactionWithMetadata))
+	require.Equal(t, expected.Hash(), m.Hash())
+
+	proof, err := m.Proof(txID)
+	require.NoError(t, err)
+	require.Equal(t, txID, proof.Index)
+}
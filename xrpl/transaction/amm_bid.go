@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"errors"
 
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
@@ -104,6 +105,27 @@ func (a *AMMBid) Flatten() FlatTransaction {
 	return flattened
 }
 
+// UnmarshalJSON unmarshals the AMMBid transaction from JSON, decoding its currency amounts.
+func (a *AMMBid) UnmarshalJSON(data []byte) error {
+	type ammBidAlias AMMBid
+	var h struct {
+		ammBidAlias
+		BidMin json.RawMessage
+		BidMax json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*a = AMMBid(h.ammBidAlias)
+	if err := unmarshalAmount(&a.BidMin, h.BidMin); err != nil {
+		return err
+	}
+	if err := unmarshalAmount(&a.BidMax, h.BidMax); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate implements the Validate method for the AMMBid struct.
 func (a *AMMBid) Validate() (bool, error) {
 	_, err := a.BaseTx.Validate()
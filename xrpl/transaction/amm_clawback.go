@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"errors"
 
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
@@ -99,6 +100,23 @@ func (a *AMMClawback) Flatten() FlatTransaction {
 	return flattened
 }
 
+// UnmarshalJSON unmarshals the AMMClawback transaction from JSON, decoding its currency amounts.
+func (a *AMMClawback) UnmarshalJSON(data []byte) error {
+	type ammClawbackAlias AMMClawback
+	var h struct {
+		ammClawbackAlias
+		Asset2 json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*a = AMMClawback(h.ammClawbackAlias)
+	if err := unmarshalAmount(&a.Asset2, h.Asset2); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validates the transaction.
 func (a *AMMClawback) Validate() (bool, error) {
 	_, err := a.BaseTx.Validate()
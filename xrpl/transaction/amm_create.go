@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -65,6 +66,27 @@ func (a *AMMCreate) Flatten() FlatTransaction {
 	return flattened
 }
 
+// UnmarshalJSON unmarshals the AMMCreate transaction from JSON, decoding its currency amounts.
+func (a *AMMCreate) UnmarshalJSON(data []byte) error {
+	type ammCreateAlias AMMCreate
+	var h struct {
+		ammCreateAlias
+		Amount  json.RawMessage
+		Amount2 json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*a = AMMCreate(h.ammCreateAlias)
+	if err := unmarshalAmount(&a.Amount, h.Amount); err != nil {
+		return err
+	}
+	if err := unmarshalAmount(&a.Amount2, h.Amount2); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validates the AMMCreate struct and makes sure all fields are correct.
 func (a *AMMCreate) Validate() (bool, error) {
 	_, err := a.BaseTx.Validate()
@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"errors"
 
 	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
@@ -139,6 +140,35 @@ func (a *AMMDeposit) Flatten() FlatTransaction {
 	return flattened
 }
 
+// UnmarshalJSON unmarshals the AMMDeposit transaction from JSON, decoding its currency amounts.
+func (a *AMMDeposit) UnmarshalJSON(data []byte) error {
+	type ammDepositAlias AMMDeposit
+	var h struct {
+		ammDepositAlias
+		Amount     json.RawMessage
+		Amount2    json.RawMessage
+		EPrice     json.RawMessage
+		LPTokenOut json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*a = AMMDeposit(h.ammDepositAlias)
+	if err := unmarshalAmount(&a.Amount, h.Amount); err != nil {
+		return err
+	}
+	if err := unmarshalAmount(&a.Amount2, h.Amount2); err != nil {
+		return err
+	}
+	if err := unmarshalAmount(&a.EPrice, h.EPrice); err != nil {
+		return err
+	}
+	if err := unmarshalAmount(&a.LPTokenOut, h.LPTokenOut); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate implements the Validate method for the AMMDeposit struct.
 func (a *AMMDeposit) Validate() (bool, error) {
 	_, err := a.BaseTx.Validate()
@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -127,6 +128,31 @@ func (a *AMMWithdraw) Flatten() FlatTransaction {
 	return flattened
 }
 
+// UnmarshalJSON unmarshals the AMMWithdraw transaction from JSON, decoding its currency amounts.
+func (a *AMMWithdraw) UnmarshalJSON(data []byte) error {
+	type ammWithdrawAlias AMMWithdraw
+	var h struct {
+		ammWithdrawAlias
+		Amount  json.RawMessage
+		Amount2 json.RawMessage
+		EPrice  json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*a = AMMWithdraw(h.ammWithdrawAlias)
+	if err := unmarshalAmount(&a.Amount, h.Amount); err != nil {
+		return err
+	}
+	if err := unmarshalAmount(&a.Amount2, h.Amount2); err != nil {
+		return err
+	}
+	if err := unmarshalAmount(&a.EPrice, h.EPrice); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validates the AMMWithdraw struct and make sure all the fields are correct.
 func (a *AMMWithdraw) Validate() (bool, error) {
 	_, err := a.BaseTx.Validate()
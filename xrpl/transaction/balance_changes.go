@@ -1,11 +1,15 @@
 package transaction
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
 
 	"github.com/Peersyst/xrpl-go/xrpl/currency"
+	"github.com/Peersyst/xrpl-go/xrpl/keylet"
 	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -17,12 +21,19 @@ var (
 	errInvalidBalanceValue           = errors.New("invalid balance value")
 	errBalanceNotFound               = errors.New("balance not found")
 	errAccountNotFoundForXRPQuantity = errors.New("account not found for XRP quantity")
+	errAccountNotFoundForMPTQuantity = errors.New("account not found for MPT quantity")
+	errMPTIssuanceIDNotFound         = errors.New("MPTokenIssuanceID not found")
+	errIssuerNotFoundForMPTQuantity  = errors.New("issuer not found for MPT quantity")
+	errInvalidMPTIssuanceSequence    = errors.New("invalid MPTokenIssuance sequence")
 )
 
+// Balance is the change of an account balance in a currency or, for multi-purpose
+// tokens, in the issuance identified by MPTIssuanceID.
 type Balance struct {
-	Value    string `json:"amount"`
-	Currency string `json:"currency"`
-	Issuer   string `json:"issuer,omitempty"`
+	Value         string `json:"amount"`
+	Currency      string `json:"currency,omitempty"`
+	Issuer        string `json:"issuer,omitempty"`
+	MPTIssuanceID string `json:"mpt_issuance_id,omitempty"`
 }
 
 type balanceChange struct {
@@ -76,12 +87,17 @@ func newNormalizedNode(node AffectedNode) *normalizedNode {
 			LedgerEntryType: node.DeletedNode.LedgerEntryType,
 			LedgerIndex:     node.DeletedNode.LedgerIndex,
 			FinalFields:     node.DeletedNode.FinalFields,
+			PreviousFields:  node.DeletedNode.PreviousFields,
 		}
 	default:
 		return nil
 	}
 }
 
+// GetBalanceChanges returns the balance changes of every account affected by a
+// transaction, computed from its metadata. XRP changes come from AccountRoot nodes,
+// issued currency changes from RippleState nodes, and multi-purpose token changes
+// from MPToken nodes for holders and MPTokenIssuance nodes for issuers.
 func GetBalanceChanges(meta *TxObjMeta) ([]AccountBalanceChanges, error) {
 	nodes := normalizeNodes(meta.AffectedNodes)
 
@@ -105,6 +121,22 @@ func GetBalanceChanges(meta *TxObjMeta) ([]AccountBalanceChanges, error) {
 			if len(trustlineChanges) > 0 {
 				balanceChanges = append(balanceChanges, trustlineChanges...)
 			}
+		case ledger.MPTokenEntry:
+			mptChange, err := getMPTokenQuantity(node)
+			if err != nil {
+				return nil, err
+			}
+			if mptChange != nil {
+				balanceChanges = append(balanceChanges, *mptChange)
+			}
+		case ledger.MPTokenIssuanceEntry:
+			issuerChange, err := getMPTokenIssuanceQuantity(node)
+			if err != nil {
+				return nil, err
+			}
+			if issuerChange != nil {
+				balanceChanges = append(balanceChanges, *issuerChange)
+			}
 		default:
 			continue
 		}
@@ -275,6 +307,150 @@ func computeBalanceChange(node *normalizedNode) (string, error) {
 	return value.String(), nil
 }
 
+// getMPTokenQuantity returns the change of the MPTAmount held by the owner of an
+// MPToken node, or nil if the amount did not change.
+func getMPTokenQuantity(node *normalizedNode) (*balanceChange, error) {
+	change, err := computeMPTAmountChange(node, "MPTAmount")
+	if err != nil || change == nil {
+		return nil, err
+	}
+
+	fields := nodeFields(node)
+	account, ok := fields["Account"].(string)
+	if !ok {
+		return nil, errAccountNotFoundForMPTQuantity
+	}
+	issuanceID, ok := fields["MPTokenIssuanceID"].(string)
+	if !ok {
+		return nil, errMPTIssuanceIDNotFound
+	}
+
+	return &balanceChange{
+		Account: types.Address(account),
+		Balance: Balance{
+			MPTIssuanceID: issuanceID,
+			Value:         change.String(),
+		},
+	}, nil
+}
+
+// getMPTokenIssuanceQuantity returns the change of the issuer balance of an
+// MPTokenIssuance node, the opposite of the change of its OutstandingAmount, or nil
+// if the outstanding amount did not change.
+func getMPTokenIssuanceQuantity(node *normalizedNode) (*balanceChange, error) {
+	change, err := computeMPTAmountChange(node, "OutstandingAmount")
+	if err != nil || change == nil {
+		return nil, err
+	}
+
+	fields := nodeFields(node)
+	issuer, ok := fields["Issuer"].(string)
+	if !ok {
+		return nil, errIssuerNotFoundForMPTQuantity
+	}
+	issuanceID, err := mptIssuanceID(issuer, fields["Sequence"])
+	if err != nil {
+		return nil, err
+	}
+
+	return &balanceChange{
+		Account: types.Address(issuer),
+		Balance: Balance{
+			MPTIssuanceID: issuanceID,
+			Value:         change.Neg(change).String(),
+		},
+	}, nil
+}
+
+// computeMPTAmountChange returns the change of an MPT amount field of a node, or nil
+// if it did not change.
+//
+// The field is omitted from a node when its value is zero. PreviousFields only lists
+// the fields that changed, so a field missing from it did not change, even when other
+// fields of the node, such as LockedAmount, did.
+func computeMPTAmountChange(node *normalizedNode, field string) (*big.Int, error) {
+	var previous, final any
+	switch {
+	case node.NewFields != nil:
+		previous, final = nil, node.NewFields[field]
+	default:
+		prev, ok := node.PreviousFields[field]
+		if !ok {
+			return nil, nil
+		}
+		previous, final = prev, node.FinalFields[field]
+	}
+
+	previousAmount, err := parseMPTAmount(previous)
+	if err != nil {
+		return nil, err
+	}
+	finalAmount, err := parseMPTAmount(final)
+	if err != nil {
+		return nil, err
+	}
+
+	change := finalAmount.Sub(finalAmount, previousAmount)
+	if change.Sign() == 0 {
+		return nil, nil
+	}
+	return change, nil
+}
+
+// parseMPTAmount parses an MPT amount, a base-10 integer string, treating a missing
+// value as zero.
+func parseMPTAmount(v any) (*big.Int, error) {
+	if v == nil {
+		return new(big.Int), nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return nil, errInvalidBalanceValue
+	}
+	amount, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, errInvalidBalanceValue
+	}
+	return amount, nil
+}
+
+// mptIssuanceID computes the MPTokenIssuanceID of an issuance from its metadata fields.
+func mptIssuanceID(issuer string, sequence any) (string, error) {
+	var seq uint32
+	switch v := sequence.(type) {
+	case float64:
+		seq = uint32(v)
+	case int:
+		seq = uint32(v)
+	case uint32:
+		seq = v
+	case uint64:
+		seq = uint32(v)
+	case json.Number:
+		n, err := strconv.ParseUint(v.String(), 10, 32)
+		if err != nil {
+			return "", errInvalidMPTIssuanceSequence
+		}
+		seq = uint32(n)
+	default:
+		return "", errInvalidMPTIssuanceSequence
+	}
+
+	id, err := keylet.MPTokenIssuanceID(types.Address(issuer), seq)
+	if err != nil {
+		return "", fmt.Errorf("invalid MPTokenIssuance issuer: %w", err)
+	}
+	return id, nil
+}
+
+// nodeFields returns the fields of a node after the transaction.
+func nodeFields(node *normalizedNode) ledger.FlatLedgerObject {
+	if node.NewFields != nil {
+		return node.NewFields
+	}
+	return node.FinalFields
+}
+
 func getValue(balance interface{}) (string, error) {
 	if value, ok := balance.(string); ok {
 		return value, nil
@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
@@ -486,6 +487,256 @@ func TestGetBalanceChanges(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "pass - MPT payment from issuer",
+			meta: &TxObjMeta{
+				AffectedNodes: []AffectedNode{
+					{
+						ModifiedNode: &ModifiedNode{
+							FinalFields: ledger.FlatLedgerObject{
+								"Flags":             0,
+								"Issuer":            "rMwjYedjc7qqtKYVLiAccJSmCwih4LnE2q",
+								"OutstandingAmount": "150",
+								"OwnerNode":         "0000000000000000",
+								"Sequence":          float64(12),
+							},
+							LedgerEntryType: ledger.MPTokenIssuanceEntry,
+							LedgerIndex:     "B2CE8A6D0E7A22A1B0D1F0F5E5A9F0B1C2D3E4F5A6B7C8D9E0F1A2B3C4D5E6F7",
+							PreviousFields: ledger.FlatLedgerObject{
+								"OutstandingAmount": "50",
+							},
+						},
+					},
+					{
+						ModifiedNode: &ModifiedNode{
+							FinalFields: ledger.FlatLedgerObject{
+								"Account":           "rKmBGxocj9Abgy25J51Mk1iqFzW9aVF9Tc",
+								"Flags":             0,
+								"MPTAmount":         "150",
+								"MPTokenIssuanceID": "0000000CDD39C650A96EDA48334E70CC4A85B8B2E8502CD3",
+								"OwnerNode":         "0000000000000000",
+							},
+							LedgerEntryType: ledger.MPTokenEntry,
+							LedgerIndex:     "A1CE8A6D0E7A22A1B0D1F0F5E5A9F0B1C2D3E4F5A6B7C8D9E0F1A2B3C4D5E6F7",
+							PreviousFields: ledger.FlatLedgerObject{
+								"MPTAmount": "50",
+							},
+						},
+					},
+					{
+						ModifiedNode: &ModifiedNode{
+							FinalFields: ledger.FlatLedgerObject{
+								"Account": "rMwjYedjc7qqtKYVLiAccJSmCwih4LnE2q",
+								"Balance": "99999988",
+							},
+							LedgerEntryType: ledger.AccountRootEntry,
+							LedgerIndex:     "C3CE8A6D0E7A22A1B0D1F0F5E5A9F0B1C2D3E4F5A6B7C8D9E0F1A2B3C4D5E6F7",
+							PreviousFields: ledger.FlatLedgerObject{
+								"Balance": "100000000",
+							},
+						},
+					},
+				},
+			},
+			expected: []AccountBalanceChanges{
+				{
+					Account: "rMwjYedjc7qqtKYVLiAccJSmCwih4LnE2q",
+					Balances: []Balance{
+						{
+							MPTIssuanceID: "0000000CDD39C650A96EDA48334E70CC4A85B8B2E8502CD3",
+							Value:         "-100",
+						},
+						{
+							Currency: "XRP",
+							Value:    "-0.000012",
+						},
+					},
+				},
+				{
+					Account: "rKmBGxocj9Abgy25J51Mk1iqFzW9aVF9Tc",
+					Balances: []Balance{
+						{
+							MPTIssuanceID: "0000000CDD39C650A96EDA48334E70CC4A85B8B2E8502CD3",
+							Value:         "100",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "pass - MPT payment between holders",
+			meta: &TxObjMeta{
+				AffectedNodes: []AffectedNode{
+					{
+						ModifiedNode: &ModifiedNode{
+							FinalFields: ledger.FlatLedgerObject{
+								"Account":           "rKmBGxocj9Abgy25J51Mk1iqFzW9aVF9Tc",
+								"MPTAmount":         "70",
+								"MPTokenIssuanceID": "0000000CDD39C650A96EDA48334E70CC4A85B8B2E8502CD3",
+							},
+							LedgerEntryType: ledger.MPTokenEntry,
+							PreviousFields: ledger.FlatLedgerObject{
+								"MPTAmount": "100",
+							},
+						},
+					},
+					{
+						CreatedNode: &CreatedNode{
+							LedgerEntryType: ledger.MPTokenEntry,
+							NewFields: ledger.FlatLedgerObject{
+								"Account":           "rLDYrujdKUfVx28T9vRDAbyJ7G2WVXKo4K",
+								"MPTAmount":         "30",
+								"MPTokenIssuanceID": "0000000CDD39C650A96EDA48334E70CC4A85B8B2E8502CD3",
+							},
+						},
+					},
+				},
+			},
+			expected: []AccountBalanceChanges{
+				{
+					Account: "rKmBGxocj9Abgy25J51Mk1iqFzW9aVF9Tc",
+					Balances: []Balance{
+						{
+							MPTIssuanceID: "0000000CDD39C650A96EDA48334E70CC4A85B8B2E8502CD3",
+							Value:         "-30",
+						},
+					},
+				},
+				{
+					Account: "rLDYrujdKUfVx28T9vRDAbyJ7G2WVXKo4K",
+					Balances: []Balance{
+						{
+							MPTIssuanceID: "0000000CDD39C650A96EDA48334E70CC4A85B8B2E8502CD3",
+							Value:         "30",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "pass - MPT clawback deleting the holder balance",
+			meta: &TxObjMeta{
+				AffectedNodes: []AffectedNode{
+					{
+						ModifiedNode: &ModifiedNode{
+							FinalFields: ledger.FlatLedgerObject{
+								"Issuer":            "rMwjYedjc7qqtKYVLiAccJSmCwih4LnE2q",
+								"OutstandingAmount": "0",
+								"Sequence":          float64(12),
+							},
+							LedgerEntryType: ledger.MPTokenIssuanceEntry,
+							PreviousFields: ledger.FlatLedgerObject{
+								"OutstandingAmount": "70",
+							},
+						},
+					},
+					{
+						DeletedNode: &DeletedNode{
+							FinalFields: ledger.FlatLedgerObject{
+								"Account":           "rKmBGxocj9Abgy25J51Mk1iqFzW9aVF9Tc",
+								"MPTokenIssuanceID": "0000000CDD39C650A96EDA48334E70CC4A85B8B2E8502CD3",
+							},
+							LedgerEntryType: ledger.MPTokenEntry,
+							PreviousFields: ledger.FlatLedgerObject{
+								"MPTAmount": "70",
+							},
+						},
+					},
+				},
+			},
+			expected: []AccountBalanceChanges{
+				{
+					Account: "rMwjYedjc7qqtKYVLiAccJSmCwih4LnE2q",
+					Balances: []Balance{
+						{
+							MPTIssuanceID: "0000000CDD39C650A96EDA48334E70CC4A85B8B2E8502CD3",
+							Value:         "70",
+						},
+					},
+				},
+				{
+					Account: "rKmBGxocj9Abgy25J51Mk1iqFzW9aVF9Tc",
+					Balances: []Balance{
+						{
+							MPTIssuanceID: "0000000CDD39C650A96EDA48334E70CC4A85B8B2E8502CD3",
+							Value:         "-70",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "pass - MPT issuance with a json.Number sequence",
+			meta: &TxObjMeta{
+				AffectedNodes: []AffectedNode{
+					{
+						ModifiedNode: &ModifiedNode{
+							FinalFields: ledger.FlatLedgerObject{
+								"Issuer":            "rMwjYedjc7qqtKYVLiAccJSmCwih4LnE2q",
+								"OutstandingAmount": "80",
+								"Sequence":          json.Number("12"),
+							},
+							LedgerEntryType: ledger.MPTokenIssuanceEntry,
+							PreviousFields: ledger.FlatLedgerObject{
+								"OutstandingAmount": "70",
+							},
+						},
+					},
+				},
+			},
+			expected: []AccountBalanceChanges{
+				{
+					Account: "rMwjYedjc7qqtKYVLiAccJSmCwih4LnE2q",
+					Balances: []Balance{
+						{
+							MPTIssuanceID: "0000000CDD39C650A96EDA48334E70CC4A85B8B2E8502CD3",
+							Value:         "-10",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "pass - MPT escrow create only changing the locked amount",
+			meta: &TxObjMeta{
+				AffectedNodes: []AffectedNode{
+					{
+						ModifiedNode: &ModifiedNode{
+							FinalFields: ledger.FlatLedgerObject{
+								"Issuer":            "rMwjYedjc7qqtKYVLiAccJSmCwih4LnE2q",
+								"LockedAmount":      "20",
+								"OutstandingAmount": "70",
+								"Sequence":          float64(12),
+							},
+							LedgerEntryType: ledger.MPTokenIssuanceEntry,
+						},
+					},
+				},
+			},
+			expected: []AccountBalanceChanges{},
+		},
+		{
+			name: "pass - MPT lock reports no balance change",
+			meta: &TxObjMeta{
+				AffectedNodes: []AffectedNode{
+					{
+						ModifiedNode: &ModifiedNode{
+							FinalFields: ledger.FlatLedgerObject{
+								"Account":           "rKmBGxocj9Abgy25J51Mk1iqFzW9aVF9Tc",
+								"Flags":             float64(1),
+								"MPTAmount":         "70",
+								"MPTokenIssuanceID": "0000000CDD39C650A96EDA48334E70CC4A85B8B2E8502CD3",
+							},
+							LedgerEntryType: ledger.MPTokenEntry,
+							PreviousFields: ledger.FlatLedgerObject{
+								"Flags": float64(0),
+							},
+						},
+					},
+				},
+			},
+			expected: []AccountBalanceChanges{},
+		},
 	}
 
 	for _, tc := range tt {
@@ -496,3 +747,31 @@ func TestGetBalanceChanges(t *testing.T) {
 		})
 	}
 }
+
+func TestMPTIssuanceID(t *testing.T) {
+	tt := []struct {
+		name     string
+		sequence any
+		expected string
+		err      error
+	}{
+		{name: "pass - float64", sequence: float64(12), expected: "0000000CDD39C650A96EDA48334E70CC4A85B8B2E8502CD3"},
+		{name: "pass - uint32", sequence: uint32(12), expected: "0000000CDD39C650A96EDA48334E70CC4A85B8B2E8502CD3"},
+		{name: "pass - uint64", sequence: uint64(12), expected: "0000000CDD39C650A96EDA48334E70CC4A85B8B2E8502CD3"},
+		{name: "pass - json.Number", sequence: json.Number("12"), expected: "0000000CDD39C650A96EDA48334E70CC4A85B8B2E8502CD3"},
+		{name: "fail - invalid json.Number", sequence: json.Number("1.5"), err: errInvalidMPTIssuanceSequence},
+		{name: "fail - missing sequence", sequence: nil, err: errInvalidMPTIssuanceSequence},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := mptIssuanceID("rMwjYedjc7qqtKYVLiAccJSmCwih4LnE2q", tc.sequence)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, id)
+		})
+	}
+}
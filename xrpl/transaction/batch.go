@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"errors"
 
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
@@ -180,6 +181,48 @@ func (b *Batch) Flatten() FlatTransaction {
 	return flattenedTx
 }
 
+// UnmarshalJSON unmarshals the Batch transaction from JSON. The inner transactions are kept
+// flat, with their integer fields converted to the types the binary codec expects.
+func (b *Batch) UnmarshalJSON(data []byte) error {
+	type batchAlias Batch
+	var h struct {
+		batchAlias
+		RawTransactions []struct {
+			RawTransaction json.RawMessage
+		}
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*b = Batch(h.batchAlias)
+	b.RawTransactions = nil
+	for _, raw := range h.RawTransactions {
+		var inner FlatTransaction
+		if len(raw.RawTransaction) > 0 {
+			flat, err := flatFromJSON(raw.RawTransaction)
+			if err != nil {
+				return err
+			}
+			inner = flat
+		}
+		b.RawTransactions = append(b.RawTransactions, types.RawTransaction{RawTransaction: inner})
+	}
+	return nil
+}
+
+// InnerTransactions returns the typed inner transactions of the batch, in order.
+func (b *Batch) InnerTransactions() ([]Tx, error) {
+	txs := make([]Tx, len(b.RawTransactions))
+	for i, raw := range b.RawTransactions {
+		tx, err := FromFlat(raw.RawTransaction)
+		if err != nil {
+			return nil, err
+		}
+		txs[i] = tx
+	}
+	return txs, nil
+}
+
 // Validate validates the Batch transaction.
 func (b *Batch) Validate() (bool, error) {
 	_, err := b.BaseTx.Validate()
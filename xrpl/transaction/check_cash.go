@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"errors"
 
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
@@ -69,6 +70,27 @@ func (c *CheckCash) Flatten() FlatTransaction {
 	return flattened
 }
 
+// UnmarshalJSON unmarshals the CheckCash transaction from JSON, decoding its currency amounts.
+func (c *CheckCash) UnmarshalJSON(data []byte) error {
+	type checkCashAlias CheckCash
+	var h struct {
+		checkCashAlias
+		Amount     json.RawMessage
+		DeliverMin json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*c = CheckCash(h.checkCashAlias)
+	if err := unmarshalAmount(&c.Amount, h.Amount); err != nil {
+		return err
+	}
+	if err := unmarshalAmount(&c.DeliverMin, h.DeliverMin); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate checks all the fields of the transaction and returns an error if any of the fields are invalid.
 func (c *CheckCash) Validate() (bool, error) {
 	ok, err := c.BaseTx.Validate()
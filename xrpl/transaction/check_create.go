@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -63,6 +64,23 @@ func (c *CheckCreate) Flatten() FlatTransaction {
 	return flattened
 }
 
+// UnmarshalJSON unmarshals the CheckCreate transaction from JSON, decoding its currency amounts.
+func (c *CheckCreate) UnmarshalJSON(data []byte) error {
+	type checkCreateAlias CheckCreate
+	var h struct {
+		checkCreateAlias
+		SendMax json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*c = CheckCreate(h.checkCreateAlias)
+	if err := unmarshalAmount(&c.SendMax, h.SendMax); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate checks all the fields of the transaction and returns an error if any of the fields are invalid.
 func (c *CheckCreate) Validate() (bool, error) {
 	ok, err := c.BaseTx.Validate()
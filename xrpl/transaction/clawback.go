@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"errors"
 
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
@@ -47,6 +48,23 @@ func (c *Clawback) Flatten() FlatTransaction {
 	return flattened
 }
 
+// UnmarshalJSON unmarshals the Clawback transaction from JSON, decoding its currency amounts.
+func (c *Clawback) UnmarshalJSON(data []byte) error {
+	type clawbackAlias Clawback
+	var h struct {
+		clawbackAlias
+		Amount json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*c = Clawback(h.clawbackAlias)
+	if err := unmarshalAmount(&c.Amount, h.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate implements the Validate method for the Clawback struct.
 func (c *Clawback) Validate() (bool, error) {
 	// validate the base transaction
@@ -29,6 +29,10 @@ var (
 	ErrInvalidHexPublicKey = errors.New("invalid PublicKey, must be a valid hexadecimal string")
 	// ErrInvalidTransactionType is returned when the TransactionType field is invalid or missing.
 	ErrInvalidTransactionType = errors.New("invalid or missing TransactionType")
+	// ErrUnsupportedTransactionType is returned when a transaction of an unknown TransactionType is decoded.
+	ErrUnsupportedTransactionType = errors.New("unsupported transaction type")
+	// ErrInvalidVector256 is returned when a field holding a list of hashes contains a value that is not a string.
+	ErrInvalidVector256 = errors.New("invalid list of hashes, must contain hexadecimal strings")
 	// ErrInvalidSubject is returned when the Subject field is an invalid xrpl address.
 	ErrInvalidSubject = errors.New("invalid xrpl address for Subject")
 	// ErrInvalidURI is returned when the URI is not a valid hexadecimal string.
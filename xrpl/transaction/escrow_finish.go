@@ -2,14 +2,18 @@ package transaction
 
 import (
 	"errors"
+	"fmt"
 
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/cryptocondition"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
 var (
 	ErrEscrowFinishMissingOwner         = errors.New("escrow finish: missing owner")
 	ErrEscrowFinishMissingOfferSequence = errors.New("escrow finish: missing offer sequence")
+	ErrEscrowFinishConditionFulfillment = errors.New("escrow finish: condition and fulfillment must be set together")
+	ErrEscrowFinishInvalidFulfillment   = errors.New("escrow finish: fulfillment does not match the condition")
 )
 
 // Deliver XRP from a held payment to the recipient.
@@ -97,5 +101,15 @@ func (e *EscrowFinish) Validate() (bool, error) {
 		return false, ErrInvalidCredentialIDs
 	}
 
+	if (e.Condition == "") != (e.Fulfillment == "") {
+		return false, ErrEscrowFinishConditionFulfillment
+	}
+
+	if e.Condition != "" {
+		if err := cryptocondition.Verify(e.Condition, e.Fulfillment); err != nil {
+			return false, fmt.Errorf("%w: %w", ErrEscrowFinishInvalidFulfillment, err)
+		}
+	}
+
 	return true, nil
 }
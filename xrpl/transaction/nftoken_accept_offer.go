@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"errors"
 
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
@@ -79,6 +80,23 @@ func (n *NFTokenAcceptOffer) Flatten() FlatTransaction {
 	return flattened
 }
 
+// UnmarshalJSON unmarshals the NFTokenAcceptOffer transaction from JSON, decoding its currency amounts.
+func (n *NFTokenAcceptOffer) UnmarshalJSON(data []byte) error {
+	type nftokenAcceptOfferAlias NFTokenAcceptOffer
+	var h struct {
+		nftokenAcceptOfferAlias
+		NFTokenBrokerFee json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*n = NFTokenAcceptOffer(h.nftokenAcceptOfferAlias)
+	if err := unmarshalAmount(&n.NFTokenBrokerFee, h.NFTokenBrokerFee); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate checks the validity of the NFTokenAcceptOffer fields.
 func (n *NFTokenAcceptOffer) Validate() (bool, error) {
 	ok, err := n.BaseTx.Validate()
@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"errors"
 
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
@@ -95,6 +96,23 @@ func (n *NFTokenCreateOffer) Flatten() FlatTransaction {
 	return flattened
 }
 
+// UnmarshalJSON unmarshals the NFTokenCreateOffer transaction from JSON, decoding its currency amounts.
+func (n *NFTokenCreateOffer) UnmarshalJSON(data []byte) error {
+	type nftokenCreateOfferAlias NFTokenCreateOffer
+	var h struct {
+		nftokenCreateOfferAlias
+		Amount json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*n = NFTokenCreateOffer(h.nftokenCreateOfferAlias)
+	if err := unmarshalAmount(&n.Amount, h.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate checks the validity of the NFTokenCreateOffer fields.
 func (n *NFTokenCreateOffer) Validate() (bool, error) {
 	ok, err := n.BaseTx.Validate()
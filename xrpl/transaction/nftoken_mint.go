@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"errors"
 
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
@@ -158,6 +159,23 @@ func (n *NFTokenMint) Flatten() FlatTransaction {
 	return flattened
 }
 
+// UnmarshalJSON unmarshals the NFTokenMint transaction from JSON, decoding its currency amounts.
+func (n *NFTokenMint) UnmarshalJSON(data []byte) error {
+	type nftokenMintAlias NFTokenMint
+	var h struct {
+		nftokenMintAlias
+		Amount json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*n = NFTokenMint(h.nftokenMintAlias)
+	if err := unmarshalAmount(&n.Amount, h.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 const (
 	// Allowing a transfer fee of up to 50%.
 	MaxTransferFee = 50000
@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
 
@@ -96,6 +97,27 @@ func (o *OfferCreate) Flatten() FlatTransaction {
 	return flattened
 }
 
+// UnmarshalJSON unmarshals the OfferCreate transaction from JSON, decoding its currency amounts.
+func (o *OfferCreate) UnmarshalJSON(data []byte) error {
+	type offerCreateAlias OfferCreate
+	var h struct {
+		offerCreateAlias
+		TakerGets json.RawMessage
+		TakerPays json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*o = OfferCreate(h.offerCreateAlias)
+	if err := unmarshalAmount(&o.TakerGets, h.TakerGets); err != nil {
+		return err
+	}
+	if err := unmarshalAmount(&o.TakerPays, h.TakerPays); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validates the OfferCreate transaction.
 func (o *OfferCreate) Validate() (bool, error) {
 	_, err := o.BaseTx.Validate()
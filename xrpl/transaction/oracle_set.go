@@ -1,7 +1,9 @@
 package transaction
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
 
 	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 )
@@ -52,7 +54,7 @@ type OracleSet struct {
 	OracleDocumentID uint32
 	// The time the data was last updated, in seconds since the UNIX Epoch.
 	// It is 0 by default.
-	LastUpdatedTime uint32
+	LastUpdatedTime uint32 `json:"LastUpdateTime"`
 	// (Variable) An arbitrary value that identifies an oracle provider, such as Chainlink, Band, or DIA. This field is a string, up to 256 ASCII hex encoded characters (0x20-0x7E).
 	// This field is required when creating a new Oracle ledger entry, but is optional for updates.
 	Provider string `json:",omitempty"`
@@ -89,7 +91,7 @@ func (tx *OracleSet) Flatten() map[string]interface{} {
 		flattened["URI"] = tx.URI
 	}
 
-	flattened["LastUpdatedTime"] = tx.LastUpdatedTime
+	flattened["LastUpdateTime"] = tx.LastUpdatedTime
 
 	if tx.AssetClass != "" {
 		flattened["AssetClass"] = tx.AssetClass
@@ -98,7 +100,9 @@ func (tx *OracleSet) Flatten() map[string]interface{} {
 	if len(tx.PriceDataSeries) > 0 {
 		flattenedPriceDataSeries := make([]map[string]interface{}, 0, len(tx.PriceDataSeries))
 		for _, priceData := range tx.PriceDataSeries {
-			flattenedPriceDataSeries = append(flattenedPriceDataSeries, priceData.Flatten())
+			flattenedPriceDataSeries = append(flattenedPriceDataSeries, map[string]interface{}{
+				"PriceData": priceData.Flatten(),
+			})
 		}
 		flattened["PriceDataSeries"] = flattenedPriceDataSeries
 	}
@@ -106,6 +110,69 @@ func (tx *OracleSet) Flatten() map[string]interface{} {
 	return flattened
 }
 
+// UnmarshalJSON unmarshals the OracleSet transaction from JSON. Each price data can be
+// wrapped in a PriceData object, as rippled returns it, and its AssetPrice can be a
+// hex string, as rippled and the binary codec return it.
+func (tx *OracleSet) UnmarshalJSON(data []byte) error {
+	type oracleSetAlias OracleSet
+	var h struct {
+		oracleSetAlias
+		PriceDataSeries []json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*tx = OracleSet(h.oracleSetAlias)
+	for _, raw := range h.PriceDataSeries {
+		priceData, err := unmarshalPriceData(raw)
+		if err != nil {
+			return err
+		}
+		tx.PriceDataSeries = append(tx.PriceDataSeries, priceData)
+	}
+	return nil
+}
+
+func unmarshalPriceData(data []byte) (ledger.PriceData, error) {
+	var wrapper struct {
+		PriceData json.RawMessage
+	}
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return ledger.PriceData{}, err
+	}
+	if len(wrapper.PriceData) > 0 {
+		data = wrapper.PriceData
+	}
+
+	var h struct {
+		BaseAsset  string
+		QuoteAsset string
+		AssetPrice json.RawMessage
+		Scale      uint8
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return ledger.PriceData{}, err
+	}
+	priceData := ledger.PriceData{
+		BaseAsset:  h.BaseAsset,
+		QuoteAsset: h.QuoteAsset,
+		Scale:      h.Scale,
+	}
+	if len(h.AssetPrice) > 0 {
+		var hexPrice string
+		var err error
+		if json.Unmarshal(h.AssetPrice, &hexPrice) == nil {
+			priceData.AssetPrice, err = strconv.ParseUint(hexPrice, 16, 64)
+		} else {
+			priceData.AssetPrice, err = strconv.ParseUint(string(h.AssetPrice), 10, 64)
+		}
+		if err != nil {
+			return ledger.PriceData{}, err
+		}
+	}
+	return priceData, nil
+}
+
 // Validates the transaction.
 func (tx *OracleSet) Validate() (bool, error) {
 	if ok, err := tx.BaseTx.Validate(); !ok {
@@ -1,11 +1,14 @@
 package transaction
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
 	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestOracleSet_TxType(t *testing.T) {
@@ -25,7 +28,7 @@ func TestOracleSet_Flatten(t *testing.T) {
 			expected: map[string]interface{}{
 				"TransactionType":  OracleSetTx,
 				"OracleDocumentID": uint32(0),
-				"LastUpdatedTime":  uint32(0),
+				"LastUpdateTime":   uint32(0),
 			},
 		},
 		{
@@ -60,14 +63,16 @@ func TestOracleSet_Flatten(t *testing.T) {
 				"OracleDocumentID":   uint32(1),
 				"Provider":           "Chainlink",
 				"URI":                "https://example.com",
-				"LastUpdatedTime":    uint32(1715702400),
+				"LastUpdateTime":     uint32(1715702400),
 				"AssetClass":         "currency",
 				"PriceDataSeries": []map[string]interface{}{
 					{
-						"BaseAsset":  "XRP",
-						"QuoteAsset": "USD",
-						"AssetPrice": uint64(740),
-						"Scale":      uint8(3),
+						"PriceData": ledger.FlatPriceData{
+							"BaseAsset":  "XRP",
+							"QuoteAsset": "USD",
+							"AssetPrice": uint64(740),
+							"Scale":      uint8(3),
+						},
 					},
 				},
 			},
@@ -200,3 +205,32 @@ func TestOracleSet_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestOracleSet_Encode(t *testing.T) {
+	tx := &OracleSet{
+		BaseTx:           BaseTx{Account: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", TransactionType: OracleSetTx},
+		OracleDocumentID: 1,
+		LastUpdatedTime:  1715702400,
+		PriceDataSeries: []ledger.PriceData{
+			{BaseAsset: "XRP", QuoteAsset: "USD", AssetPrice: 740, Scale: 3},
+		},
+	}
+
+	// Go through JSON, so the values have the types the binary codec expects.
+	data, err := json.Marshal(tx.Flatten())
+	require.NoError(t, err)
+	flat, err := flatFromJSON(data)
+	require.NoError(t, err)
+	blob, err := binarycodec.Encode(flat)
+	require.NoError(t, err)
+	// LastUpdateTime is UInt32 field 15.
+	require.Contains(t, blob, "2F66438A80")
+	// PriceDataSeries (STArray 24) holds a PriceData object (STObject 32) with
+	// AssetPrice (UInt64 23), Scale (UInt8 4), BaseAsset and QuoteAsset (Currency 1 and 2).
+	require.Contains(t, blob, "F018"+"E020"+
+		"301700000000000002E4"+
+		"041003"+
+		"011A0000000000000000000000000000000000000000"+
+		"021A0000000000000000000000005553440000000000"+
+		"E1F1")
+}
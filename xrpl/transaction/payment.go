@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"errors"
 
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
@@ -138,6 +139,35 @@ func (p *Payment) Flatten() FlatTransaction {
 	return flattened
 }
 
+// UnmarshalJSON unmarshals the Payment transaction from JSON, decoding its currency amounts.
+func (p *Payment) UnmarshalJSON(data []byte) error {
+	type paymentAlias Payment
+	var h struct {
+		paymentAlias
+		Amount     json.RawMessage
+		DeliverMax json.RawMessage
+		DeliverMin json.RawMessage
+		SendMax    json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*p = Payment(h.paymentAlias)
+	if err := unmarshalAmount(&p.Amount, h.Amount); err != nil {
+		return err
+	}
+	if err := unmarshalAmount(&p.DeliverMax, h.DeliverMax); err != nil {
+		return err
+	}
+	if err := unmarshalAmount(&p.DeliverMin, h.DeliverMin); err != nil {
+		return err
+	}
+	if err := unmarshalAmount(&p.SendMax, h.SendMax); err != nil {
+		return err
+	}
+	return nil
+}
+
 // SetRippleNotDirectFlag sets the RippleNotDirect flag.
 //
 // RippleNotDirect: Do not use the default path; only use paths included in the Paths field.
@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -99,6 +100,23 @@ func (s *SignerListSet) Flatten() FlatTransaction {
 	return flattened
 }
 
+// UnmarshalJSON unmarshals the SignerListSet transaction from JSON, decoding SignerQuorum as an uint32.
+func (s *SignerListSet) UnmarshalJSON(data []byte) error {
+	type signerListSetAlias SignerListSet
+	var h struct {
+		signerListSetAlias
+		SignerQuorum *uint32
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*s = SignerListSet(h.signerListSetAlias)
+	if h.SignerQuorum != nil {
+		s.SignerQuorum = *h.SignerQuorum
+	}
+	return nil
+}
+
 // Validate checks if the SignerListSet struct is valid.
 func (s *SignerListSet) Validate() (bool, error) {
 	ok, err := s.BaseTx.Validate()
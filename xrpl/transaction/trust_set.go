@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"errors"
 
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
@@ -77,6 +78,23 @@ func (t *TrustSet) Flatten() FlatTransaction {
 	return flattened
 }
 
+// UnmarshalJSON unmarshals the TrustSet transaction from JSON, decoding its currency amounts.
+func (t *TrustSet) UnmarshalJSON(data []byte) error {
+	type trustSetAlias TrustSet
+	var h struct {
+		trustSetAlias
+		LimitAmount json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*t = TrustSet(h.trustSetAlias)
+	if err := unmarshalAmount(&t.LimitAmount, h.LimitAmount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Set the SetAuth flag
 //
 // SetAuth: Authorize the other party to hold currency issued by this account. (No
@@ -19,7 +19,7 @@ type CurrencyAmount interface {
 }
 
 func UnmarshalCurrencyAmount(data []byte) (CurrencyAmount, error) {
-	if len(data) == 0 {
+	if len(data) == 0 || string(data) == "null" {
 		return nil, nil
 	}
 	switch data[0] {
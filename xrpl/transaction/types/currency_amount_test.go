@@ -192,3 +192,40 @@ func TestUnmarshalCurrencyAmount_MPT(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshalCurrencyAmount(t *testing.T) {
+	testcases := []struct {
+		name     string
+		input    []byte
+		expected CurrencyAmount
+	}{
+		{
+			name:     "pass - xrp",
+			input:    []byte(`"100"`),
+			expected: XRPCurrencyAmount(100),
+		},
+		{
+			name:     "pass - issued currency",
+			input:    []byte(`{"currency":"USD","issuer":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","value":"1"}`),
+			expected: IssuedCurrencyAmount{Currency: "USD", Issuer: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", Value: "1"},
+		},
+		{
+			name:     "pass - empty",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name:     "pass - null",
+			input:    []byte(`null`),
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := UnmarshalCurrencyAmount(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
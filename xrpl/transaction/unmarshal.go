@@ -0,0 +1,266 @@
+package transaction
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// EmptyTx returns an empty typed transaction of the given type. It returns an
+// error if the type is not supported.
+func EmptyTx(t TxType) (Tx, error) {
+	switch t {
+	case AccountSetTx:
+		return &AccountSet{}, nil
+	case AccountDeleteTx:
+		return &AccountDelete{}, nil
+	case AMMBidTx:
+		return &AMMBid{}, nil
+	case AMMClawbackTx:
+		return &AMMClawback{}, nil
+	case AMMCreateTx:
+		return &AMMCreate{}, nil
+	case AMMDeleteTx:
+		return &AMMDelete{}, nil
+	case AMMDepositTx:
+		return &AMMDeposit{}, nil
+	case AMMVoteTx:
+		return &AMMVote{}, nil
+	case AMMWithdrawTx:
+		return &AMMWithdraw{}, nil
+	case BatchTx:
+		return &Batch{}, nil
+	case CheckCancelTx:
+		return &CheckCancel{}, nil
+	case CheckCashTx:
+		return &CheckCash{}, nil
+	case CheckCreateTx:
+		return &CheckCreate{}, nil
+	case ClawbackTx:
+		return &Clawback{}, nil
+	case CredentialAcceptTx:
+		return &CredentialAccept{}, nil
+	case CredentialCreateTx:
+		return &CredentialCreate{}, nil
+	case CredentialDeleteTx:
+		return &CredentialDelete{}, nil
+	case DelegateSetTx:
+		return &DelegateSet{}, nil
+	case DepositPreauthTx:
+		return &DepositPreauth{}, nil
+	case DIDDeleteTx:
+		return &DIDDelete{}, nil
+	case DIDSetTx:
+		return &DIDSet{}, nil
+	case EscrowCancelTx:
+		return &EscrowCancel{}, nil
+	case EscrowCreateTx:
+		return &EscrowCreate{}, nil
+	case EscrowFinishTx:
+		return &EscrowFinish{}, nil
+	case MPTokenAuthorizeTx:
+		return &MPTokenAuthorize{}, nil
+	case MPTokenIssuanceCreateTx:
+		return &MPTokenIssuanceCreate{}, nil
+	case MPTokenIssuanceDestroyTx:
+		return &MPTokenIssuanceDestroy{}, nil
+	case MPTokenIssuanceSetTx:
+		return &MPTokenIssuanceSet{}, nil
+	case NFTokenAcceptOfferTx:
+		return &NFTokenAcceptOffer{}, nil
+	case NFTokenBurnTx:
+		return &NFTokenBurn{}, nil
+	case NFTokenCancelOfferTx:
+		return &NFTokenCancelOffer{}, nil
+	case NFTokenCreateOfferTx:
+		return &NFTokenCreateOffer{}, nil
+	case NFTokenMintTx:
+		return &NFTokenMint{}, nil
+	case NFTokenModifyTx:
+		return &NFTokenModify{}, nil
+	case OfferCreateTx:
+		return &OfferCreate{}, nil
+	case OfferCancelTx:
+		return &OfferCancel{}, nil
+	case OracleDeleteTx:
+		return &OracleDelete{}, nil
+	case OracleSetTx:
+		return &OracleSet{}, nil
+	case PaymentTx:
+		return &Payment{}, nil
+	case PaymentChannelClaimTx:
+		return &PaymentChannelClaim{}, nil
+	case PaymentChannelCreateTx:
+		return &PaymentChannelCreate{}, nil
+	case PaymentChannelFundTx:
+		return &PaymentChannelFund{}, nil
+	case PermissionedDomainDeleteTx:
+		return &PermissionedDomainDelete{}, nil
+	case PermissionedDomainSetTx:
+		return &PermissionedDomainSet{}, nil
+	case SetRegularKeyTx:
+		return &SetRegularKey{}, nil
+	case SignerListSetTx:
+		return &SignerListSet{}, nil
+	case TrustSetTx:
+		return &TrustSet{}, nil
+	case TicketCreateTx:
+		return &TicketCreate{}, nil
+	case XChainAccountCreateCommitTx:
+		return &XChainAccountCreateCommit{}, nil
+	case XChainAddAccountCreateAttestationTx:
+		return &XChainAddAccountCreateAttestation{}, nil
+	case XChainAddClaimAttestationTx:
+		return &XChainAddClaimAttestation{}, nil
+	case XChainCreateBridgeTx:
+		return &XChainCreateBridge{}, nil
+	case XChainCreateClaimIDTx:
+		return &XChainCreateClaimID{}, nil
+	case XChainClaimTx:
+		return &XChainClaim{}, nil
+	case XChainCommitTx:
+		return &XChainCommit{}, nil
+	case XChainModifyBridgeTx:
+		return &XChainModifyBridge{}, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnsupportedTransactionType, t)
+}
+
+// Unmarshal decodes a transaction in JSON format into the typed transaction of
+// its TransactionType, such as *Payment for a Payment.
+func Unmarshal(data []byte) (Tx, error) {
+	var h struct {
+		TransactionType TxType
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return nil, err
+	}
+	if h.TransactionType == "" {
+		return nil, ErrInvalidTransactionType
+	}
+	tx, err := EmptyTx(h.TransactionType)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, tx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// FromFlat converts a flat transaction, such as the tx_json of a response or the
+// result of binarycodec.Decode, into the typed transaction of its TransactionType.
+func FromFlat(flat FlatTransaction) (Tx, error) {
+	data, err := json.Marshal(flat)
+	if err != nil {
+		return nil, err
+	}
+	return Unmarshal(data)
+}
+
+// FromBlob decodes a hex encoded binary transaction into the typed transaction
+// of its TransactionType. The options are passed to binarycodec.Decode.
+func FromBlob(txBlob string, opts ...binarycodec.Option) (Tx, error) {
+	flat, err := binarycodec.Decode(txBlob, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return FromFlat(flat)
+}
+
+// unmarshalAmount decodes a currency amount in JSON format into dst. A missing
+// amount leaves dst nil.
+func unmarshalAmount(dst *types.CurrencyAmount, data json.RawMessage) error {
+	amount, err := types.UnmarshalCurrencyAmount(data)
+	if err != nil {
+		return err
+	}
+	*dst = amount
+	return nil
+}
+
+// flatFromJSON decodes a transaction in JSON format into a flat transaction whose
+// values have the Go types binarycodec.Decode returns for their fields, so that the
+// flat transaction can be encoded again.
+func flatFromJSON(data []byte) (FlatTransaction, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var flat map[string]any
+	if err := dec.Decode(&flat); err != nil {
+		return nil, err
+	}
+	if err := convertFields(flat); err != nil {
+		return nil, err
+	}
+	return flat, nil
+}
+
+// convertFields converts the values of the fields of an object, and of its nested
+// objects and arrays, to the Go types of their field types.
+func convertFields(object map[string]any) error {
+	for field, value := range object {
+		converted, err := convertField(field, value)
+		if err != nil {
+			return err
+		}
+		object[field] = converted
+	}
+	return nil
+}
+
+func convertField(field string, value any) (any, error) {
+	typeName, err := definitions.Get().GetTypeNameByFieldName(field)
+	if err != nil {
+		// Unknown fields, and the nested fields of amounts and path steps,
+		// are left as they are.
+		typeName = ""
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		return v, convertFields(v)
+	case []any:
+		if typeName == "Vector256" {
+			hashes := make([]string, len(v))
+			for i, item := range v {
+				hash, ok := item.(string)
+				if !ok {
+					return nil, fmt.Errorf("%w: %s", ErrInvalidVector256, field)
+				}
+				hashes[i] = hash
+			}
+			return hashes, nil
+		}
+		for i, item := range v {
+			converted, err := convertField(field, item)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = converted
+		}
+		return v, nil
+	case json.Number:
+		switch typeName {
+		case "UInt8":
+			n, err := strconv.ParseUint(v.String(), 10, 8)
+			return int(n), err
+		case "UInt16":
+			n, err := strconv.ParseUint(v.String(), 10, 16)
+			return int(n), err
+		case "UInt32":
+			n, err := strconv.ParseUint(v.String(), 10, 32)
+			return uint32(n), err
+		case "Int32":
+			n, err := strconv.ParseInt(v.String(), 10, 32)
+			return int32(n), err
+		}
+		return v, nil
+	default:
+		return v, nil
+	}
+}
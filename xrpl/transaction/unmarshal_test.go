@@ -0,0 +1,405 @@
+package transaction
+
+import (
+	"encoding/json"
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	unmarshalAccount     = "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"
+	unmarshalDestination = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	unmarshalIssuer      = "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH"
+	unmarshalHash        = "C1AE6DDDEEC05CF2978C0BAD6FE302948E9533691DC749DCDD3B9E5992CA6198"
+	unmarshalMPTID       = "000004C463C52827307480341125DA0577DEFC38405B0E3E"
+)
+
+func unmarshalBaseTx(txType TxType) BaseTx {
+	return BaseTx{
+		Account:         unmarshalAccount,
+		TransactionType: txType,
+		Fee:             types.XRPCurrencyAmount(12),
+		Sequence:        7,
+		Flags:           0,
+	}
+}
+
+func unmarshalUSD(value string) types.IssuedCurrencyAmount {
+	return types.IssuedCurrencyAmount{Currency: "USD", Issuer: unmarshalIssuer, Value: value}
+}
+
+func unmarshalBridge() types.XChainBridge {
+	return types.XChainBridge{
+		IssuingChainDoor:  unmarshalDestination,
+		IssuingChainIssue: unmarshalDestination,
+		LockingChainDoor:  unmarshalIssuer,
+		LockingChainIssue: unmarshalIssuer,
+	}
+}
+
+// flatten returns the flat form of a typed transaction.
+func flatten(t *testing.T, tx Tx) FlatTransaction {
+	t.Helper()
+	switch f := tx.(type) {
+	case interface{ Flatten() FlatTransaction }:
+		return f.Flatten()
+	case interface{ Flatten() map[string]any }:
+		return f.Flatten()
+	}
+	t.Fatalf("%T cannot be flattened", tx)
+	return nil
+}
+
+func unmarshalFixtures() []Tx {
+	tag := uint32(42)
+	scale := uint8(2)
+	fee := uint16(100)
+	domain := "6578616D706C652E636F6D"
+	holder := types.Address(unmarshalDestination)
+	metadata := "7B7D"
+	maximum := types.XRPCurrencyAmount(1000)
+
+	return []Tx{
+		&AccountSet{BaseTx: unmarshalBaseTx(AccountSetTx), SetFlag: 8, Domain: &domain, TickSize: &scale},
+		&AccountDelete{BaseTx: unmarshalBaseTx(AccountDeleteTx), Destination: unmarshalDestination, DestinationTag: 13},
+		&AMMBid{
+			BaseTx:       unmarshalBaseTx(AMMBidTx),
+			Asset:        ledger.Asset{Currency: "XRP"},
+			Asset2:       ledger.Asset{Currency: "USD", Issuer: unmarshalIssuer},
+			BidMin:       unmarshalUSD("1"),
+			BidMax:       unmarshalUSD("10"),
+			AuthAccounts: []ledger.AuthAccounts{{AuthAccount: ledger.AuthAccount{Account: unmarshalDestination}}},
+		},
+		&AMMClawback{
+			BaseTx: unmarshalBaseTx(AMMClawbackTx),
+			Holder: unmarshalDestination,
+			Asset:  types.IssuedCurrency{Currency: "USD", Issuer: unmarshalAccount},
+			Asset2: types.IssuedCurrencyAmount{Currency: "XRP"},
+			Amount: unmarshalUSD("5"),
+		},
+		&AMMCreate{BaseTx: unmarshalBaseTx(AMMCreateTx), Amount: types.XRPCurrencyAmount(1000), Amount2: unmarshalUSD("5"), TradingFee: 10},
+		&AMMDelete{BaseTx: unmarshalBaseTx(AMMDeleteTx), Asset: ledger.Asset{Currency: "XRP"}, Asset2: ledger.Asset{Currency: "USD", Issuer: unmarshalIssuer}},
+		&AMMDeposit{
+			BaseTx:     unmarshalBaseTx(AMMDepositTx),
+			Asset:      ledger.Asset{Currency: "XRP"},
+			Asset2:     ledger.Asset{Currency: "USD", Issuer: unmarshalIssuer},
+			Amount:     types.XRPCurrencyAmount(100),
+			EPrice:     types.XRPCurrencyAmount(3),
+			LPTokenOut: unmarshalUSD("2"),
+		},
+		&AMMVote{BaseTx: unmarshalBaseTx(AMMVoteTx), Asset: ledger.Asset{Currency: "XRP"}, Asset2: ledger.Asset{Currency: "USD", Issuer: unmarshalIssuer}, TradingFee: 20},
+		&AMMWithdraw{
+			BaseTx:    unmarshalBaseTx(AMMWithdrawTx),
+			Asset:     ledger.Asset{Currency: "XRP"},
+			Asset2:    ledger.Asset{Currency: "USD", Issuer: unmarshalIssuer},
+			Amount:    types.XRPCurrencyAmount(100),
+			Amount2:   unmarshalUSD("1"),
+			LPTokenIn: unmarshalUSD("3"),
+		},
+		&Batch{
+			BaseTx: unmarshalBaseTx(BatchTx),
+			RawTransactions: []types.RawTransaction{
+				{RawTransaction: map[string]any{
+					"TransactionType": "Payment",
+					"Account":         unmarshalAccount,
+					"Destination":     unmarshalDestination,
+					"Amount":          "1000",
+					"Flags":           types.TfInnerBatchTxn,
+					"Fee":             "0",
+					"Sequence":        uint32(8),
+					"SigningPubKey":   "",
+				}},
+			},
+			BatchSigners: []types.BatchSigner{{BatchSigner: types.BatchSignerData{Account: unmarshalDestination, SigningPubKey: "ABCD"}}},
+		},
+		&CheckCancel{BaseTx: unmarshalBaseTx(CheckCancelTx), CheckID: unmarshalHash},
+		&CheckCash{BaseTx: unmarshalBaseTx(CheckCashTx), CheckID: unmarshalHash, DeliverMin: unmarshalUSD("1.5")},
+		&CheckCreate{BaseTx: unmarshalBaseTx(CheckCreateTx), Destination: unmarshalDestination, SendMax: types.XRPCurrencyAmount(500), DestinationTag: &tag, Expiration: 100},
+		&Clawback{BaseTx: unmarshalBaseTx(ClawbackTx), Amount: types.MPTCurrencyAmount{MPTIssuanceID: unmarshalMPTID, Value: "10"}},
+		&CredentialAccept{BaseTx: unmarshalBaseTx(CredentialAcceptTx), Issuer: unmarshalIssuer, CredentialType: "4B5943"},
+		&CredentialCreate{BaseTx: unmarshalBaseTx(CredentialCreateTx), Subject: unmarshalDestination, CredentialType: "4B5943", Expiration: 10, URI: "AB"},
+		&CredentialDelete{BaseTx: unmarshalBaseTx(CredentialDeleteTx), CredentialType: "4B5943", Issuer: unmarshalIssuer},
+		&DelegateSet{
+			BaseTx:      unmarshalBaseTx(DelegateSetTx),
+			Authorize:   unmarshalDestination,
+			Permissions: []types.Permission{{Permission: types.PermissionValue{PermissionValue: "Payment"}}},
+		},
+		&DepositPreauth{
+			BaseTx: unmarshalBaseTx(DepositPreauthTx),
+			AuthorizeCredentials: []types.AuthorizeCredentialsWrapper{
+				{Credential: types.AuthorizeCredentials{Issuer: unmarshalIssuer, CredentialType: "4B5943"}},
+			},
+		},
+		&DIDDelete{BaseTx: unmarshalBaseTx(DIDDeleteTx)},
+		&DIDSet{BaseTx: unmarshalBaseTx(DIDSetTx), Data: "AB", URI: "CD"},
+		&EscrowCancel{BaseTx: unmarshalBaseTx(EscrowCancelTx), Owner: unmarshalIssuer, OfferSequence: 3},
+		&EscrowCreate{BaseTx: unmarshalBaseTx(EscrowCreateTx), Amount: 1000, Destination: unmarshalDestination, FinishAfter: 10, CancelAfter: 20, DestinationTag: &tag},
+		&EscrowFinish{BaseTx: unmarshalBaseTx(EscrowFinishTx), Owner: unmarshalIssuer, OfferSequence: 3, CredentialIDs: types.CredentialIDs{unmarshalHash}},
+		&MPTokenAuthorize{BaseTx: unmarshalBaseTx(MPTokenAuthorizeTx), MPTokenIssuanceID: unmarshalMPTID, Holder: &holder},
+		&MPTokenIssuanceCreate{BaseTx: unmarshalBaseTx(MPTokenIssuanceCreateTx), AssetScale: &scale, TransferFee: &fee, MaximumAmount: &maximum, MPTokenMetadata: &metadata},
+		&MPTokenIssuanceDestroy{BaseTx: unmarshalBaseTx(MPTokenIssuanceDestroyTx), MPTokenIssuanceID: unmarshalMPTID},
+		&MPTokenIssuanceSet{BaseTx: unmarshalBaseTx(MPTokenIssuanceSetTx), MPTokenIssuanceID: unmarshalMPTID, Holder: &holder},
+		&NFTokenAcceptOffer{BaseTx: unmarshalBaseTx(NFTokenAcceptOfferTx), NFTokenSellOffer: unmarshalHash, NFTokenBrokerFee: types.XRPCurrencyAmount(10)},
+		&NFTokenBurn{BaseTx: unmarshalBaseTx(NFTokenBurnTx), NFTokenID: unmarshalHash, Owner: unmarshalIssuer},
+		&NFTokenCancelOffer{BaseTx: unmarshalBaseTx(NFTokenCancelOfferTx), NFTokenOffers: []types.NFTokenID{unmarshalHash}},
+		&NFTokenCreateOffer{BaseTx: unmarshalBaseTx(NFTokenCreateOfferTx), NFTokenID: unmarshalHash, Amount: unmarshalUSD("1"), Owner: unmarshalIssuer},
+		&NFTokenMint{BaseTx: unmarshalBaseTx(NFTokenMintTx), NFTokenTaxon: 1, TransferFee: &fee, URI: "AB", Amount: types.XRPCurrencyAmount(5)},
+		&NFTokenModify{BaseTx: unmarshalBaseTx(NFTokenModifyTx), NFTokenID: unmarshalHash, URI: "AB"},
+		&OfferCancel{BaseTx: unmarshalBaseTx(OfferCancelTx), OfferSequence: 5},
+		&OfferCreate{BaseTx: unmarshalBaseTx(OfferCreateTx), TakerGets: types.XRPCurrencyAmount(100), TakerPays: unmarshalUSD("1"), Expiration: 50},
+		&OracleDelete{BaseTx: unmarshalBaseTx(OracleDeleteTx), OracleDocumentID: 1},
+		&OracleSet{
+			BaseTx:           unmarshalBaseTx(OracleSetTx),
+			OracleDocumentID: 1,
+			LastUpdatedTime:  1000,
+			Provider:         "AB",
+			PriceDataSeries:  []ledger.PriceData{{BaseAsset: "XRP", QuoteAsset: "USD", AssetPrice: 740, Scale: 3}},
+		},
+		&Payment{
+			BaseTx: BaseTx{
+				Account:         unmarshalAccount,
+				TransactionType: PaymentTx,
+				Fee:             types.XRPCurrencyAmount(12),
+				Sequence:        7,
+				Memos:           []types.MemoWrapper{{Memo: types.Memo{MemoData: "AB", MemoType: "CD"}}},
+				Signers: []types.Signer{
+					{SignerData: types.SignerData{Account: unmarshalIssuer, TxnSignature: "AB", SigningPubKey: "CD"}},
+				},
+			},
+			Amount:         unmarshalUSD("10"),
+			Destination:    unmarshalDestination,
+			DestinationTag: &tag,
+			Paths:          [][]PathStep{{{Currency: "USD", Issuer: unmarshalIssuer}}, {{Currency: "EUR", Issuer: unmarshalIssuer}}},
+			SendMax:        types.XRPCurrencyAmount(2000),
+		},
+		&PaymentChannelClaim{BaseTx: unmarshalBaseTx(PaymentChannelClaimTx), Channel: unmarshalHash, Balance: 10, Amount: 20, Signature: "AB", PublicKey: "CD"},
+		&PaymentChannelCreate{BaseTx: unmarshalBaseTx(PaymentChannelCreateTx), Amount: 1000, Destination: unmarshalDestination, SettleDelay: 60, PublicKey: "AB"},
+		&PaymentChannelFund{BaseTx: unmarshalBaseTx(PaymentChannelFundTx), Channel: unmarshalHash, Amount: 10, Expiration: 100},
+		&PermissionedDomainDelete{BaseTx: unmarshalBaseTx(PermissionedDomainDeleteTx), DomainID: unmarshalHash},
+		&PermissionedDomainSet{
+			BaseTx:              unmarshalBaseTx(PermissionedDomainSetTx),
+			AcceptedCredentials: types.AuthorizeCredentialList{{Credential: types.Credential{Issuer: unmarshalIssuer, CredentialType: "4B5943"}}},
+		},
+		&SetRegularKey{BaseTx: unmarshalBaseTx(SetRegularKeyTx), RegularKey: unmarshalDestination},
+		&SignerListSet{
+			BaseTx:        unmarshalBaseTx(SignerListSetTx),
+			SignerQuorum:  uint32(2),
+			SignerEntries: []ledger.SignerEntryWrapper{{SignerEntry: ledger.SignerEntry{Account: unmarshalDestination, SignerWeight: 2}}},
+		},
+		&TrustSet{BaseTx: unmarshalBaseTx(TrustSetTx), LimitAmount: unmarshalUSD("100"), QualityIn: 1},
+		&TicketCreate{BaseTx: unmarshalBaseTx(TicketCreateTx), TicketCount: 2},
+		&XChainAccountCreateCommit{BaseTx: unmarshalBaseTx(XChainAccountCreateCommitTx), Amount: types.XRPCurrencyAmount(10), Destination: unmarshalDestination, SignatureReward: types.XRPCurrencyAmount(1), XChainBridge: unmarshalBridge()},
+		&XChainAddAccountCreateAttestation{
+			BaseTx:                   unmarshalBaseTx(XChainAddAccountCreateAttestationTx),
+			Amount:                   types.XRPCurrencyAmount(10),
+			AttestationRewardAccount: unmarshalIssuer,
+			AttestationSignerAccount: unmarshalIssuer,
+			Destination:              unmarshalDestination,
+			OtherChainSource:         unmarshalIssuer,
+			PublicKey:                "AB",
+			Signature:                "CD",
+			SignatureReward:          types.XRPCurrencyAmount(1),
+			WasLockingChainSend:      1,
+			XChainAccountCreateCount: "0000000000000001",
+			XChainBridge:             unmarshalBridge(),
+		},
+		&XChainAddClaimAttestation{
+			BaseTx:                   unmarshalBaseTx(XChainAddClaimAttestationTx),
+			Amount:                   types.XRPCurrencyAmount(10),
+			AttestationRewardAccount: unmarshalIssuer,
+			AttestationSignerAccount: unmarshalIssuer,
+			OtherChainSource:         unmarshalIssuer,
+			PublicKey:                "AB",
+			Signature:                "CD",
+			WasLockingChainSend:      1,
+			XChainBridge:             unmarshalBridge(),
+			XChainClaimID:            "0000000000000001",
+		},
+		&XChainCreateBridge{BaseTx: unmarshalBaseTx(XChainCreateBridgeTx), MinAccountCreateAmount: types.XRPCurrencyAmount(10), SignatureReward: types.XRPCurrencyAmount(1), XChainBridge: unmarshalBridge()},
+		&XChainCreateClaimID{BaseTx: unmarshalBaseTx(XChainCreateClaimIDTx), OtherChainSource: unmarshalIssuer, SignatureReward: types.XRPCurrencyAmount(1), XChainBridge: unmarshalBridge()},
+		&XChainClaim{BaseTx: unmarshalBaseTx(XChainClaimTx), Amount: types.XRPCurrencyAmount(10), Destination: unmarshalDestination, XChainBridge: unmarshalBridge(), XChainClaimID: "0000000000000001"},
+		&XChainCommit{BaseTx: unmarshalBaseTx(XChainCommitTx), Amount: types.XRPCurrencyAmount(10), XChainBridge: unmarshalBridge(), XChainClaimID: "0000000000000001"},
+		&XChainModifyBridge{BaseTx: unmarshalBaseTx(XChainModifyBridgeTx), MinAccountCreateAmount: types.XRPCurrencyAmount(10), SignatureReward: types.XRPCurrencyAmount(1), XChainBridge: unmarshalBridge()},
+	}
+}
+
+func TestFromFlat(t *testing.T) {
+	for _, tx := range unmarshalFixtures() {
+		t.Run(tx.TxType().String(), func(t *testing.T) {
+			got, err := FromFlat(flatten(t, tx))
+			require.NoError(t, err)
+			require.Equal(t, tx, got)
+		})
+	}
+}
+
+func TestFromFlat_EveryType(t *testing.T) {
+	covered := make(map[TxType]bool)
+	for _, tx := range unmarshalFixtures() {
+		covered[tx.TxType()] = true
+	}
+	for _, tx := range unmarshalFixtures() {
+		empty, err := EmptyTx(tx.TxType())
+		require.NoError(t, err)
+		require.IsType(t, tx, empty)
+	}
+	// Every transaction type but the HASH and BINARY placeholders has a typed transaction.
+	require.Len(t, covered, 56)
+}
+
+func TestFromFlat_Errors(t *testing.T) {
+	testcases := []struct {
+		name        string
+		flat        FlatTransaction
+		expectedErr error
+	}{
+		{
+			name:        "fail - missing TransactionType",
+			flat:        FlatTransaction{"Account": unmarshalAccount},
+			expectedErr: ErrInvalidTransactionType,
+		},
+		{
+			name:        "fail - unsupported TransactionType",
+			flat:        FlatTransaction{"TransactionType": "Unknown"},
+			expectedErr: ErrUnsupportedTransactionType,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := FromFlat(tc.flat)
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+
+	t.Run("fail - invalid currency amount", func(t *testing.T) {
+		_, err := FromFlat(FlatTransaction{"TransactionType": "Payment", "Amount": "abc"})
+		require.Error(t, err)
+	})
+}
+
+func TestUnmarshal(t *testing.T) {
+	tx, err := Unmarshal([]byte(`{
+		"TransactionType": "OfferCreate",
+		"Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"Fee": "12",
+		"Flags": 524288,
+		"Sequence": 7,
+		"TakerGets": "100",
+		"TakerPays": {"currency": "USD", "issuer": "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH", "value": "1"},
+		"hash": "C1AE6DDDEEC05CF2978C0BAD6FE302948E9533691DC749DCDD3B9E5992CA6198"
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, &OfferCreate{
+		BaseTx: BaseTx{
+			Account:         unmarshalAccount,
+			TransactionType: OfferCreateTx,
+			Fee:             types.XRPCurrencyAmount(12),
+			Flags:           tfSell,
+			Sequence:        7,
+		},
+		TakerGets: types.XRPCurrencyAmount(100),
+		TakerPays: unmarshalUSD("1"),
+	}, tx)
+}
+
+func TestUnmarshal_OracleSet(t *testing.T) {
+	tx, err := Unmarshal([]byte(`{
+		"TransactionType": "OracleSet",
+		"Account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+		"OracleDocumentID": 1,
+		"LastUpdateTime": 743609414,
+		"PriceDataSeries": [
+			{"PriceData": {"BaseAsset": "XRP", "QuoteAsset": "USD", "AssetPrice": "2e4", "Scale": 3}},
+			{"BaseAsset": "BTC", "QuoteAsset": "USD", "AssetPrice": 100, "Scale": 1}
+		]
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, []ledger.PriceData{
+		{BaseAsset: "XRP", QuoteAsset: "USD", AssetPrice: 740, Scale: 3},
+		{BaseAsset: "BTC", QuoteAsset: "USD", AssetPrice: 100, Scale: 1},
+	}, tx.(*OracleSet).PriceDataSeries)
+
+	_, err = Unmarshal([]byte(`{"TransactionType": "OracleSet", "PriceDataSeries": [{"AssetPrice": "xyz"}]}`))
+	require.Error(t, err)
+}
+
+func TestFromBlob(t *testing.T) {
+	txs := unmarshalFixtures()
+	txs = append(txs, &Payment{
+		BaseTx: BaseTx{
+			Account:         unmarshalAccount,
+			TransactionType: PaymentTx,
+			Fee:             types.XRPCurrencyAmount(12),
+			Sequence:        7,
+			Memos:           []types.MemoWrapper{{Memo: types.Memo{MemoData: "AB", MemoType: "CD"}}},
+			Signers: []types.Signer{
+				{SignerData: types.SignerData{Account: unmarshalIssuer, TxnSignature: "AB", SigningPubKey: "CD"}},
+			},
+		},
+		Amount:      unmarshalUSD("10"),
+		Destination: unmarshalDestination,
+		SendMax:     types.XRPCurrencyAmount(2000),
+		Paths: [][]PathStep{
+			{{Currency: "USD", Issuer: unmarshalIssuer}},
+			{{Account: unmarshalIssuer}, {Currency: "USD", Issuer: unmarshalIssuer}},
+			{{Account: unmarshalIssuer}, {Currency: "XRP"}},
+		},
+	})
+
+	for _, tx := range txs {
+		t.Run(tx.TxType().String(), func(t *testing.T) {
+			// Go through JSON, so the values have the types the binary codec expects.
+			data, err := json.Marshal(flatten(t, tx))
+			require.NoError(t, err)
+			flat, err := flatFromJSON(data)
+			require.NoError(t, err)
+			blob, err := binarycodec.Encode(flat)
+			require.NoError(t, err)
+
+			got, err := FromBlob(blob)
+			require.NoError(t, err)
+			require.Equal(t, tx, got)
+		})
+	}
+}
+
+func TestBatch_InnerTransactions(t *testing.T) {
+	batch, err := FromFlat(flatten(t, unmarshalFixtures()[9]))
+	require.NoError(t, err)
+	require.IsType(t, &Batch{}, batch)
+
+	valid, err := batch.(*Batch).Validate()
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	inner, err := batch.(*Batch).InnerTransactions()
+	require.NoError(t, err)
+	require.Equal(t, []Tx{&Payment{
+		BaseTx: BaseTx{
+			Account:         unmarshalAccount,
+			TransactionType: PaymentTx,
+			Flags:           types.TfInnerBatchTxn,
+			Sequence:        8,
+		},
+		Amount:      types.XRPCurrencyAmount(1000),
+		Destination: unmarshalDestination,
+	}}, inner)
+}
+
+func TestFromBlob_MPTAmountsInBase10(t *testing.T) {
+	maximum := types.XRPCurrencyAmount(1000)
+	tx := &MPTokenIssuanceCreate{BaseTx: unmarshalBaseTx(MPTokenIssuanceCreateTx), MaximumAmount: &maximum}
+
+	blob, err := binarycodec.Encode(tx.Flatten())
+	require.NoError(t, err)
+	// MaximumAmount (UInt64 24) holds 1000, not 0x1000.
+	require.Contains(t, blob, "301800000000000003E8")
+
+	got, err := FromBlob(blob)
+	require.NoError(t, err)
+	require.Equal(t, tx, got)
+}
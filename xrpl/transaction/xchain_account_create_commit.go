@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -77,6 +78,27 @@ func (x *XChainAccountCreateCommit) Flatten() FlatTransaction {
 	return flatTx
 }
 
+// UnmarshalJSON unmarshals the XChainAccountCreateCommit transaction from JSON, decoding its currency amounts.
+func (x *XChainAccountCreateCommit) UnmarshalJSON(data []byte) error {
+	type xchainAccountCreateCommitAlias XChainAccountCreateCommit
+	var h struct {
+		xchainAccountCreateCommitAlias
+		Amount          json.RawMessage
+		SignatureReward json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*x = XChainAccountCreateCommit(h.xchainAccountCreateCommitAlias)
+	if err := unmarshalAmount(&x.Amount, h.Amount); err != nil {
+		return err
+	}
+	if err := unmarshalAmount(&x.SignatureReward, h.SignatureReward); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validates the transaction.
 func (x *XChainAccountCreateCommit) Validate() (bool, error) {
 	_, err := x.BaseTx.Validate()
@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"errors"
 	"strconv"
 
@@ -141,6 +142,27 @@ func (x *XChainAddAccountCreateAttestation) Flatten() FlatTransaction {
 	return flatTx
 }
 
+// UnmarshalJSON unmarshals the XChainAddAccountCreateAttestation transaction from JSON, decoding its currency amounts.
+func (x *XChainAddAccountCreateAttestation) UnmarshalJSON(data []byte) error {
+	type xchainAddAccountCreateAttestationAlias XChainAddAccountCreateAttestation
+	var h struct {
+		xchainAddAccountCreateAttestationAlias
+		Amount          json.RawMessage
+		SignatureReward json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*x = XChainAddAccountCreateAttestation(h.xchainAddAccountCreateAttestationAlias)
+	if err := unmarshalAmount(&x.Amount, h.Amount); err != nil {
+		return err
+	}
+	if err := unmarshalAmount(&x.SignatureReward, h.SignatureReward); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validates the transaction.
 func (x *XChainAddAccountCreateAttestation) Validate() (bool, error) {
 	_, err := x.BaseTx.Validate()
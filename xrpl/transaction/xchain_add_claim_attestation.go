@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"errors"
 
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
@@ -150,6 +151,23 @@ func (x *XChainAddClaimAttestation) Flatten() FlatTransaction {
 	return flatTx
 }
 
+// UnmarshalJSON unmarshals the XChainAddClaimAttestation transaction from JSON, decoding its currency amounts.
+func (x *XChainAddClaimAttestation) UnmarshalJSON(data []byte) error {
+	type xchainAddClaimAttestationAlias XChainAddClaimAttestation
+	var h struct {
+		xchainAddClaimAttestationAlias
+		Amount json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*x = XChainAddClaimAttestation(h.xchainAddClaimAttestationAlias)
+	if err := unmarshalAmount(&x.Amount, h.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validates the transaction.
 func (x *XChainAddClaimAttestation) Validate() (bool, error) {
 	_, err := x.BaseTx.Validate()
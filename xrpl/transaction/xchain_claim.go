@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"errors"
 
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
@@ -97,6 +98,23 @@ func (x *XChainClaim) Flatten() FlatTransaction {
 	return flatTx
 }
 
+// UnmarshalJSON unmarshals the XChainClaim transaction from JSON, decoding its currency amounts.
+func (x *XChainClaim) UnmarshalJSON(data []byte) error {
+	type xchainClaimAlias XChainClaim
+	var h struct {
+		xchainClaimAlias
+		Amount json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*x = XChainClaim(h.xchainClaimAlias)
+	if err := unmarshalAmount(&x.Amount, h.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validate validates the transaction.
 func (x *XChainClaim) Validate() (bool, error) {
 	_, err := x.BaseTx.Validate()
@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"github.com/Peersyst/xrpl-go/pkg/typecheck"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -80,6 +81,23 @@ func (x *XChainCommit) Flatten() FlatTransaction {
 	return flatTx
 }
 
+// UnmarshalJSON unmarshals the XChainCommit transaction from JSON, decoding its currency amounts.
+func (x *XChainCommit) UnmarshalJSON(data []byte) error {
+	type xchainCommitAlias XChainCommit
+	var h struct {
+		xchainCommitAlias
+		Amount json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*x = XChainCommit(h.xchainCommitAlias)
+	if err := unmarshalAmount(&x.Amount, h.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validates the transaction.
 func (x *XChainCommit) Validate() (bool, error) {
 	_, err := x.BaseTx.Validate()
@@ -1,6 +1,10 @@
 package transaction
 
-import "github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+import (
+	"encoding/json"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
 
 // (Requires the XChainBridge amendment )
 //
@@ -71,6 +75,27 @@ func (x *XChainCreateBridge) Flatten() FlatTransaction {
 	return flatTx
 }
 
+// UnmarshalJSON unmarshals the XChainCreateBridge transaction from JSON, decoding its currency amounts.
+func (x *XChainCreateBridge) UnmarshalJSON(data []byte) error {
+	type xchainCreateBridgeAlias XChainCreateBridge
+	var h struct {
+		xchainCreateBridgeAlias
+		MinAccountCreateAmount json.RawMessage
+		SignatureReward        json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*x = XChainCreateBridge(h.xchainCreateBridgeAlias)
+	if err := unmarshalAmount(&x.MinAccountCreateAmount, h.MinAccountCreateAmount); err != nil {
+		return err
+	}
+	if err := unmarshalAmount(&x.SignatureReward, h.SignatureReward); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validates the transaction.
 func (x *XChainCreateBridge) Validate() (bool, error) {
 	_, err := x.BaseTx.Validate()
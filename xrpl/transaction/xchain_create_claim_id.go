@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -73,6 +74,23 @@ func (x *XChainCreateClaimID) Flatten() FlatTransaction {
 	return flatTx
 }
 
+// UnmarshalJSON unmarshals the XChainCreateClaimID transaction from JSON, decoding its currency amounts.
+func (x *XChainCreateClaimID) UnmarshalJSON(data []byte) error {
+	type xchainCreateClaimIDAlias XChainCreateClaimID
+	var h struct {
+		xchainCreateClaimIDAlias
+		SignatureReward json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*x = XChainCreateClaimID(h.xchainCreateClaimIDAlias)
+	if err := unmarshalAmount(&x.SignatureReward, h.SignatureReward); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validates the transaction.
 func (x *XChainCreateClaimID) Validate() (bool, error) {
 	_, err := x.BaseTx.Validate()
@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"encoding/json"
 	"errors"
 
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
@@ -96,6 +97,27 @@ func (x *XChainModifyBridge) Flatten() FlatTransaction {
 	return flatTx
 }
 
+// UnmarshalJSON unmarshals the XChainModifyBridge transaction from JSON, decoding its currency amounts.
+func (x *XChainModifyBridge) UnmarshalJSON(data []byte) error {
+	type xchainModifyBridgeAlias XChainModifyBridge
+	var h struct {
+		xchainModifyBridgeAlias
+		MinAccountCreateAmount json.RawMessage
+		SignatureReward        json.RawMessage
+	}
+	if err := json.Unmarshal(data, &h); err != nil {
+		return err
+	}
+	*x = XChainModifyBridge(h.xchainModifyBridgeAlias)
+	if err := unmarshalAmount(&x.MinAccountCreateAmount, h.MinAccountCreateAmount); err != nil {
+		return err
+	}
+	if err := unmarshalAmount(&x.SignatureReward, h.SignatureReward); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Validates the transaction.
 func (x *XChainModifyBridge) Validate() (bool, error) {
 	_, err := x.BaseTx.Validate()
@@ -0,0 +1,15 @@
+package unl
+
+import "errors"
+
+var (
+	ErrInvalidList              = errors.New("invalid validator list")
+	ErrUnsupportedVersion       = errors.New("unsupported validator list version")
+	ErrUntrustedPublisher       = errors.New("validator list is not published by the trusted publisher key")
+	ErrPublisherRevoked         = errors.New("publisher manifest revokes its master key")
+	ErrInvalidSignature         = errors.New("validator list signature does not match the publisher signing key")
+	ErrInvalidValidatorManifest = errors.New("invalid validator manifest")
+	ErrExpired                  = errors.New("validator list has expired")
+	ErrNotYetEffective          = errors.New("validator list is not effective yet")
+	ErrUnexpectedStatus         = errors.New("unexpected status code")
+)
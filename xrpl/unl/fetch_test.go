@@ -0,0 +1,49 @@
+package unl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetch(t *testing.T) {
+	k := newTestKeys(t)
+	data := k.publicationV1(t, signedBlobFor(t, k.publisherEphemeral, 3, time.Time{}, time.Now().Add(time.Hour), k.validators(t)))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	l, err := Fetch(context.Background(), server.URL, k.publisher.public)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), l.Sequence)
+
+	_, err = Fetch(context.Background(), server.URL+"/missing", k.publisher.public)
+	require.ErrorIs(t, err, ErrUnexpectedStatus)
+}
+
+func TestLoad(t *testing.T) {
+	k := newTestKeys(t)
+	data := k.publicationV1(t, signedBlobFor(t, k.publisherEphemeral, 4, time.Time{}, time.Now().Add(time.Hour), k.validators(t)))
+
+	path := filepath.Join(t.TempDir(), "vl.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	l, err := Load(path, k.publisher.public)
+	require.NoError(t, err)
+	require.Equal(t, uint32(4), l.Sequence)
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing.json"), k.publisher.public)
+	require.Error(t, err)
+}
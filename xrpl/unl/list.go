@@ -0,0 +1,267 @@
+package unl
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	xrpltime "github.com/Peersyst/xrpl-go/xrpl/time"
+	"github.com/Peersyst/xrpl-go/xrpl/validator"
+)
+
+const (
+	// Lists with a single blob, signed by the publisher.
+	version1 uint32 = 1
+	// Lists with several blobs, each with its own effective date, so a
+	// publisher can announce the next list before the current one expires.
+	version2 uint32 = 2
+)
+
+// Validator is a validator trusted by a list.
+type Validator struct {
+	// The master public key of the validator, as a hex string.
+	PublicKey string
+	// The master public key in the base58 node public key format.
+	NodePublicKey string
+	// The current manifest of the validator. Nil if the list does not include it,
+	// or if the one it includes is invalid.
+	Manifest *validator.Manifest
+	// Why the manifest included by the list was rejected, wrapping
+	// ErrInvalidValidatorManifest. Nil if the manifest is valid or missing.
+	ManifestErr error
+}
+
+// List is a verified validator list.
+type List struct {
+	// The master public key of the publisher, as a hex string.
+	PublisherKey string
+	// The manifest of the publisher, whose ephemeral key signed the list.
+	PublisherManifest *validator.Manifest
+	Sequence          uint32
+	// The time from which the list applies. Zero if the list applies as soon as it is published.
+	Effective  time.Time
+	Expiration time.Time
+	Validators []Validator
+}
+
+// Parse parses and verifies a validator list in the JSON format served by
+// publishers such as vl.ripple.com. publisherKey is the hex master public key
+// of the trusted publisher. The list must be effective and not expired now.
+func Parse(data []byte, publisherKey string) (*List, error) {
+	return ParseAt(data, publisherKey, time.Now())
+}
+
+// ParseAt parses and verifies a validator list as Parse does, checking the
+// effective and expiration dates against now. When a version 2 list holds
+// several blobs, the one with the highest sequence that applies at now is used.
+func ParseAt(data []byte, publisherKey string, now time.Time) (*List, error) {
+	var p publication
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidList, err)
+	}
+	if publisherKey == "" || !strings.EqualFold(p.PublicKey, publisherKey) {
+		return nil, ErrUntrustedPublisher
+	}
+
+	var blobs []signedBlob
+	switch p.Version {
+	case version1:
+		blobs = []signedBlob{{Blob: p.Blob, Signature: p.Signature}}
+	case version2:
+		blobs = p.BlobsV2
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, p.Version)
+	}
+	if len(blobs) == 0 {
+		return nil, fmt.Errorf("%w: no blobs", ErrInvalidList)
+	}
+
+	var (
+		current *List
+		future  bool
+	)
+	for _, b := range blobs {
+		manifest := p.Manifest
+		if b.Manifest != "" {
+			manifest = b.Manifest
+		}
+		l, err := parseBlob(b, manifest, publisherKey)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case !now.Before(l.Expiration):
+			continue
+		case !l.Effective.IsZero() && now.Before(l.Effective):
+			future = true
+		case current == nil || l.Sequence > current.Sequence:
+			current = l
+		}
+	}
+
+	switch {
+	case current != nil:
+		return current, nil
+	case future:
+		return nil, ErrNotYetEffective
+	default:
+		return nil, ErrExpired
+	}
+}
+
+// ValidatorByKey returns the validator of the list whose master or current
+// ephemeral public key, as a hex string, is key. Validations are signed by the
+// ephemeral key. Validators whose manifest revokes their master key are never returned.
+func (l *List) ValidatorByKey(key string) (*Validator, bool) {
+	for i := range l.Validators {
+		v := &l.Validators[i]
+		if v.Manifest != nil && v.Manifest.Revoked() {
+			continue
+		}
+		if strings.EqualFold(v.PublicKey, key) {
+			return v, true
+		}
+		if v.Manifest != nil && strings.EqualFold(v.Manifest.SigningPubKey, key) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// Keys returns the master public keys of the validators of the list in the
+// base58 node public key format.
+func (l *List) Keys() []string {
+	keys := make([]string, 0, len(l.Validators))
+	for _, v := range l.Validators {
+		keys = append(keys, v.NodePublicKey)
+	}
+	return keys
+}
+
+// Quorum returns the number of validators of the list that must validate a
+// ledger for it to be considered final, 80% of the list rounded up.
+func (l *List) Quorum() int {
+	return (len(l.Validators)*4 + 4) / 5
+}
+
+// ############################################################################
+// Decoding
+// ############################################################################
+
+type publication struct {
+	PublicKey string       `json:"public_key"`
+	Manifest  string       `json:"manifest"`
+	Blob      string       `json:"blob"`
+	Signature string       `json:"signature"`
+	Version   uint32       `json:"version"`
+	BlobsV2   []signedBlob `json:"blobs_v2"`
+}
+
+type signedBlob struct {
+	Blob      string `json:"blob"`
+	Signature string `json:"signature"`
+	// Overrides the manifest of the publication when set.
+	Manifest string `json:"manifest,omitempty"`
+}
+
+type blob struct {
+	Sequence   uint32 `json:"sequence"`
+	Effective  int64  `json:"effective"`
+	Expiration int64  `json:"expiration"`
+	Validators []struct {
+		ValidationPublicKey string `json:"validation_public_key"`
+		Manifest            string `json:"manifest"`
+	} `json:"validators"`
+}
+
+// parseBlob verifies the manifest of the publisher and the signature of a
+// blob, and decodes it.
+func parseBlob(b signedBlob, manifest, publisherKey string) (*List, error) {
+	m, err := decodeManifest(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: publisher manifest: %w", ErrInvalidList, err)
+	}
+	if !strings.EqualFold(m.PublicKey, publisherKey) {
+		return nil, ErrUntrustedPublisher
+	}
+	if m.Revoked() {
+		return nil, ErrPublisherRevoked
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(b.Blob)
+	if err != nil {
+		return nil, fmt.Errorf("%w: blob: %w", ErrInvalidList, err)
+	}
+	if err := m.VerifySignature(raw, b.Signature); err != nil {
+		return nil, ErrInvalidSignature
+	}
+
+	var decoded blob
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, fmt.Errorf("%w: blob: %w", ErrInvalidList, err)
+	}
+
+	l := &List{
+		PublisherKey:      strings.ToUpper(publisherKey),
+		PublisherManifest: m,
+		Sequence:          decoded.Sequence,
+		Expiration:        rippleTime(decoded.Expiration),
+		Validators:        make([]Validator, 0, len(decoded.Validators)),
+	}
+	if decoded.Effective != 0 {
+		l.Effective = rippleTime(decoded.Effective)
+	}
+
+	for _, v := range decoded.Validators {
+		key, err := hex.DecodeString(v.ValidationPublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w: validation_public_key: %w", ErrInvalidList, err)
+		}
+		nodeKey, err := addresscodec.EncodeNodePublicKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("%w: validation_public_key: %w", ErrInvalidList, err)
+		}
+
+		val := Validator{PublicKey: strings.ToUpper(v.ValidationPublicKey), NodePublicKey: nodeKey}
+		if v.Manifest != "" {
+			// A bad manifest only affects its validator, whose master key the
+			// publisher vouches for anyway, so the rest of the list is kept.
+			vm, err := decodeManifest(v.Manifest)
+			switch {
+			case err != nil:
+				val.ManifestErr = fmt.Errorf("%w: %s: %w", ErrInvalidValidatorManifest, nodeKey, err)
+			case !strings.EqualFold(vm.PublicKey, v.ValidationPublicKey):
+				val.ManifestErr = fmt.Errorf("%w: %s: master key mismatch", ErrInvalidValidatorManifest, nodeKey)
+			default:
+				val.Manifest = vm
+			}
+		}
+		l.Validators = append(l.Validators, val)
+	}
+	return l, nil
+}
+
+// decodeManifest decodes and verifies a base64 encoded manifest.
+func decodeManifest(manifest string) (*validator.Manifest, error) {
+	raw, err := base64.StdEncoding.DecodeString(manifest)
+	if err != nil {
+		return nil, err
+	}
+	m, err := validator.DecodeManifest(hex.EncodeToString(raw))
+	if err != nil {
+		return nil, err
+	}
+	if err := m.Verify(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func rippleTime(seconds int64) time.Time {
+	return time.UnixMilli(xrpltime.RippleTimeToUnixTime(seconds)).UTC()
+}
@@ -0,0 +1,357 @@
+package unl
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+	xrpltime "github.com/Peersyst/xrpl-go/xrpl/time"
+	"github.com/stretchr/testify/require"
+)
+
+type keypair struct {
+	private, public string
+}
+
+func derive(t *testing.T, seed string) keypair {
+	t.Helper()
+	private, public, err := keypairs.DeriveKeypair(seed, false)
+	require.NoError(t, err)
+	return keypair{private: private, public: public}
+}
+
+type testKeys struct {
+	publisher, publisherEphemeral keypair
+	validator, validatorEphemeral keypair
+	validator2, attacker          keypair
+}
+
+func newTestKeys(t *testing.T) testKeys {
+	return testKeys{
+		publisher:          derive(t, "sEdTM1uX8pu2do5XvTnutH6HsouMaM2"),
+		publisherEphemeral: derive(t, "sp5fghtJtpUorTwvof1NpDXAzNwf5"),
+		validator:          derive(t, "sEd7HmQFsoyj5TAm6d98gytM9LJA1MF"),
+		validatorEphemeral: derive(t, "snoPBrXtMeMyMHUVTgbuqAfg1SUTb"),
+		validator2:         derive(t, "sEdTCFHBquP36KursdZ17ZiuZenJZHg"),
+		attacker:           derive(t, "sEdTvLVDRVJsrUyBiCPTHDs46GUKQAr"),
+	}
+}
+
+// manifest returns a base64 manifest binding master to ephemeral. A zero
+// ephemeral key makes a revocation.
+func manifest(t *testing.T, master, ephemeral keypair, sequence uint32) string {
+	t.Helper()
+	fields := map[string]any{"PublicKey": master.public, "Sequence": sequence}
+	if ephemeral.public != "" {
+		fields["SigningPubKey"] = ephemeral.public
+	}
+	encoded, err := binarycodec.Encode(fields)
+	require.NoError(t, err)
+	b, err := hex.DecodeString(encoded)
+	require.NoError(t, err)
+	data := string(append(binary.BigEndian.AppendUint32(nil, hash.ManifestPrefix), b...))
+
+	fields["MasterSignature"], err = keypairs.Sign(data, master.private)
+	require.NoError(t, err)
+	if ephemeral.public != "" {
+		fields["Signature"], err = keypairs.Sign(data, ephemeral.private)
+		require.NoError(t, err)
+	}
+	encoded, err = binarycodec.Encode(fields)
+	require.NoError(t, err)
+	raw, err := hex.DecodeString(encoded)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(raw)
+}
+
+func rippleSeconds(tm time.Time) int64 {
+	return xrpltime.UnixTimeToRippleTime(tm.Unix())
+}
+
+// signedBlobFor encodes a blob with the given validators and signs it with key.
+func signedBlobFor(t *testing.T, key keypair, sequence uint32, effective, expiration time.Time, validators []map[string]string) signedBlob {
+	t.Helper()
+	b := map[string]any{
+		"sequence":   sequence,
+		"expiration": rippleSeconds(expiration),
+		"validators": validators,
+	}
+	if !effective.IsZero() {
+		b["effective"] = rippleSeconds(effective)
+	}
+	raw, err := json.Marshal(b)
+	require.NoError(t, err)
+	sig, err := keypairs.Sign(string(raw), key.private)
+	require.NoError(t, err)
+	return signedBlob{Blob: base64.StdEncoding.EncodeToString(raw), Signature: sig}
+}
+
+func (k testKeys) validators(t *testing.T) []map[string]string {
+	return []map[string]string{
+		{
+			"validation_public_key": k.validator.public,
+			"manifest":              manifest(t, k.validator, k.validatorEphemeral, 1),
+		},
+		{"validation_public_key": k.validator2.public},
+	}
+}
+
+func (k testKeys) publicationV1(t *testing.T, b signedBlob) []byte {
+	t.Helper()
+	data, err := json.Marshal(publication{
+		PublicKey: k.publisher.public,
+		Manifest:  manifest(t, k.publisher, k.publisherEphemeral, 1),
+		Blob:      b.Blob,
+		Signature: b.Signature,
+		Version:   version1,
+	})
+	require.NoError(t, err)
+	return data
+}
+
+func TestParse(t *testing.T) {
+	k := newTestKeys(t)
+	now := time.Now()
+	data := k.publicationV1(t, signedBlobFor(t, k.publisherEphemeral, 7, time.Time{}, now.Add(time.Hour), k.validators(t)))
+
+	l, err := Parse(data, k.publisher.public)
+	require.NoError(t, err)
+
+	require.Equal(t, k.publisher.public, l.PublisherKey)
+	require.Equal(t, k.publisherEphemeral.public, l.PublisherManifest.SigningPubKey)
+	require.Equal(t, uint32(7), l.Sequence)
+	require.True(t, l.Effective.IsZero())
+	require.Equal(t, now.Add(time.Hour).Unix(), l.Expiration.Unix())
+	require.Len(t, l.Validators, 2)
+	require.Equal(t, k.validator.public, l.Validators[0].PublicKey)
+	require.Equal(t, k.validatorEphemeral.public, l.Validators[0].Manifest.SigningPubKey)
+	require.Nil(t, l.Validators[1].Manifest)
+	require.Equal(t, 2, l.Quorum())
+
+	keys := l.Keys()
+	require.Len(t, keys, 2)
+	for _, key := range keys {
+		require.Equal(t, "n", key[:1])
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	k := newTestKeys(t)
+	now := time.Now()
+	valid := signedBlobFor(t, k.publisherEphemeral, 1, time.Time{}, now.Add(time.Hour), k.validators(t))
+
+	tests := []struct {
+		name         string
+		data         func(t *testing.T) []byte
+		publisherKey string
+		err          error
+	}{
+		{
+			name:         "fail - not JSON",
+			data:         func(*testing.T) []byte { return []byte("<html>") },
+			publisherKey: k.publisher.public,
+			err:          ErrInvalidList,
+		},
+		{
+			name:         "fail - other publisher",
+			data:         func(t *testing.T) []byte { return k.publicationV1(t, valid) },
+			publisherKey: k.attacker.public,
+			err:          ErrUntrustedPublisher,
+		},
+		{
+			name: "fail - manifest of another master key",
+			data: func(t *testing.T) []byte {
+				data, err := json.Marshal(publication{
+					PublicKey: k.publisher.public,
+					Manifest:  manifest(t, k.attacker, k.publisherEphemeral, 1),
+					Blob:      valid.Blob,
+					Signature: valid.Signature,
+					Version:   version1,
+				})
+				require.NoError(t, err)
+				return data
+			},
+			publisherKey: k.publisher.public,
+			err:          ErrUntrustedPublisher,
+		},
+		{
+			name: "fail - revoked publisher",
+			data: func(t *testing.T) []byte {
+				data, err := json.Marshal(publication{
+					PublicKey: k.publisher.public,
+					Manifest:  manifest(t, k.publisher, keypair{}, 0xFFFFFFFF),
+					Blob:      valid.Blob,
+					Signature: valid.Signature,
+					Version:   version1,
+				})
+				require.NoError(t, err)
+				return data
+			},
+			publisherKey: k.publisher.public,
+			err:          ErrPublisherRevoked,
+		},
+		{
+			name: "fail - blob signed by another key",
+			data: func(t *testing.T) []byte {
+				return k.publicationV1(t, signedBlobFor(t, k.attacker, 1, time.Time{}, now.Add(time.Hour), k.validators(t)))
+			},
+			publisherKey: k.publisher.public,
+			err:          ErrInvalidSignature,
+		},
+		{
+			name: "fail - tampered blob",
+			data: func(t *testing.T) []byte {
+				tampered := signedBlobFor(t, k.publisherEphemeral, 2, time.Time{}, now.Add(time.Hour), k.validators(t)[:1])
+				tampered.Signature = valid.Signature
+				return k.publicationV1(t, tampered)
+			},
+			publisherKey: k.publisher.public,
+			err:          ErrInvalidSignature,
+		},
+		{
+			name: "fail - expired",
+			data: func(t *testing.T) []byte {
+				return k.publicationV1(t, signedBlobFor(t, k.publisherEphemeral, 1, time.Time{}, now.Add(-time.Hour), k.validators(t)))
+			},
+			publisherKey: k.publisher.public,
+			err:          ErrExpired,
+		},
+		{
+			name: "fail - not effective yet",
+			data: func(t *testing.T) []byte {
+				return k.publicationV1(t, signedBlobFor(t, k.publisherEphemeral, 1, now.Add(time.Hour), now.Add(2*time.Hour), k.validators(t)))
+			},
+			publisherKey: k.publisher.public,
+			err:          ErrNotYetEffective,
+		},
+		{
+			name: "fail - unsupported version",
+			data: func(t *testing.T) []byte {
+				data, err := json.Marshal(publication{PublicKey: k.publisher.public, Version: 3})
+				require.NoError(t, err)
+				return data
+			},
+			publisherKey: k.publisher.public,
+			err:          ErrUnsupportedVersion,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.data(t), tt.publisherKey)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestParse_InvalidValidatorManifest(t *testing.T) {
+	k := newTestKeys(t)
+	corrupted, err := base64.StdEncoding.DecodeString(manifest(t, k.validator2, k.validatorEphemeral, 1))
+	require.NoError(t, err)
+	corrupted[len(corrupted)-1] ^= 0xFF
+
+	validators := []map[string]string{
+		{
+			"validation_public_key": k.validator.public,
+			"manifest":              manifest(t, k.validator, k.validatorEphemeral, 1),
+		},
+		{
+			"validation_public_key": k.validator2.public,
+			"manifest":              base64.StdEncoding.EncodeToString(corrupted),
+		},
+		{
+			"validation_public_key": k.attacker.public,
+			"manifest":              manifest(t, k.validator, k.validatorEphemeral, 1),
+		},
+	}
+	data := k.publicationV1(t, signedBlobFor(t, k.publisherEphemeral, 1, time.Time{}, time.Now().Add(time.Hour), validators))
+
+	l, err := Parse(data, k.publisher.public)
+	require.NoError(t, err)
+	require.Len(t, l.Validators, 3)
+
+	require.NoError(t, l.Validators[0].ManifestErr)
+	require.Equal(t, k.validatorEphemeral.public, l.Validators[0].Manifest.SigningPubKey)
+
+	require.Equal(t, k.validator2.public, l.Validators[1].PublicKey)
+	require.Nil(t, l.Validators[1].Manifest)
+	require.ErrorIs(t, l.Validators[1].ManifestErr, ErrInvalidValidatorManifest)
+
+	require.Nil(t, l.Validators[2].Manifest)
+	require.ErrorIs(t, l.Validators[2].ManifestErr, ErrInvalidValidatorManifest)
+	require.ErrorContains(t, l.Validators[2].ManifestErr, "master key mismatch")
+
+	_, ok := l.ValidatorByKey(k.validator2.public)
+	require.True(t, ok)
+}
+
+func TestParseAt_Version2(t *testing.T) {
+	k := newTestKeys(t)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	validators := k.validators(t)
+
+	data, err := json.Marshal(publication{
+		PublicKey: k.publisher.public,
+		Manifest:  manifest(t, k.publisher, k.publisherEphemeral, 1),
+		Version:   version2,
+		BlobsV2: []signedBlob{
+			signedBlobFor(t, k.publisherEphemeral, 1, time.Time{}, start.Add(48*time.Hour), validators),
+			signedBlobFor(t, k.publisherEphemeral, 2, start.Add(24*time.Hour), start.Add(72*time.Hour), validators[:1]),
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		sequence uint32
+		err      error
+	}{
+		{name: "pass - first list", now: start, sequence: 1},
+		{name: "pass - second list once effective", now: start.Add(30 * time.Hour), sequence: 2},
+		{name: "pass - second list after the first expires", now: start.Add(60 * time.Hour), sequence: 2},
+		{name: "fail - both expired", now: start.Add(72 * time.Hour), err: ErrExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := ParseAt(data, k.publisher.public, tt.now)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.sequence, l.Sequence)
+		})
+	}
+}
+
+func TestList_ValidatorByKey(t *testing.T) {
+	k := newTestKeys(t)
+	validators := append(k.validators(t), map[string]string{
+		"validation_public_key": k.attacker.public,
+		"manifest":              manifest(t, k.attacker, keypair{}, 0xFFFFFFFF),
+	})
+	data := k.publicationV1(t, signedBlobFor(t, k.publisherEphemeral, 1, time.Time{}, time.Now().Add(time.Hour), validators))
+	l, err := Parse(data, k.publisher.public)
+	require.NoError(t, err)
+
+	v, ok := l.ValidatorByKey(k.validatorEphemeral.public)
+	require.True(t, ok)
+	require.Equal(t, k.validator.public, v.PublicKey)
+
+	v, ok = l.ValidatorByKey(k.validator2.public)
+	require.True(t, ok)
+	require.Equal(t, k.validator2.public, v.PublicKey)
+
+	_, ok = l.ValidatorByKey(k.attacker.public)
+	require.False(t, ok)
+	_, ok = l.ValidatorByKey(k.publisherEphemeral.public)
+	require.False(t, ok)
+}
@@ -0,0 +1,12 @@
+package validator
+
+import "errors"
+
+var (
+	ErrMissingField           = errors.New("missing required field")
+	ErrInvalidField           = errors.New("invalid field")
+	ErrInvalidSignature       = errors.New("signature does not match the signing key")
+	ErrInvalidMasterSignature = errors.New("master signature does not match the master key")
+	ErrManifestRevoked        = errors.New("manifest revokes the master key")
+	ErrSigningKeyMismatch     = errors.New("validation is not signed by the ephemeral key of the manifest")
+)
@@ -0,0 +1,115 @@
+package validator
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+)
+
+const (
+	// The sequence of a manifest that permanently revokes its master key.
+	revocationSequence uint32 = 0xFFFFFFFF
+)
+
+// Manifest binds the long-lived master key of a validator to the ephemeral
+// key it signs validations with. A newer manifest, with a higher sequence,
+// replaces the ephemeral key.
+type Manifest struct {
+	// The master public key of the validator, as a hex string.
+	PublicKey string
+	// The ephemeral public key of the validator, as a hex string. Empty in revocations.
+	SigningPubKey string
+	Sequence      uint32
+	// The domain the validator claims, decoded from its hex form.
+	Domain string
+	// The signature of the ephemeral key. Empty in revocations.
+	Signature string
+	// The signature of the master key.
+	MasterSignature string
+
+	signingData []byte
+}
+
+// DecodeManifest decodes a hex encoded manifest blob.
+func DecodeManifest(blob string) (*Manifest, error) {
+	fields, err := binarycodec.Decode(blob)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Manifest{}
+	if m.PublicKey, err = stringField(fields, "PublicKey", true); err != nil {
+		return nil, err
+	}
+	if m.Sequence, err = uint32Field(fields, "Sequence", true); err != nil {
+		return nil, err
+	}
+	if m.MasterSignature, err = stringField(fields, "MasterSignature", true); err != nil {
+		return nil, err
+	}
+	if m.SigningPubKey, err = stringField(fields, "SigningPubKey", false); err != nil {
+		return nil, err
+	}
+	if m.Signature, err = stringField(fields, "Signature", false); err != nil {
+		return nil, err
+	}
+	domain, err := stringField(fields, "Domain", false)
+	if err != nil {
+		return nil, err
+	}
+	d, err := hex.DecodeString(domain)
+	if err != nil {
+		return nil, fmt.Errorf("%w: Domain", ErrInvalidField)
+	}
+	m.Domain = string(d)
+
+	if m.signingData, err = signingData(hash.ManifestPrefix, fields); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Revoked reports whether the manifest permanently revokes its master key.
+func (m *Manifest) Revoked() bool {
+	return m.Sequence == revocationSequence
+}
+
+// MasterKey returns the master public key in the base58 node public key
+// format, which identifies the validator in UNLs and the validations stream.
+func (m *Manifest) MasterKey() (string, error) {
+	return nodePublicKey(m.PublicKey)
+}
+
+// EphemeralKey returns the ephemeral public key in the base58 node public key format.
+func (m *Manifest) EphemeralKey() (string, error) {
+	return nodePublicKey(m.SigningPubKey)
+}
+
+// Verify checks the master signature of the manifest and, unless it is a
+// revocation, the signature of its ephemeral key.
+func (m *Manifest) Verify() error {
+	if !verify(m.signingData, m.PublicKey, m.MasterSignature) {
+		return ErrInvalidMasterSignature
+	}
+	if m.Revoked() {
+		return nil
+	}
+	if !verify(m.signingData, m.SigningPubKey, m.Signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// VerifySignature checks that sig is a signature of data by the ephemeral key
+// of the manifest. It does not verify the manifest itself.
+func (m *Manifest) VerifySignature(data []byte, sig string) error {
+	if m.Revoked() {
+		return ErrManifestRevoked
+	}
+	if !verify(data, m.SigningPubKey, sig) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package validator
+
+import (
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	masterPrivateKey    = "ED0BF5F1F124C884B1A5AE4A48C816FCF554FC3A0D9A07C0F7EB1CA91F7B94814C"
+	masterPublicKey     = "EDA57EBBCB502C2009EFE17229E8DC865DCCB192C52D7888D624DC9EBADDB815F0"
+	ephemeralPrivateKey = "00D78B9735C3F26501C7337B8A5727FD53A6EFDBC6AA55984F098488561F985E23"
+	ephemeralPublicKey  = "030D58EB48B4420B1F7B9DF55087E0E29FEF0E8468F9A6825B01CA2C361042D435"
+	otherPrivateKey     = "001ACAAEDECE405B2A958212629E16F2EB46B153EEE94CDD350FDEFF52795525B7"
+	otherPublicKey      = "0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020"
+)
+
+// sign encodes fields with a signature of each private key stored in the named field.
+func sign(t *testing.T, prefix uint32, fields map[string]any, keys map[string]string) string {
+	t.Helper()
+	data, err := signingData(prefix, fields)
+	require.NoError(t, err)
+
+	for field, key := range keys {
+		sig, err := keypairs.Sign(string(data), key)
+		require.NoError(t, err)
+		fields[field] = sig
+	}
+
+	blob, err := binarycodec.Encode(fields)
+	require.NoError(t, err)
+	return blob
+}
+
+func newManifest(t *testing.T, sequence uint32, ephemeralKey string) string {
+	t.Helper()
+	fields := map[string]any{
+		"PublicKey": masterPublicKey,
+		"Sequence":  sequence,
+		"Domain":    "6578616D706C652E636F6D",
+	}
+	keys := map[string]string{"MasterSignature": masterPrivateKey}
+	if sequence != revocationSequence {
+		fields["SigningPubKey"] = ephemeralPublicKey
+		keys["Signature"] = ephemeralKey
+	}
+	return sign(t, hash.ManifestPrefix, fields, keys)
+}
+
+func TestDecodeManifest(t *testing.T) {
+	m, err := DecodeManifest(newManifest(t, 3, ephemeralPrivateKey))
+	require.NoError(t, err)
+
+	require.Equal(t, masterPublicKey, m.PublicKey)
+	require.Equal(t, ephemeralPublicKey, m.SigningPubKey)
+	require.Equal(t, uint32(3), m.Sequence)
+	require.Equal(t, "example.com", m.Domain)
+	require.False(t, m.Revoked())
+	require.NoError(t, m.Verify())
+
+	masterKey, err := m.MasterKey()
+	require.NoError(t, err)
+	require.Equal(t, "n", masterKey[:1])
+	ephemeralKey, err := m.EphemeralKey()
+	require.NoError(t, err)
+	require.Equal(t, "n", ephemeralKey[:1])
+}
+
+func TestDecodeManifest_Errors(t *testing.T) {
+	_, err := DecodeManifest("ZZ")
+	require.Error(t, err)
+
+	blob, err := binarycodec.Encode(map[string]any{"PublicKey": masterPublicKey, "Sequence": uint32(1)})
+	require.NoError(t, err)
+	_, err = DecodeManifest(blob)
+	require.ErrorIs(t, err, ErrMissingField)
+}
+
+func TestManifest_Verify(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest func(t *testing.T) string
+		revoked  bool
+		err      error
+	}{
+		{
+			name:     "pass - valid manifest",
+			manifest: func(t *testing.T) string { return newManifest(t, 1, ephemeralPrivateKey) },
+		},
+		{
+			name:     "pass - revocation",
+			manifest: func(t *testing.T) string { return newManifest(t, revocationSequence, "") },
+			revoked:  true,
+		},
+		{
+			name:     "fail - ephemeral signature by another key",
+			manifest: func(t *testing.T) string { return newManifest(t, 1, otherPrivateKey) },
+			err:      ErrInvalidSignature,
+		},
+		{
+			name: "fail - master signature by another key",
+			manifest: func(t *testing.T) string {
+				return sign(t, hash.ManifestPrefix, map[string]any{
+					"PublicKey":     masterPublicKey,
+					"SigningPubKey": ephemeralPublicKey,
+					"Sequence":      uint32(1),
+				}, map[string]string{"MasterSignature": otherPrivateKey, "Signature": ephemeralPrivateKey})
+			},
+			err: ErrInvalidMasterSignature,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := DecodeManifest(tt.manifest(t))
+			require.NoError(t, err)
+			require.Equal(t, tt.revoked, m.Revoked())
+			if tt.err == nil {
+				require.NoError(t, m.Verify())
+				return
+			}
+			require.ErrorIs(t, m.Verify(), tt.err)
+		})
+	}
+}
+
+func TestManifest_VerifySignature(t *testing.T) {
+	m, err := DecodeManifest(newManifest(t, 1, ephemeralPrivateKey))
+	require.NoError(t, err)
+	revocation, err := DecodeManifest(newManifest(t, revocationSequence, ""))
+	require.NoError(t, err)
+
+	data := []byte(`{"sequence":1}`)
+	sig, err := keypairs.Sign(string(data), ephemeralPrivateKey)
+	require.NoError(t, err)
+	otherSig, err := keypairs.Sign(string(data), otherPrivateKey)
+	require.NoError(t, err)
+
+	require.NoError(t, m.VerifySignature(data, sig))
+	require.ErrorIs(t, m.VerifySignature(data, otherSig), ErrInvalidSignature)
+	require.ErrorIs(t, m.VerifySignature([]byte(`{"sequence":2}`), sig), ErrInvalidSignature)
+	require.ErrorIs(t, revocation.VerifySignature(data, sig), ErrManifestRevoked)
+}
+
+// The manifest of mainnet validator nHUFE9prPXPrHcG3SkwP1UzAQbSphqyQkQK9ATXLZsfkezhhda3p,
+// as returned by the manifest method and published in the XRPL documentation.
+const publishedManifest = "24000000017121EDC090980ECAAB37CBE52E880236EC57F732B7DBB7C7BB9A3768D3A6E7184A795E7321021466BC26665995E5C1285513DA97B360150AF855B80FD61989135FFBD51811B07646304402201A7629206B9F511A233767135DA90033B5706B4191EE8DE8B1D56565286BA17402207F51FCBC75BFDADD6F8618DA7E67AF17678524A87F753A57FC081C999E6A8E6E701240F43D6200CA3B6050A96F6E393770B37342F54765DA73463043A5DD193F9C67BCB0DA7F096DD0B7847F17BBD394A9CF3AEC47BA266957B720C7CC1758FE174909"
+
+func TestManifest_Published(t *testing.T) {
+	m, err := DecodeManifest(publishedManifest)
+	require.NoError(t, err)
+
+	require.Equal(t, "EDC090980ECAAB37CBE52E880236EC57F732B7DBB7C7BB9A3768D3A6E7184A795E", m.PublicKey)
+	require.Equal(t, "021466BC26665995E5C1285513DA97B360150AF855B80FD61989135FFBD51811B0", m.SigningPubKey)
+	require.Equal(t, uint32(1), m.Sequence)
+	require.False(t, m.Revoked())
+
+	masterKey, err := m.MasterKey()
+	require.NoError(t, err)
+	require.Equal(t, "nHUFE9prPXPrHcG3SkwP1UzAQbSphqyQkQK9ATXLZsfkezhhda3p", masterKey)
+
+	// The master key is ed25519 and the ephemeral key secp256k1.
+	require.NoError(t, m.Verify())
+
+	// The same manifest with the master public key altered.
+	tampered, err := DecodeManifest("24000000017121EDC090980ECAAB37CBE52E880236EC57F732F9F91B646957118B3C3D91F222FE75" + publishedManifest[80:])
+	require.NoError(t, err)
+	require.ErrorIs(t, tampered.Verify(), ErrInvalidMasterSignature)
+}
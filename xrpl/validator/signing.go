@@ -0,0 +1,101 @@
+package validator
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
+	"github.com/Peersyst/xrpl-go/keypairs"
+)
+
+// The length of a hex encoded public key, 33 bytes for both algorithms.
+const publicKeyLength = 66
+
+// signingData returns the prefix followed by the fields of an object that are
+// covered by its signatures, in their canonical binary format.
+func signingData(prefix uint32, fields map[string]any) ([]byte, error) {
+	signing := make(map[string]any, len(fields))
+	for k, v := range fields {
+		fi, err := definitions.Get().GetFieldInstanceByFieldName(k)
+		if err != nil || fi == nil || !fi.IsSigningField {
+			continue
+		}
+		signing[k] = v
+	}
+
+	encoded, err := binarycodec.Encode(signing)
+	if err != nil {
+		return nil, err
+	}
+	b, err := hex.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return append(binary.BigEndian.AppendUint32(nil, prefix), b...), nil
+}
+
+// verify checks sig over data with the hex public key, using the algorithm
+// keypairs.Validate picks from the public key.
+func verify(data []byte, publicKey, sig string) bool {
+	if len(publicKey) != publicKeyLength || sig == "" {
+		return false
+	}
+	ok, err := keypairs.Validate(string(data), publicKey, sig)
+	return err == nil && ok
+}
+
+// nodePublicKey encodes a hex public key in the base58 node public key format.
+func nodePublicKey(publicKey string) (string, error) {
+	b, err := hex.DecodeString(publicKey)
+	if err != nil {
+		return "", err
+	}
+	return addresscodec.EncodeNodePublicKey(b)
+}
+
+func stringField(fields map[string]any, name string, required bool) (string, error) {
+	v, ok := fields[name]
+	if !ok {
+		if required {
+			return "", fmt.Errorf("%w: %s", ErrMissingField, name)
+		}
+		return "", nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrInvalidField, name)
+	}
+	return s, nil
+}
+
+func uint32Field(fields map[string]any, name string, required bool) (uint32, error) {
+	v, ok := fields[name]
+	if !ok {
+		if required {
+			return 0, fmt.Errorf("%w: %s", ErrMissingField, name)
+		}
+		return 0, nil
+	}
+	n, ok := v.(uint32)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidField, name)
+	}
+	return n, nil
+}
+
+// uint64Field reads a UInt64 field, which the binary codec decodes as a hex string.
+func uint64Field(fields map[string]any, name string) (uint64, error) {
+	s, err := stringField(fields, name, false)
+	if err != nil || s == "" {
+		return 0, err
+	}
+	n, err := strconv.ParseUint(s, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidField, name)
+	}
+	return n, nil
+}
@@ -0,0 +1,157 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+)
+
+const (
+	// The validation votes for a ledger. Partial validations only show that the
+	// validator is online.
+	vfFullValidation uint32 = 0x00000001
+)
+
+// Validation is a signed vote of a validator for a ledger, as broadcast on the
+// peer network and in the data field of the validations stream.
+type Validation struct {
+	Flags          uint32
+	LedgerHash     string
+	LedgerSequence uint32
+	// When the validation was signed, in seconds since the Ripple Epoch.
+	SigningTime uint32
+	CloseTime   uint32
+	LoadFee     uint32
+	// The amendments the validator votes for. Only sent on flag ledgers.
+	Amendments []string
+	// Fee voting fields, in fee units. Only sent on flag ledgers.
+	BaseFee          uint64
+	ReserveBase      uint32
+	ReserveIncrement uint32
+	// Fee voting fields, in drops, used once the XRPFees amendment is enabled.
+	BaseFeeDrops          string
+	ReserveBaseDrops      string
+	ReserveIncrementDrops string
+	// A random value chosen by the validator at startup.
+	Cookie        uint64
+	ServerVersion uint64
+	ConsensusHash string
+	ValidatedHash string
+	// The ephemeral public key that signed the validation, as a hex string.
+	SigningPubKey string
+	Signature     string
+
+	signingData []byte
+}
+
+// DecodeValidation decodes a hex encoded validation blob.
+func DecodeValidation(blob string) (*Validation, error) {
+	fields, err := binarycodec.Decode(blob)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &Validation{}
+	if v.Flags, err = uint32Field(fields, "Flags", true); err != nil {
+		return nil, err
+	}
+	if v.LedgerHash, err = stringField(fields, "LedgerHash", true); err != nil {
+		return nil, err
+	}
+	if v.LedgerSequence, err = uint32Field(fields, "LedgerSequence", true); err != nil {
+		return nil, err
+	}
+	if v.SigningTime, err = uint32Field(fields, "SigningTime", true); err != nil {
+		return nil, err
+	}
+	if v.SigningPubKey, err = stringField(fields, "SigningPubKey", true); err != nil {
+		return nil, err
+	}
+	if v.Signature, err = stringField(fields, "Signature", true); err != nil {
+		return nil, err
+	}
+	if v.CloseTime, err = uint32Field(fields, "CloseTime", false); err != nil {
+		return nil, err
+	}
+	if v.LoadFee, err = uint32Field(fields, "LoadFee", false); err != nil {
+		return nil, err
+	}
+	if amendments, ok := fields["Amendments"]; ok {
+		if v.Amendments, ok = amendments.([]string); !ok {
+			return nil, fmt.Errorf("%w: Amendments", ErrInvalidField)
+		}
+	}
+	if v.BaseFee, err = uint64Field(fields, "BaseFee"); err != nil {
+		return nil, err
+	}
+	if v.ReserveBase, err = uint32Field(fields, "ReserveBase", false); err != nil {
+		return nil, err
+	}
+	if v.ReserveIncrement, err = uint32Field(fields, "ReserveIncrement", false); err != nil {
+		return nil, err
+	}
+	if v.BaseFeeDrops, err = stringField(fields, "BaseFeeDrops", false); err != nil {
+		return nil, err
+	}
+	if v.ReserveBaseDrops, err = stringField(fields, "ReserveBaseDrops", false); err != nil {
+		return nil, err
+	}
+	if v.ReserveIncrementDrops, err = stringField(fields, "ReserveIncrementDrops", false); err != nil {
+		return nil, err
+	}
+	if v.Cookie, err = uint64Field(fields, "Cookie"); err != nil {
+		return nil, err
+	}
+	if v.ServerVersion, err = uint64Field(fields, "ServerVersion"); err != nil {
+		return nil, err
+	}
+	if v.ConsensusHash, err = stringField(fields, "ConsensusHash", false); err != nil {
+		return nil, err
+	}
+	if v.ValidatedHash, err = stringField(fields, "ValidatedHash", false); err != nil {
+		return nil, err
+	}
+
+	if v.signingData, err = signingData(hash.ValidationPrefix, fields); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Full reports whether the validation votes for its ledger. Partial
+// validations only show that the validator is online.
+func (v *Validation) Full() bool {
+	return v.Flags&vfFullValidation != 0
+}
+
+// PublicKey returns the key that signed the validation in the base58 node
+// public key format, as shown in the validation_public_key of the validations stream.
+func (v *Validation) PublicKey() (string, error) {
+	return nodePublicKey(v.SigningPubKey)
+}
+
+// Verify checks the signature of the validation against its SigningPubKey.
+func (v *Validation) Verify() error {
+	if !verify(v.signingData, v.SigningPubKey, v.Signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// VerifyWithManifest checks the validation and the manifest of its validator.
+// The manifest must be valid and not revoked, and the validation must be
+// signed by the ephemeral key the manifest delegates to.
+func (v *Validation) VerifyWithManifest(m *Manifest) error {
+	if err := m.Verify(); err != nil {
+		return err
+	}
+	if m.Revoked() {
+		return ErrManifestRevoked
+	}
+	if !strings.EqualFold(v.SigningPubKey, m.SigningPubKey) {
+		return ErrSigningKeyMismatch
+	}
+	return v.Verify()
+}
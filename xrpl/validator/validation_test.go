@@ -0,0 +1,101 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+	"github.com/stretchr/testify/require"
+)
+
+const testLedgerHash = "4B1E5E0A3F2B1C3D4E5F60718293A4B5C6D7E8F90A1B2C3D4E5F60718293A4B5"
+
+func newValidation(t *testing.T, flags uint32, signingPubKey, privateKey string) string {
+	t.Helper()
+	return sign(t, hash.ValidationPrefix, map[string]any{
+		"Flags":          flags,
+		"LedgerHash":     testLedgerHash,
+		"LedgerSequence": uint32(90000000),
+		"SigningTime":    uint32(780000000),
+		"Cookie":         "0DBC61D2C3A6A7B9",
+		"LoadFee":        uint32(256),
+		"Amendments":     []string{"42426C4D4F1009EE67080A9B7965B44656D7714D104A72F9B4369F97ABF044EE"},
+		"SigningPubKey":  signingPubKey,
+	}, map[string]string{"Signature": privateKey})
+}
+
+func TestDecodeValidation(t *testing.T) {
+	v, err := DecodeValidation(newValidation(t, 0x80000001, ephemeralPublicKey, ephemeralPrivateKey))
+	require.NoError(t, err)
+
+	require.True(t, v.Full())
+	require.Equal(t, testLedgerHash, v.LedgerHash)
+	require.Equal(t, uint32(90000000), v.LedgerSequence)
+	require.Equal(t, uint32(780000000), v.SigningTime)
+	require.Equal(t, uint64(0x0DBC61D2C3A6A7B9), v.Cookie)
+	require.Equal(t, uint32(256), v.LoadFee)
+	require.Equal(t, []string{"42426C4D4F1009EE67080A9B7965B44656D7714D104A72F9B4369F97ABF044EE"}, v.Amendments)
+	require.Equal(t, ephemeralPublicKey, v.SigningPubKey)
+	require.NoError(t, v.Verify())
+
+	publicKey, err := v.PublicKey()
+	require.NoError(t, err)
+	ephemeralKey, err := (&Manifest{SigningPubKey: ephemeralPublicKey}).EphemeralKey()
+	require.NoError(t, err)
+	require.Equal(t, ephemeralKey, publicKey)
+}
+
+func TestDecodeValidation_Partial(t *testing.T) {
+	v, err := DecodeValidation(newValidation(t, 0x80000000, ephemeralPublicKey, ephemeralPrivateKey))
+	require.NoError(t, err)
+	require.False(t, v.Full())
+}
+
+func TestValidation_Verify(t *testing.T) {
+	v, err := DecodeValidation(newValidation(t, 0x80000001, ephemeralPublicKey, otherPrivateKey))
+	require.NoError(t, err)
+	require.ErrorIs(t, v.Verify(), ErrInvalidSignature)
+}
+
+func TestValidation_VerifyWithManifest(t *testing.T) {
+	manifest, err := DecodeManifest(newManifest(t, 1, ephemeralPrivateKey))
+	require.NoError(t, err)
+	revocation, err := DecodeManifest(newManifest(t, revocationSequence, ""))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		validation string
+		manifest   *Manifest
+		err        error
+	}{
+		{
+			name:       "pass - signed by the ephemeral key",
+			validation: newValidation(t, 0x80000001, ephemeralPublicKey, ephemeralPrivateKey),
+			manifest:   manifest,
+		},
+		{
+			name:       "fail - signed by another key",
+			validation: newValidation(t, 0x80000001, otherPublicKey, otherPrivateKey),
+			manifest:   manifest,
+			err:        ErrSigningKeyMismatch,
+		},
+		{
+			name:       "fail - revoked master key",
+			validation: newValidation(t, 0x80000001, ephemeralPublicKey, ephemeralPrivateKey),
+			manifest:   revocation,
+			err:        ErrManifestRevoked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := DecodeValidation(tt.validation)
+			require.NoError(t, err)
+			if tt.err == nil {
+				require.NoError(t, v.VerifyWithManifest(tt.manifest))
+				return
+			}
+			require.ErrorIs(t, v.VerifyWithManifest(tt.manifest), tt.err)
+		})
+	}
+}
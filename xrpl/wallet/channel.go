@@ -0,0 +1,57 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"errors"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+var (
+	// ErrChannelClaimAmountRequired is returned when a payment channel claim has no amount.
+	ErrChannelClaimAmountRequired = errors.New("payment channel claim amount is required")
+)
+
+// AuthorizeChannelClaim signs a claim against a payment channel offline, the same way
+// the channel_authorize method does. The channel ID is the hex encoded ID of the channel,
+// and the amount is the cumulative amount of the claim, in XRP drops or in a token.
+// Returns the hex encoded signature of the claim.
+func (w *Wallet) AuthorizeChannelClaim(channelID string, amount types.CurrencyAmount) (string, error) {
+	encodedClaim, err := encodeChannelClaim(channelID, amount)
+	if err != nil {
+		return "", err
+	}
+
+	return w.computeSignature(encodedClaim)
+}
+
+// VerifyChannelClaim verifies a payment channel claim offline, the same way the
+// channel_verify method does. It reports whether the signature is a valid signature of
+// the claim of the amount against the channel, made with the key pair of the public key.
+func VerifyChannelClaim(channelID string, amount types.CurrencyAmount, signature, publicKey string) (bool, error) {
+	encodedClaim, err := encodeChannelClaim(channelID, amount)
+	if err != nil {
+		return false, err
+	}
+
+	msg, err := hex.DecodeString(encodedClaim)
+	if err != nil {
+		return false, err
+	}
+
+	return keypairs.Validate(string(msg), publicKey, signature)
+}
+
+// Encodes a payment channel claim in preparation for signing.
+func encodeChannelClaim(channelID string, amount types.CurrencyAmount) (string, error) {
+	if amount == nil {
+		return "", ErrChannelClaimAmountRequired
+	}
+
+	return binarycodec.EncodeForSigningClaim(map[string]any{
+		"Channel": channelID,
+		"Amount":  amount.Flatten(),
+	})
+}
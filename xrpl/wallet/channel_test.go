@@ -0,0 +1,102 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testChannelID = "5DB01B7FFED6B67E6B0414DED11E051D2EE2B7619CE0EAA6286D67A3A4D5BDB3"
+
+func TestWallet_AuthorizeChannelClaim(t *testing.T) {
+	tt := []struct {
+		name        string
+		seed        string
+		channelID   string
+		amount      types.CurrencyAmount
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:      "pass - secp256k1",
+			seed:      "snGHNrPbHrdUcszeuDEigMdC1Lyyd",
+			channelID: testChannelID,
+			amount:    types.XRPCurrencyAmount(1000000),
+			expected:  "304402204E7052F33DDAFAAA55C9F5B132A5E50EE95B2CF68C0902F61DFE77299BC893740220353640B951DCD24371C16868B3F91B78D38B6F3FD1E826413CDF891FA8250AAC",
+		},
+		{
+			name:      "pass - ed25519",
+			seed:      "sEdSuqBPSQaood2DmNYVkwWTn1oQTj2",
+			channelID: testChannelID,
+			amount:    types.XRPCurrencyAmount(1000000),
+			expected:  "7E1C217A3E4B3C107B7A356E665088B4FBA6464C48C58267BEF64975E3375EA338AE22E6714E3F5E734AE33E6B97AAD59058E1E196C1F92346FC1498D0674404",
+		},
+		{
+			name:        "fail - missing amount",
+			seed:        "snGHNrPbHrdUcszeuDEigMdC1Lyyd",
+			channelID:   testChannelID,
+			expectedErr: ErrChannelClaimAmountRequired,
+		},
+		{
+			name:      "fail - invalid channel ID",
+			seed:      "snGHNrPbHrdUcszeuDEigMdC1Lyyd",
+			channelID: "5DB01B",
+			amount:    types.XRPCurrencyAmount(1000000),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			w, err := FromSeed(tc.seed, "")
+			require.NoError(t, err)
+
+			signature, err := w.AuthorizeChannelClaim(tc.channelID, tc.amount)
+			if tc.expected == "" {
+				require.Error(t, err)
+				if tc.expectedErr != nil {
+					require.ErrorIs(t, err, tc.expectedErr)
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, signature)
+		})
+	}
+}
+
+func TestVerifyChannelClaim(t *testing.T) {
+	amounts := []types.CurrencyAmount{
+		types.XRPCurrencyAmount(1000000),
+		types.IssuedCurrencyAmount{
+			Currency: "USD",
+			Issuer:   "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			Value:    "12.5",
+		},
+		types.MPTCurrencyAmount{
+			MPTIssuanceID: "00000001A407AF5856CCF3C42619DAA925813FC955C72983",
+			Value:         "100",
+		},
+	}
+
+	for _, seed := range []string{"snGHNrPbHrdUcszeuDEigMdC1Lyyd", "sEdSuqBPSQaood2DmNYVkwWTn1oQTj2"} {
+		w, err := FromSeed(seed, "")
+		require.NoError(t, err)
+
+		for _, amount := range amounts {
+			signature, err := w.AuthorizeChannelClaim(testChannelID, amount)
+			require.NoError(t, err)
+
+			ok, err := VerifyChannelClaim(testChannelID, amount, signature, w.PublicKey)
+			require.NoError(t, err)
+			require.True(t, ok)
+
+			ok, err = VerifyChannelClaim(testChannelID, types.XRPCurrencyAmount(999999), signature, w.PublicKey)
+			require.NoError(t, err)
+			require.False(t, ok)
+		}
+	}
+
+	_, err := VerifyChannelClaim(testChannelID, nil, "00", "00")
+	require.ErrorIs(t, err, ErrChannelClaimAmountRequired)
+}
@@ -136,8 +136,10 @@ func FromMnemonic(mnemonic string) (*Wallet, error) {
 // In order for a transaction to be validated, it must be signed by the account sending the transaction to prove
 // that the owner is actually the one deciding to take that action.
 //
+// The options are passed to the binary codec, for example to encode with custom definitions.
+//
 // TODO: Refactor to accept a `Transaction` object instead of a map.
-func (w *Wallet) Sign(tx map[string]interface{}) (string, string, error) {
+func (w *Wallet) Sign(tx map[string]interface{}, opts ...binarycodec.Option) (string, string, error) {
 	tx["SigningPubKey"] = w.PublicKey
 
 	// Copy the transaction to avoid modifying the original transaction
@@ -146,7 +148,7 @@ func (w *Wallet) Sign(tx map[string]interface{}) (string, string, error) {
 		signTx[k] = v
 	}
 
-	encodedTx, err := binarycodec.EncodeForSigning(signTx)
+	encodedTx, err := binarycodec.EncodeForSigning(signTx, opts...)
 	if err != nil {
 		return "", "", err
 	}
@@ -158,12 +160,12 @@ func (w *Wallet) Sign(tx map[string]interface{}) (string, string, error) {
 
 	tx["TxnSignature"] = txHash
 
-	txBlob, err := binarycodec.Encode(tx)
+	txBlob, err := binarycodec.Encode(tx, opts...)
 	if err != nil {
 		return "", "", err
 	}
 
-	txHash, err = hash.SignTxBlob(txBlob)
+	txHash, err = hash.SignTxBlob(txBlob, opts...)
 	if err != nil {
 		return "", "", err
 	}
@@ -178,8 +180,9 @@ func (w *Wallet) GetAddress() types.Address {
 
 // Signs a multisigned transaction offline.
 // Returns the transaction blob and the transaction hash.
-func (w *Wallet) Multisign(tx map[string]interface{}) (string, string, error) {
-	encodedTx, err := binarycodec.EncodeForMultisigning(tx, w.ClassicAddress.String())
+// The options are passed to the binary codec, for example to encode with custom definitions.
+func (w *Wallet) Multisign(tx map[string]interface{}, opts ...binarycodec.Option) (string, string, error) {
+	encodedTx, err := binarycodec.EncodeForMultisigning(tx, w.ClassicAddress.String(), opts...)
 	if err != nil {
 		return "", "", err
 	}
@@ -198,11 +201,11 @@ func (w *Wallet) Multisign(tx map[string]interface{}) (string, string, error) {
 	}
 
 	tx["Signers"] = []any{signer.Flatten()}
-	blob, err := binarycodec.Encode(tx)
+	blob, err := binarycodec.Encode(tx, opts...)
 	if err != nil {
 		return "", "", err
 	}
-	blobHash, err := hash.SignTxBlob(blob)
+	blobHash, err := hash.SignTxBlob(blob, opts...)
 	if err != nil {
 		return "", "", err
 	}
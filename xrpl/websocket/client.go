@@ -1,33 +1,22 @@
 package websocket
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"math"
-	"strconv"
-	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
-	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
-	"github.com/Peersyst/xrpl-go/xrpl/currency"
-	"github.com/Peersyst/xrpl-go/xrpl/hash"
-	transaction "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/client"
 	"github.com/mitchellh/mapstructure"
 
-	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
-	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
 	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
-	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
-	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
-	"github.com/Peersyst/xrpl-go/xrpl/wallet"
 	"github.com/Peersyst/xrpl-go/xrpl/websocket/interfaces"
 	wstypes "github.com/Peersyst/xrpl-go/xrpl/websocket/types"
 	ws "github.com/gorilla/websocket"
-
-	commonconstants "github.com/Peersyst/xrpl-go/xrpl/common"
 )
 
 const (
@@ -38,23 +27,34 @@ const (
 	// Networks with ID above this restricted number are expected specify an accurate NetworkID field
 	// in every transaction to that chain to prevent replay attacks.
 	// Mainnet and testnet are exceptions. More context: https://github.com/XRPLF/rippled/pull/4370
-	RestrictedNetworks       = 1024
-	RequiredNetworkIDVersion = "1.11.0"
+	RestrictedNetworks       = client.RestrictedNetworks
+	RequiredNetworkIDVersion = client.RequiredNetworkIDVersion
 )
 
 var (
 	ErrIncorrectID          = errors.New("incorrect id")
 	ErrNotConnectedToServer = errors.New("not connected to server")
 	ErrRequestTimedOut      = errors.New("request timed out")
+	ErrConnectionLost       = errors.New("connection lost before the response was received")
 )
 
+var _ client.XRPLClient = (*Client)(nil)
+
+// Client is a websocket client. The autofill, fee and submission methods are
+// provided by the embedded client.Core.
 type Client struct {
+	*client.Core
+
 	cfg  ClientConfig
 	conn *Connection
 
+	// Pending requests, keyed by request ID. Each one waits on its own
+	// channel, so concurrent requests never receive each other's responses.
+	pendingMu sync.Mutex
+	pending   map[int]chan *ClientResponse
+
 	// Channels
 	errChan          chan error
-	requestChan      chan *ClientResponse
 	ledgerClosedChan chan *streamtypes.LedgerStream
 	validationChan   chan *streamtypes.ValidationStream
 	transactionChan  chan *streamtypes.TransactionStream
@@ -62,34 +62,75 @@ type Client struct {
 	orderBookChan    chan *streamtypes.OrderBookStream
 	bookChangesChan  chan *streamtypes.BookChangesStream
 	consensusChan    chan *streamtypes.ConsensusStream
+	reconnectChan    chan *wstypes.ReconnectEvent
+
+	// Open path_find session, receiving the asynchronous path_find updates
+	pathFindMu sync.Mutex
+	pathFind   *PathFind
+
+	// Active subscriptions, replayed after a reconnect. Use registry to access it.
+	subscriptionsOnce sync.Once
+	subscriptions     *subscriptionRegistry
+	// Index of the last validated ledger seen by the client
+	lastLedgerIndex atomic.Uint32
 
 	idCounter atomic.Uint32
+
+	// Endpoint pool, nil unless the config has endpoints
+	pool *client.EndpointPool
+	// Pool endpoint the client last connected to
+	endpoint atomic.Pointer[Endpoint]
+	// Set when the connection is closed to move to a healthier endpoint
+	switching atomic.Bool
+	// Stops the background checks of the pool endpoints
+	monitorMu   sync.Mutex
+	monitorStop chan struct{}
+
 	NetworkID uint32
 }
 
 // Creates a new websocket client with cfg.
 // This client will open and close a websocket connection for each request.
 func NewClient(cfg ClientConfig) *Client {
-	return &Client{
-		cfg:         cfg,
-		requestChan: make(chan *ClientResponse),
-		errChan:     make(chan error),
-		conn:        NewConnection(cfg.host),
-	}
+	c := &Client{
+		cfg:           cfg,
+		pending:       make(map[int]chan *ClientResponse),
+		errChan:       make(chan error),
+		conn:          NewConnection(cfg.host),
+		subscriptions: newSubscriptionRegistry(),
+	}
+	if len(cfg.endpoints) > 0 {
+		c.pool = client.NewEndpointPool(cfg.endpoints, cfg.maxLedgerLag, cfg.healthCheckInterval)
+	}
+	c.Core = client.NewCore(c.request, client.Config{
+		MaxRetries:     cfg.maxRetries,
+		RetryDelay:     cfg.retryDelay,
+		FeeCushion:     cfg.feeCushion,
+		MaxFeeXRP:      cfg.maxFeeXRP,
+		FaucetProvider: cfg.faucetProvider,
+		Definitions:    cfg.definitions,
+		NetworkID:      &c.NetworkID,
+	})
+	return c
 }
 
 // Connect opens a websocket connection to the server. It starts reading messages in a goroutine.
+// With an endpoint pool, it connects to the first healthy endpoint that is synced with
+// the network, and starts checking the health of the endpoints in the background.
 func (c *Client) Connect() error {
-	err := c.conn.Connect()
+	err := c.connect()
 	if err != nil {
 		return err
 	}
 	go c.readMessages()
+	c.startMonitor()
 	return nil
 }
 
 // Disconnect closes the websocket connection.
 func (c *Client) Disconnect() error {
+	c.stopMonitor()
+	c.switching.Store(false)
 	return c.conn.Disconnect()
 }
 
@@ -98,112 +139,32 @@ func (c *Client) IsConnected() bool {
 	return c.conn.IsConnected()
 }
 
-func (c *Client) FaucetProvider() commonconstants.FaucetProvider {
-	return c.cfg.faucetProvider
-}
-
-// Autofill fills in the missing fields in a transaction.
-func (c *Client) Autofill(tx *transaction.FlatTransaction) error {
-	if err := c.setValidTransactionAddresses(tx); err != nil {
-		return err
-	}
-
-	err := c.setTransactionFlags(tx)
-	if err != nil {
-		return err
-	}
-
-	if _, ok := (*tx)["NetworkID"]; !ok {
-		if c.NetworkID != 0 {
-			(*tx)["NetworkID"] = c.NetworkID
-		}
-	}
-	if _, ok := (*tx)["Sequence"]; !ok {
-		err := c.setTransactionNextValidSequenceNumber(tx)
-		if err != nil {
-			return err
-		}
-	}
-	if _, ok := (*tx)["Fee"]; !ok {
-		err := c.calculateFeePerTransactionType(tx, 0)
-		if err != nil {
-			return err
-		}
-	}
-	if _, ok := (*tx)["LastLedgerSequence"]; !ok {
-		err := c.setLastLedgerSequence(tx)
-		if err != nil {
-			return err
-		}
-	}
-
-	if txType, ok := (*tx)["TransactionType"].(string); ok {
-		if acc, ok := (*tx)["Account"].(types.Address); txType == transaction.AccountDeleteTx.String() && ok {
-			err := c.checkAccountDeleteBlockers(acc)
-			if err != nil {
-				return err
-			}
-		}
-		if txType == transaction.PaymentTx.String() {
-			err := c.checkPaymentAmounts(tx)
-			if err != nil {
-				return err
-			}
-		}
-		if txType == transaction.BatchTx.String() {
-			err := c.autofillRawTransactions(tx)
-			if err != nil {
-				return err
-			}
-		}
-	}
-	return nil
-}
-
-// AutofillMultisigned fills in the missing fields in a multisigned transaction.
-// This function is used to fill in the missing fields in a multisigned transaction.
-// It fills in the missing fields in the transaction and calculates the fee per number of signers.
-func (c *Client) AutofillMultisigned(tx *transaction.FlatTransaction, nSigners uint64) error {
-	err := c.Autofill(tx)
-	if err != nil {
-		return err
-	}
-
-	err = c.calculateFeePerTransactionType(tx, nSigners)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// FundWallet funds a wallet with XRP from the faucet.
-// If the wallet does not have a classic address, it will return an error.
-func (c *Client) FundWallet(wallet *wallet.Wallet) error {
-	if wallet.ClassicAddress == "" {
-		return errors.New("fund wallet: cannot fund a wallet without a classic address")
-	}
-
-	err := c.cfg.faucetProvider.FundWallet(wallet.ClassicAddress)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
 // Request sends a request to the server and returns the response.
 // This function is used to send requests to the server.
 // It returns the response from the server.
 func (c *Client) Request(req interfaces.Request) (*ClientResponse, error) {
+	return c.RequestWithContext(context.Background(), req)
+}
+
+// RequestWithContext is like Request but uses ctx for cancellation and deadlines.
+// The wait for the response is additionally bounded by the client timeout.
+func (c *Client) RequestWithContext(ctx context.Context, req interfaces.Request) (*ClientResponse, error) {
 	err := req.Validate()
 	if err != nil {
 		return nil, err
 	}
 
-	id := c.idCounter.Add(1)
+	// Clio-only methods are sent to a Clio endpoint when the client is connected
+	// to rippled.
+	if c.pool != nil && client.IsClioOnlyMethod(req.Method()) {
+		if e := c.endpoint.Load(); e == nil || e.Kind != ClioEndpoint {
+			return c.clioRequest(ctx, req)
+		}
+	}
+
+	id := int(c.idCounter.Add(1))
 
-	msg, err := c.formatRequest(req, int(id), nil)
+	msg, err := c.formatRequest(req, id, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -212,17 +173,21 @@ func (c *Client) Request(req interfaces.Request) (*ClientResponse, error) {
 		return nil, ErrNotConnectedToServer
 	}
 
+	// Register before writing so the response can't arrive ahead of its waiter.
+	resChan := c.registerRequest(id)
+	defer c.unregisterRequest(id)
+
 	err = c.conn.WriteMessage(msg)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := c.awaitResponse(int(id))
+	res, err := c.awaitResponse(ctx, resChan)
 	if err != nil {
 		return nil, err
 	}
 
-	if res.ID != int(id) {
+	if res.ID != id {
 		return nil, ErrIncorrectID
 	}
 	if err := res.CheckError(); err != nil {
@@ -232,189 +197,13 @@ func (c *Client) Request(req interfaces.Request) (*ClientResponse, error) {
 	return res, nil
 }
 
-// SubmitTxBlob sends a pre-signed transaction blob to the server.
-// It decodes the blob to confirm that it contains either a signature
-// or a signing public key, and then submits it using a submission request.
-// The failHard flag determines how strictly errors are handled.
-func (c *Client) SubmitTxBlob(txBlob string, failHard bool) (*requests.SubmitResponse, error) {
-	tx, err := binarycodec.Decode(txBlob)
-	if err != nil {
-		return nil, err
-	}
-
-	_, okTxSig := tx["TxSignature"].(string)
-	_, okPubKey := tx["SigningPubKey"].(string)
-
-	if !okTxSig && !okPubKey {
-		return nil, ErrMissingTxSignatureOrSigningPubKey
-	}
-
-	return c.submitRequest(&requests.SubmitRequest{
-		TxBlob:   txBlob,
-		FailHard: failHard,
-	})
-}
-
-// SubmitTx signs the transaction (if necessary) and submits it to the server
-// via a submission request. It applies the provided submit options to decide whether
-// to autofill missing fields and enforce failHard mode during submission.
-func (c *Client) SubmitTx(tx transaction.FlatTransaction, opts *wstypes.SubmitOptions) (*requests.SubmitResponse, error) {
-	txBlob, err := c.getSignedTx(tx, opts.Autofill, opts.Wallet)
+// request sends req on behalf of the embedded client.Core.
+func (c *Client) request(ctx context.Context, req client.Request) (client.Response, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-
-	return c.submitRequest(&requests.SubmitRequest{
-		TxBlob:   txBlob,
-		FailHard: opts.FailHard,
-	})
-}
-
-// SubmitMultisigned sends a multisigned transaction to the server and returns the response.
-// This function is used to send multisigned transactions to the server.
-// It returns the response from the server.
-func (c *Client) SubmitMultisigned(txBlob string, failHard bool) (*requests.SubmitMultisignedResponse, error) {
-	tx, err := binarycodec.Decode(txBlob)
-	if err != nil {
-		return nil, err
-	}
-	signers, okSigners := tx["Signers"].([]interface{})
-
-	if okSigners && len(signers) > 0 {
-		for _, sig := range signers {
-			signer := sig.(map[string]any)
-			signerData := signer["Signer"].(map[string]any)
-			if signerData["SigningPubKey"] == "" && signerData["TxnSignature"] == "" {
-				return nil, errors.New("signer data is empty")
-			}
-		}
-	}
-
-	return c.submitMultisignedRequest(&requests.SubmitMultisignedRequest{
-		Tx:       tx,
-		FailHard: failHard,
-	})
-}
-
-// SubmitTxBlobAndWait sends a pre-signed transaction blob to the server,
-// decodes it to retrieve the required LastLedgerSequence, submits the blob,
-// and then waits until the transaction is confirmed in a ledger. It returns
-// the transaction response if the submission is successful.
-func (c *Client) SubmitTxBlobAndWait(txBlob string, failHard bool) (*requests.TxResponse, error) {
-	tx, err := binarycodec.Decode(txBlob)
-	if err != nil {
-		return nil, err
-	}
-
-	lastLedgerSequence, ok := tx["LastLedgerSequence"].(uint32)
-	if !ok {
-
-		return nil, ErrMissingLastLedgerSequenceInTransaction
-
-	}
-	txResponse, err := c.SubmitTxBlob(txBlob, failHard)
-	if err != nil {
-		return nil, err
-	}
-
-	if txResponse.EngineResult != "tesSUCCESS" {
-		return nil, &ClientError{ErrorString: "transaction failed to submit with engine result: " + txResponse.EngineResult}
-	}
-
-	txHash, err := hash.SignTxBlob(txBlob)
-	if err != nil {
-		return nil, err
-	}
-
-	return c.waitForTransaction(txHash, lastLedgerSequence)
-}
-
-// SubmitTxAndWait prepares a transaction by ensuring it is fully signed,
-// submits it to the server, and waits for ledger confirmation.
-// It validates that the transaction's EngineResult is successful before returning
-// the transaction response.
-func (c *Client) SubmitTxAndWait(tx transaction.FlatTransaction, opts *wstypes.SubmitOptions) (*requests.TxResponse, error) {
-	// Get the signed transaction blob.
-	txBlob, err := c.getSignedTx(tx, opts.Autofill, opts.Wallet)
-	if err != nil {
-		return nil, err
-	}
-
-	// Delegate to SubmitTxBlobAndWait to handle submission, engine result check,
-	// ledger sequence validation, and waiting for confirmation.
-	return c.SubmitTxBlobAndWait(txBlob, opts.FailHard)
-}
-
-func (c *Client) waitForTransaction(txHash string, lastLedgerSequence uint32) (*requests.TxResponse, error) {
-	var txResponse *requests.TxResponse
-	i := 0
-
-	for i < c.cfg.maxRetries {
-		// Get the current ledger index
-		currentLedger, err := c.GetLedgerIndex()
-		if err != nil {
-			return nil, err
-		}
-
-		// Check if the transaction has been included in the current ledger
-		if currentLedger.Int() >= int(lastLedgerSequence) {
-			break
-		}
-
-		// Request the transaction from the server
-		res, err := c.Request(&requests.TxRequest{
-			Transaction: txHash,
-		})
-		if err != nil {
-			return nil, err
-		}
-
-		err = res.GetResult(&txResponse)
-		if err != nil {
-			return nil, err
-		}
-
-		// Check if the transaction has been included in the current ledger
-		if txResponse.LedgerIndex.Int() >= int(lastLedgerSequence) {
-			break
-		}
-
-		// Wait for the retry delay before retrying
-		time.Sleep(c.cfg.retryDelay)
-		i++
-	}
-
-	if txResponse == nil {
-		return nil, errors.New("transaction not found")
-	}
-
-	return txResponse, nil
-}
-
-func (c *Client) submitMultisignedRequest(req *requests.SubmitMultisignedRequest) (*requests.SubmitMultisignedResponse, error) {
-	res, err := c.Request(req)
-	if err != nil {
-		return nil, err
-	}
-	var subRes requests.SubmitMultisignedResponse
-	err = res.GetResult(&subRes)
-	if err != nil {
-		return nil, err
-	}
-	return &subRes, nil
-}
-
-func (c *Client) submitRequest(req *requests.SubmitRequest) (*requests.SubmitResponse, error) {
-	res, err := c.Request(req)
-	if err != nil {
-		return nil, err
-	}
-	var subRes requests.SubmitResponse
-	err = res.GetResult(&subRes)
-	if err != nil {
-		return nil, err
-	}
-	return &subRes, nil
+	return res, nil
 }
 
 func (c *Client) formatRequest(req interfaces.Request, id int, marker any) ([]byte, error) {
@@ -434,282 +223,83 @@ func (c *Client) formatRequest(req interfaces.Request, id int, marker any) ([]by
 	return json.Marshal(m)
 }
 
-// TODO: Implement this when IsValidXAddress is implemented
-func (c *Client) getClassicAccountAndTag(address string) (string, uint32) {
-	return address, 0
-}
-
-func (c *Client) convertTransactionAddressToClassicAddress(tx *transaction.FlatTransaction, fieldName string) {
-	if address, ok := (*tx)[fieldName].(string); ok {
-		classicAddress, _ := c.getClassicAccountAndTag(address)
-		(*tx)[fieldName] = classicAddress
-	}
-}
-
-func (c *Client) validateTransactionAddress(tx *transaction.FlatTransaction, addressField, tagField string) error {
-	classicAddress, tag := c.getClassicAccountAndTag((*tx)[addressField].(string))
-	(*tx)[addressField] = classicAddress
-
-	if tag != uint32(0) {
-		if txTag, ok := (*tx)[tagField].(uint32); ok && txTag != tag {
-			return fmt.Errorf("the %s, if present, must be equal to the tag of the %s", addressField, tagField)
-		}
-		(*tx)[tagField] = tag
-	}
-
-	return nil
-}
-
-// Sets valid addresses for the transaction.
-func (c *Client) setValidTransactionAddresses(tx *transaction.FlatTransaction) error {
-	// Validate if "Account" address is an xAddress
-	if err := c.validateTransactionAddress(tx, "Account", "SourceTag"); err != nil {
-		return err
-	}
-
-	if _, ok := (*tx)["Destination"]; ok {
-		if err := c.validateTransactionAddress(tx, "Destination", "DestinationTag"); err != nil {
-			return err
-		}
-	}
-
-	// DepositPreuaht
-	c.convertTransactionAddressToClassicAddress(tx, "Authorize")
-	c.convertTransactionAddressToClassicAddress(tx, "Unauthorize")
-	// EscrowCancel, EscrowFinish
-	c.convertTransactionAddressToClassicAddress(tx, "Owner")
-	// SetRegularKey
-	c.convertTransactionAddressToClassicAddress(tx, "RegularKey")
-
-	return nil
-}
-
-// Sets the next valid sequence number for a given transaction.
-func (c *Client) setTransactionNextValidSequenceNumber(tx *transaction.FlatTransaction) error {
-	if _, ok := (*tx)["Account"].(string); !ok {
-		return errors.New("missing Account in transaction")
-	}
-	res, err := c.GetAccountInfo(&account.InfoRequest{
-		Account:     types.Address((*tx)["Account"].(string)),
-		LedgerIndex: common.LedgerTitle("current"),
-	})
-
-	if err != nil {
-		return err
-	}
-
-	(*tx)["Sequence"] = uint32(res.AccountData.Sequence)
-	return nil
-}
-
-// Calculates the current transaction fee for the ledger.
-// Note: This is a public API that can be called directly.
-func (c *Client) getFeeXrp(cushion float32) (string, error) {
-	res, err := c.GetServerInfo(&server.InfoRequest{})
-	if err != nil {
-		return "", err
-	}
-
-	if res.Info.ValidatedLedger.BaseFeeXRP == 0 {
-		return "", errors.New("getFeeXrp: could not get BaseFeeXrp from ServerInfo")
-	}
-
-	loadFactor := res.Info.LoadFactor
-	if res.Info.LoadFactor == 0 {
-		loadFactor = 1
-	}
-
-	fee := res.Info.ValidatedLedger.BaseFeeXRP * float32(loadFactor) * cushion
-
-	if fee > c.cfg.maxFeeXRP {
-		fee = c.cfg.maxFeeXRP
-	}
-
-	// Round fee to NUM_DECIMAL_PLACES
-	roundedFee := float32(math.Round(float64(fee)*math.Pow10(int(currency.MaxFractionLength)))) / float32(math.Pow10(int(currency.MaxFractionLength)))
-
-	// Convert the rounded fee back to a string with NUM_DECIMAL_PLACES
-	return fmt.Sprintf("%.*f", currency.MaxFractionLength, roundedFee), nil
-}
-
-// Calculates the fee per transaction type.
-//
-// Enhanced implementation that replicates xrpl.js calculateFeePerTransactionType logic,
-// including special cases for EscrowFinish, AccountDelete, AMMCreate, Batch, and multi-signing.
-func (c *Client) calculateFeePerTransactionType(tx *transaction.FlatTransaction, nSigners uint64) error {
-	// Get base network fee
-	netFeeXRP, err := c.getFeeXrp(c.cfg.feeCushion)
-	if err != nil {
-		return err
-	}
-
-	netFeeDrops, err := currency.XrpToDrops(netFeeXRP)
-	if err != nil {
-		return err
-	}
-
-	// Convert to uint64 for calculations
-	baseFeeUint, err := strconv.ParseUint(netFeeDrops, 10, 64)
-	if err != nil {
-		return err
-	}
-
-	baseFee := baseFeeUint
-
-	// Get transaction type
-	transactionType := ""
-	if txType, ok := (*tx)["TransactionType"]; ok {
-		if str, ok := txType.(string); ok {
-			transactionType = str
-		}
-	}
-
-	// Check if this is a special transaction cost type
-	isSpecialTxCost := transactionType == "AccountDelete" || transactionType == "AMMCreate"
-
-	switch transactionType {
-	case "EscrowFinish":
-		if fulfillment, ok := (*tx)["Fulfillment"]; ok && fulfillment != nil {
-			if fulfillmentStr, ok := fulfillment.(string); ok && fulfillmentStr != "" {
-				fulfillmentBytesSize := (len(fulfillmentStr) + 1) / 2 // Math.ceil(length / 2)
-				if fulfillmentBytesSize < 0 {
-					return fmt.Errorf("invalid fulfillment length")
-				}
-				// BaseFee × (33 + ceil(Fulfillment size in bytes / 16))
-				chunks := (uint64(fulfillmentBytesSize) + 15) / 16 // ceil division
-				baseFee = baseFeeUint * (33 + chunks)
-			}
-		}
-	case "AccountDelete", "AMMCreate":
-		reserveFee, err := c.fetchOwnerReserveFee()
-		if err != nil {
-			return err
-		}
-		baseFee = reserveFee
-	case "Batch":
-		rawTxFees, err := c.calculateBatchFees(tx)
-		if err != nil {
-			return err
-		}
-		baseFee = baseFeeUint*2 + rawTxFees
-	}
-
-	// Multi-signed Transaction: BaseFee × (1 + Number of Signatures Provided)
-	if nSigners > 0 {
-		signersFee := baseFeeUint * nSigners
-		baseFee += signersFee
-	}
-
-	// Apply max fee limit (but not for special transaction cost types)
-	var totalFee uint64
-	if isSpecialTxCost {
-		totalFee = baseFee
-	} else {
-		maxFeeDrops, err := currency.XrpToDrops(fmt.Sprintf("%.6f", c.cfg.maxFeeXRP))
-		if err != nil {
-			return err
-		}
-		maxFeeUint, err := strconv.ParseUint(maxFeeDrops, 10, 64)
-		if err != nil {
-			return err
-		}
-		if baseFee < maxFeeUint {
-			totalFee = baseFee
-		} else {
-			totalFee = maxFeeUint
-		}
-	}
-
-	(*tx)["Fee"] = strconv.FormatUint(totalFee, 10)
-	return nil
-}
+// registerRequest creates the channel the response with the given id will be delivered to.
+func (c *Client) registerRequest(id int) chan *ClientResponse {
+	// Buffered so the reader never blocks on a requester that already gave up.
+	resChan := make(chan *ClientResponse, 1)
 
-// Sets the latest validated ledger sequence for the transaction.
-// Modifies the `LastLedgerSequence` field in the tx.
-func (c *Client) setLastLedgerSequence(tx *transaction.FlatTransaction) error {
-	index, err := c.GetLedgerIndex()
-	if err != nil {
-		return err
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+	if c.pending == nil {
+		c.pending = make(map[int]chan *ClientResponse)
 	}
+	c.pending[id] = resChan
 
-	(*tx)["LastLedgerSequence"] = index.Uint32() + commonconstants.LedgerOffset
-	return err
+	return resChan
 }
 
-// Checks for any blockers that prevent the deletion of an account.
-// Returns nil if there are no blockers, otherwise returns an error.
-func (c *Client) checkAccountDeleteBlockers(address types.Address) error {
-	accObjects, err := c.GetAccountObjects(&account.ObjectsRequest{
-		Account:              address,
-		LedgerIndex:          common.LedgerTitle("validated"),
-		DeletionBlockersOnly: true,
-	})
-	if err != nil {
-		return err
-	}
-
-	if len(accObjects.AccountObjects) > 0 {
-		return errors.New("account %s cannot be deleted; there are Escrows, PayChannels, RippleStates, or Checks associated with the account")
-	}
-	return nil
+// unregisterRequest removes the pending request with the given id, if still present.
+func (c *Client) unregisterRequest(id int) {
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+	delete(c.pending, id)
 }
 
-func (c *Client) checkPaymentAmounts(tx *transaction.FlatTransaction) error {
-	if _, ok := (*tx)["DeliverMax"]; ok {
-		if _, ok := (*tx)["Amount"]; !ok {
-			(*tx)["Amount"] = (*tx)["DeliverMax"]
-		} else if (*tx)["Amount"] != (*tx)["DeliverMax"] {
-			return errors.New("payment transaction: Amount and DeliverMax fields must be identical when both are provided")
-		}
+// failPendingRequests closes the channel of every pending request, so their
+// callers return ErrConnectionLost instead of waiting for the timeout.
+func (c *Client) failPendingRequests() {
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+	for id, resChan := range c.pending {
+		close(resChan)
+		delete(c.pending, id)
 	}
-	return nil
 }
 
-// Sets a transaction's flags to its numeric representation.
-// TODO: Add flag support for AMMDeposit, AMMWithdraw,
-// NFTTOkenCreateOffer, NFTokenMint, OfferCreate, XChainModifyBridge (not supported).
-func (c *Client) setTransactionFlags(tx *transaction.FlatTransaction) error {
-	flags, ok := (*tx)["Flags"].(uint32)
-	if !ok && flags > 0 {
-		(*tx)["Flags"] = int(0)
-		return nil
-	}
-
-	_, ok = (*tx)["TransactionType"].(string)
-	if !ok {
-		return errors.New("transaction type is missing in transaction")
-	}
-
-	return nil
-}
+func (c *Client) awaitResponse(ctx context.Context, resChan chan *ClientResponse) (*ClientResponse, error) {
+	timeout := time.NewTimer(c.cfg.timeout)
+	defer timeout.Stop()
 
-func (c *Client) awaitResponse(id int) (*ClientResponse, error) {
-	for {
-		select {
-		case res := <-c.requestChan:
-			if res.ID == id {
-				return res, nil
-			}
-		case <-time.After(c.cfg.timeout):
-			return nil, ErrRequestTimedOut
+	select {
+	case res, ok := <-resChan:
+		if !ok {
+			return nil, ErrConnectionLost
 		}
+		return res, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-timeout.C:
+		return nil, ErrRequestTimedOut
 	}
 }
 
 func (c *Client) handleMessage(message []byte) {
 	var stream wstypes.Message
 	c.unmarshalMessage(message, &stream)
-	if stream.IsRequest() {
+	// path_find updates carry the ID of the request that opened them.
+	if stream.IsPathFind() {
+		c.handlePathFind(message)
+	} else if stream.IsRequest() {
 		c.handleRequest(message)
 	} else if stream.IsStream() {
 		c.handleStream(stream.Type, message)
 	}
 }
 
+// handleRequest delivers a response to the request waiting for its ID.
+// Responses nobody is waiting for, e.g. after a timeout, are discarded.
 func (c *Client) handleRequest(message []byte) {
 	var res ClientResponse
 	c.unmarshalMessage(message, &res)
-	c.requestChan <- &res
+
+	c.pendingMu.Lock()
+	resChan, ok := c.pending[res.ID]
+	delete(c.pending, res.ID)
+	c.pendingMu.Unlock()
+
+	if ok {
+		resChan <- &res
+	}
 }
 
 func (c *Client) unmarshalMessage(message []byte, v any) {
@@ -726,6 +316,7 @@ func (c *Client) handleStream(t streamtypes.Type, message []byte) {
 	case streamtypes.LedgerStreamType:
 		var ledger streamtypes.LedgerStream
 		c.unmarshalMessage(message, &ledger)
+		c.trackLedgerIndex(ledger.LedgerIndex)
 
 		if c.ledgerClosedChan != nil {
 			c.ledgerClosedChan <- &ledger
@@ -736,6 +327,13 @@ func (c *Client) handleStream(t streamtypes.Type, message []byte) {
 		if c.transactionChan != nil {
 			c.transactionChan <- &transaction
 		}
+		// Order book updates share the transaction message type, so they are told
+		// apart by the offers the transaction touches.
+		if c.orderBookChan != nil && c.registry().affectsBook(transaction.Meta) {
+			var orderBook streamtypes.OrderBookStream
+			c.unmarshalMessage(message, &orderBook)
+			c.orderBookChan <- &orderBook
+		}
 	case streamtypes.ValidationStreamType:
 		var validation streamtypes.ValidationStream
 		c.unmarshalMessage(message, &validation)
@@ -754,6 +352,12 @@ func (c *Client) handleStream(t streamtypes.Type, message []byte) {
 		if c.consensusChan != nil {
 			c.consensusChan <- &consensus
 		}
+	case streamtypes.BookChangesStreamType:
+		var bookChanges streamtypes.BookChangesStream
+		c.unmarshalMessage(message, &bookChanges)
+		if c.bookChangesChan != nil {
+			c.bookChangesChan <- &bookChanges
+		}
 	default:
 		if c.errChan == nil {
 			c.errChan = make(chan error)
@@ -771,8 +375,16 @@ func (c *Client) readMessages() {
 			return
 		}
 		message, err := c.conn.ReadMessage()
+		switching := false
+		if err != nil {
+			// Requests written to the lost connection will never be answered,
+			// and the server forgets the open path_find request with it.
+			c.failPendingRequests()
+			c.endPathFind()
+			switching = c.switching.Swap(false)
+		}
 		switch {
-		case ws.IsCloseError(err) || ws.IsUnexpectedCloseError(err):
+		case switching || ws.IsCloseError(err) || ws.IsUnexpectedCloseError(err):
 			if retryCount >= maxRetries {
 				if c.errChan == nil {
 					c.errChan = make(chan error)
@@ -781,7 +393,11 @@ func (c *Client) readMessages() {
 				return
 			}
 			retryCount++
-			connErr := c.conn.Connect()
+			// With an endpoint pool, fail over to a healthy endpoint.
+			if e := c.endpoint.Load(); c.pool != nil && e != nil && !switching {
+				c.pool.MarkUnhealthy(e.URL, err)
+			}
+			connErr := c.connect()
 			if connErr != nil {
 				if c.errChan == nil {
 					c.errChan = make(chan error)
@@ -789,6 +405,8 @@ func (c *Client) readMessages() {
 				c.errChan <- connErr
 				return
 			}
+			// Restoring needs responses from this reader, so it can't block it.
+			go c.restoreSubscriptions(retryCount)
 		case err != nil:
 			c.errChan <- err
 			return
@@ -801,295 +419,69 @@ func (c *Client) readMessages() {
 	}
 }
 
-// getSignedTx ensures the transaction is fully signed and returns the transaction blob.
-// If the transaction is already signed, it encodes and returns it. Otherwise, it autofills (if enabled)
-// and signs the transaction using the provided wallet.
-func (c *Client) getSignedTx(tx transaction.FlatTransaction, autofill bool, wallet *wallet.Wallet) (string, error) {
-	// Check if the transaction is already signed: both fields must be non-empty.
-	sig, sigOk := tx["TxSignature"].(string)
-	pubKey, pubKeyOk := tx["SigningPubKey"].(string)
-	if sigOk && sig != "" && pubKeyOk && pubKey != "" {
-		blob, err := binarycodec.Encode(tx)
-		if err != nil {
-			return "", err
-		}
-		return blob, nil
-	}
-
-	// If not signed, ensure a wallet is provided.
-	if wallet == nil {
-		return "", ErrMissingWallet
-	}
-
-	// Optionally autofill the transaction.
-	if autofill {
-		if err := c.Autofill(&tx); err != nil {
-			return "", err
+// trackLedgerIndex records index as the last validated ledger seen, if it is newer.
+func (c *Client) trackLedgerIndex(index common.LedgerIndex) {
+	for {
+		last := c.lastLedgerIndex.Load()
+		if index.Uint32() <= last || c.lastLedgerIndex.CompareAndSwap(last, index.Uint32()) {
+			return
 		}
 	}
-
-	// Sign the transaction.
-	txBlob, _, err := wallet.Sign(tx)
-	if err != nil {
-		return "", err
-	}
-	return txBlob, nil
 }
 
-// fetchOwnerReserveFee fetches the owner reserve fee from the server state.
-// Replicates the JavaScript fetchOwnerReserveFee function.
-func (c *Client) fetchOwnerReserveFee() (uint64, error) {
-	response, err := c.GetServerState(&server.StateRequest{})
-	if err != nil {
-		return 0, err
+// restoreSubscriptions replays every active subscription on a new connection and
+// emits a ReconnectEvent describing the ledgers that may have been missed. It does
+// nothing when the client has no subscriptions.
+func (c *Client) restoreSubscriptions(attempt int) {
+	ctx := context.Background()
+	event := &wstypes.ReconnectEvent{
+		Attempt:         attempt,
+		LastLedgerIndex: common.LedgerIndex(c.lastLedgerIndex.Load()),
 	}
 
-	reserveInc := response.State.ValidatedLedger.ReserveInc
-	if reserveInc == 0 {
-		return 0, errors.New("could not fetch Owner Reserve")
+	req := c.registry().request()
+	if req == nil {
+		return
 	}
-
-	return uint64(reserveInc), nil
-}
-
-// calculateBatchFees calculates the total fees for all inner transactions in a Batch.
-// Replicates the JavaScript logic for Batch transaction fee calculation.
-func (c *Client) calculateBatchFees(tx *transaction.FlatTransaction) (uint64, error) {
-	var totalFees uint64
-
-	// Get RawTransactions from the batch transaction
-	rawTransactions, ok := (*tx)["RawTransactions"].([]map[string]any)
-	if !ok {
-		return 0, errors.New("RawTransactions field missing from Batch transaction")
+	res, err := c.SubscribeWithContext(ctx, req)
+	if err != nil {
+		c.reportError(fmt.Errorf("restore subscriptions: %w", err))
+		return
 	}
+	event.CurrentLedgerIndex = res.LedgerIndex
 
-	// Iterate through each raw transaction
-	for _, rawTx := range rawTransactions {
-		// Extract the actual transaction from the wrapper
-		innerTx, ok := rawTx["RawTransaction"].(map[string]any)
-		if !ok {
-			return 0, errors.New("RawTransaction field missing from wrapper")
-		}
-
-		// Calculate fee for this inner transaction (no multi-signing for inner transactions)
-		innerTxFlat := transaction.FlatTransaction(innerTx)
-		err := c.calculateFeePerTransactionType(&innerTxFlat, 0)
+	// The server only reports the ledger index when the ledger stream is subscribed.
+	if event.CurrentLedgerIndex == 0 {
+		index, err := c.GetLedgerIndexWithContext(ctx)
 		if err != nil {
-			return 0, err
-		}
-
-		// Extract the calculated fee
-		feeStr, ok := innerTx["Fee"].(string)
-		if !ok {
-			return 0, errors.New("fee field missing after calculation")
-		}
-
-		innerTx["Fee"] = "0"
-
-		// Convert fee string to uint64 and add to total
-		feeUint, err := strconv.ParseUint(feeStr, 10, 64)
-		if err != nil {
-			return 0, fmt.Errorf("failed to parse fee '%s': %w", feeStr, err)
+			c.reportError(fmt.Errorf("restore subscriptions: %w", err))
+			return
 		}
-
-		totalFees += feeUint
-	}
-
-	return totalFees, nil
-}
-
-func (c *Client) autofillRawTransactions(tx *transaction.FlatTransaction) error {
-	needsNetworkID, err := c.txNeedsNetworkID()
-	if err != nil {
-		return err
+		event.CurrentLedgerIndex = index
 	}
+	c.trackLedgerIndex(event.CurrentLedgerIndex)
 
-	rawTxs, ok := (*tx)["RawTransactions"].([]map[string]any)
-	if !ok {
-		return ErrRawTransactionsFieldIsNotAnArray
+	if c.reconnectChan != nil {
+		c.reconnectChan <- event
 	}
-
-	accountSeq := make(map[string]uint32, len(rawTxs))
-
-	for _, rawTx := range rawTxs {
-		innerRawTx, ok := rawTx["RawTransaction"].(map[string]any)
-		if !ok {
-			return ErrRawTransactionFieldIsNotAnObject
-		}
-
-		// Validate `Fee` field
-		if innerRawTx["Fee"] == nil {
-			innerRawTx["Fee"] = "0"
-		} else if innerRawTx["Fee"] != "0" {
-			return types.ErrBatchInnerTransactionInvalid
-		}
-
-		// Validate `SigningPubKey` field
-		if innerRawTx["SigningPubKey"] == nil {
-			innerRawTx["SigningPubKey"] = ""
-		} else if innerRawTx["SigningPubKey"] != "" {
-			return ErrSigningPubKeyFieldMustBeEmpty
-		}
-
-		// Validate `TxnSignature` field
-		if innerRawTx["TxnSignature"] != nil {
-			return ErrTxnSignatureFieldMustBeEmpty
-		}
-		if innerRawTx["Signers"] != nil {
-			return ErrSignersFieldMustBeEmpty
-		}
-
-		// Validate `NetworkID` field
-		if innerRawTx["NetworkID"] == nil && needsNetworkID {
-			innerRawTx["NetworkID"] = c.NetworkID
-		}
-
-		// Validate `Sequence` field
-		if innerRawTx["Sequence"] == nil && innerRawTx["TicketSequence"] == nil {
-
-			acc, ok := innerRawTx["Account"].(string)
-			if !ok {
-				return ErrAccountFieldIsNotAString
-			}
-
-			if accountSeq[acc] != 0 {
-				innerRawTx["Sequence"] = accountSeq[acc]
-				accountSeq[acc]++
-			} else {
-				accountInfo, err := c.GetAccountInfo(&account.InfoRequest{
-					Account: types.Address(acc),
-				})
-				if err != nil {
-					return err
-				}
-				var seq uint32
-				if innerRawTx["Account"] == (*tx)["Account"] {
-					seq = accountInfo.AccountData.Sequence + 1
-				} else {
-					seq = accountInfo.AccountData.Sequence
-				}
-				accountSeq[acc] = seq + 1
-				innerRawTx["Sequence"] = seq
-			}
-		}
-	}
-
-	return nil
 }
 
-// isNotLaterRippledVersion determines whether the source rippled version is not later than the target rippled version.
-// Example usage: isNotLaterRippledVersion("1.10.0", "1.11.0") returns true.
-//
-//	isNotLaterRippledVersion("1.10.0", "1.10.0-b1") returns false.
-func isNotLaterRippledVersion(source, target string) bool {
-	if source == target {
-		return true
-	}
-
-	sourceDecomp := strings.Split(source, ".")
-	targetDecomp := strings.Split(target, ".")
-
-	if len(sourceDecomp) < 3 || len(targetDecomp) < 3 {
-		return false
-	}
-
-	sourceMajor, err := strconv.Atoi(sourceDecomp[0])
-	if err != nil {
-		return false
-	}
-	sourceMinor, err := strconv.Atoi(sourceDecomp[1])
-	if err != nil {
-		return false
-	}
-	targetMajor, err := strconv.Atoi(targetDecomp[0])
-	if err != nil {
-		return false
-	}
-	targetMinor, err := strconv.Atoi(targetDecomp[1])
-	if err != nil {
-		return false
-	}
-
-	// Compare major version
-	if sourceMajor != targetMajor {
-		return sourceMajor < targetMajor
-	}
-
-	// Compare minor version
-	if sourceMinor != targetMinor {
-		return sourceMinor < targetMinor
-	}
-
-	sourcePatch := strings.Split(sourceDecomp[2], "-")
-	targetPatch := strings.Split(targetDecomp[2], "-")
-
-	sourcePatchVersion, err := strconv.Atoi(sourcePatch[0])
-	if err != nil {
-		return false
-	}
-	targetPatchVersion, err := strconv.Atoi(targetPatch[0])
-	if err != nil {
-		return false
-	}
-
-	// Compare patch version
-	if sourcePatchVersion != targetPatchVersion {
-		return sourcePatchVersion < targetPatchVersion
-	}
-
-	// Compare release version
-	if len(sourcePatch) != len(targetPatch) {
-		return len(sourcePatch) > len(targetPatch)
-	}
-
-	if len(sourcePatch) == 2 {
-		// Compare different release types
-		if !strings.HasPrefix(sourcePatch[1], string(targetPatch[1][0])) {
-			return sourcePatch[1] < targetPatch[1]
-		}
-
-		// Compare beta version
-		if strings.HasPrefix(sourcePatch[1], "b") {
-			sourceBeta, err := strconv.Atoi(sourcePatch[1][1:])
-			if err != nil {
-				return false
-			}
-			targetBeta, err := strconv.Atoi(targetPatch[1][1:])
-			if err != nil {
-				return false
-			}
-			return sourceBeta < targetBeta
-		}
-
-		// Compare rc version
-		if strings.HasPrefix(sourcePatch[1], "rc") {
-			sourceRC, err := strconv.Atoi(sourcePatch[1][2:])
-			if err != nil {
-				return false
-			}
-			targetRC, err := strconv.Atoi(targetPatch[1][2:])
-			if err != nil {
-				return false
-			}
-			return sourceRC < targetRC
+// registry returns the active subscriptions of the client, creating the registry
+// if the client was not built with NewClient.
+func (c *Client) registry() *subscriptionRegistry {
+	c.subscriptionsOnce.Do(func() {
+		if c.subscriptions == nil {
+			c.subscriptions = newSubscriptionRegistry()
 		}
-	}
-
-	return false
+	})
+	return c.subscriptions
 }
 
-// txNeedsNetworkID determines if the transaction required a networkID to be valid.
-// Transaction needs networkID if later than restricted ID and build version is >= 1.11.0
-func (c *Client) txNeedsNetworkID() (bool, error) {
-	if c.NetworkID != 0 && c.NetworkID > RestrictedNetworks {
-		res, err := c.GetServerInfo(&server.InfoRequest{})
-		if err != nil {
-			return false, err
-		}
-
-		if res.Info.BuildVersion != "" {
-			return isNotLaterRippledVersion(RequiredNetworkIDVersion, res.Info.BuildVersion), nil
-		}
+// reportError passes err to the OnError handler, dropping it when no handler is
+// ready to receive it, so background goroutines never block on the error channel.
+func (c *Client) reportError(err error) {
+	select {
+	case c.errChan <- err:
+	default:
 	}
-	return false, nil
 }
@@ -3,6 +3,7 @@ package websocket
 import (
 	"time"
 
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
 	"github.com/Peersyst/xrpl-go/xrpl/common"
 )
 
@@ -20,6 +21,14 @@ type ClientConfig struct {
 
 	// Faucet config
 	faucetProvider common.FaucetProvider
+
+	// Binary codec config
+	definitions *definitions.Definitions
+
+	// Endpoint pool config
+	endpoints           []Endpoint
+	maxLedgerLag        uint32
+	healthCheckInterval time.Duration
 }
 
 func NewClientConfig() *ClientConfig {
@@ -31,6 +40,9 @@ func NewClientConfig() *ClientConfig {
 		maxReconnects: common.DefaultMaxReconnects,
 		retryDelay:    common.DefaultRetryDelay,
 		timeout:       common.DefaultTimeout,
+
+		maxLedgerLag:        common.DefaultMaxLedgerLag,
+		healthCheckInterval: common.DefaultHealthCheckInterval,
 	}
 }
 
@@ -89,3 +101,41 @@ func (wc ClientConfig) WithTimeout(timeout time.Duration) ClientConfig {
 	wc.timeout = timeout
 	return wc
 }
+
+// WithDefinitions sets the binary codec definitions the client encodes and decodes
+// transactions with.
+// Default: the definitions embedded in the binary codec
+func (wc ClientConfig) WithDefinitions(d *definitions.Definitions) ClientConfig {
+	wc.definitions = d
+	return wc
+}
+
+// WithEndpoints sets the rippled and Clio endpoints of an endpoint pool, in order of
+// preference. Connect uses the first healthy one that is synced with the network, and
+// the client moves to another one when the connection is lost or the endpoint falls
+// behind. Clio-only methods are sent to a Clio endpoint. The host is set to the first
+// endpoint.
+// Default: no pool, the client only connects to its host
+func (wc ClientConfig) WithEndpoints(endpoints ...Endpoint) ClientConfig {
+	wc.endpoints = endpoints
+	if len(endpoints) > 0 {
+		wc.host = endpoints[0].URL
+	}
+	return wc
+}
+
+// WithMaxLedgerLag sets how many ledgers a pool endpoint can fall behind the most
+// advanced one before it is considered unhealthy.
+// Default: 3
+func (wc ClientConfig) WithMaxLedgerLag(maxLedgerLag uint32) ClientConfig {
+	wc.maxLedgerLag = maxLedgerLag
+	return wc
+}
+
+// WithHealthCheckInterval sets how often the health of the pool endpoints is checked
+// while the client is connected. Zero disables the periodic checks.
+// Default: 30 seconds
+func (wc ClientConfig) WithHealthCheckInterval(interval time.Duration) ClientConfig {
+	wc.healthCheckInterval = interval
+	return wc
+}
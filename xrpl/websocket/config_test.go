@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Peersyst/xrpl-go/binary-codec/definitions"
 	"github.com/Peersyst/xrpl-go/xrpl/common"
 	"github.com/Peersyst/xrpl-go/xrpl/faucet"
 	"github.com/stretchr/testify/require"
@@ -17,6 +18,8 @@ func TestNewClientConfig(t *testing.T) {
 	require.Equal(t, config.feeCushion, common.DefaultFeeCushion)
 	require.Equal(t, config.maxFeeXRP, common.DefaultMaxFeeXRP)
 	require.Equal(t, config.timeout, common.DefaultTimeout)
+	require.Equal(t, config.maxLedgerLag, common.DefaultMaxLedgerLag)
+	require.Equal(t, config.healthCheckInterval, common.DefaultHealthCheckInterval)
 }
 
 func TestWithMaxRetries(t *testing.T) {
@@ -29,6 +32,16 @@ func TestWithRetryDelay(t *testing.T) {
 	require.Equal(t, config.retryDelay, 2*time.Second)
 }
 
+func TestWithMaxLedgerLag(t *testing.T) {
+	config := NewClientConfig().WithMaxLedgerLag(10)
+	require.Equal(t, config.maxLedgerLag, uint32(10))
+}
+
+func TestWithHealthCheckInterval(t *testing.T) {
+	config := NewClientConfig().WithHealthCheckInterval(time.Minute)
+	require.Equal(t, config.healthCheckInterval, time.Minute)
+}
+
 func TestWithFeeCushion(t *testing.T) {
 	config := NewClientConfig().WithFeeCushion(1.5)
 	require.Equal(t, config.feeCushion, float32(1.5))
@@ -48,3 +61,10 @@ func TestWithTimeout(t *testing.T) {
 	config := NewClientConfig().WithTimeout(10 * time.Second)
 	require.Equal(t, config.timeout, 10*time.Second)
 }
+
+func TestWithDefinitions(t *testing.T) {
+	d := definitions.Get()
+	config := NewClientConfig().WithDefinitions(d)
+	require.Equal(t, config.definitions, d)
+	require.Equal(t, NewClient(config).Definitions(), d)
+}
@@ -3,6 +3,7 @@ package websocket
 import (
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/gorilla/websocket"
 )
@@ -41,13 +42,33 @@ func (c *Connection) Connect() error {
 	return nil
 }
 
+// setURL sets the url the next Connect dials.
+func (c *Connection) setURL(url string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.url = url
+}
+
+// setReadDeadline sets the deadline of the reads on the connection. A zero t
+// means reads don't time out.
+func (c *Connection) setReadDeadline(t time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		return ErrNotConnected
+	}
+	return c.conn.SetReadDeadline(t)
+}
+
 // Disconnect closes the websocket connection and sets the connection to nil.
 // It returns an error if the connection is not connected.
 func (c *Connection) Disconnect() error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if !c.IsConnected() {
+	if c.conn == nil {
 		return ErrNotConnected
 	}
 
@@ -60,6 +81,9 @@ func (c *Connection) Disconnect() error {
 
 // IsConnected returns true if the connection is connected.
 func (c *Connection) IsConnected() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	return c.conn != nil
 }
 
@@ -67,10 +91,14 @@ func (c *Connection) IsConnected() bool {
 // It returns the message and an error if the message is not read.
 // This method is blocking, it will block until a message is read.
 func (c *Connection) ReadMessage() ([]byte, error) {
-	if !c.IsConnected() {
+	c.mu.Lock()
+	conn := c.conn
+	c.mu.Unlock()
+
+	if conn == nil {
 		return nil, ErrNotConnected
 	}
-	_, message, err := c.conn.ReadMessage()
+	_, message, err := conn.ReadMessage()
 	if err != nil {
 		return nil, err
 	}
@@ -79,8 +107,12 @@ func (c *Connection) ReadMessage() ([]byte, error) {
 
 // WriteMessage writes a message to the connection.
 // It returns an error if the message is not written.
+// Writes are serialized, as the underlying connection supports a single concurrent writer.
 func (c *Connection) WriteMessage(message []byte) error {
-	if !c.IsConnected() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
 		return ErrNotConnected
 	}
 	return c.conn.WriteMessage(websocket.TextMessage, message)
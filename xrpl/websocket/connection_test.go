@@ -1,8 +1,11 @@
 package websocket
 
 import (
+	"sync"
 	"testing"
 
+	"github.com/Peersyst/xrpl-go/xrpl/websocket/testutil"
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/require"
 )
 
@@ -33,3 +36,31 @@ func TestConnection_IsConnected(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, conn.IsConnected())
 }
+
+func TestConnection_ConcurrentDisconnect(t *testing.T) {
+	ms := &testutil.MockWebSocketServer{}
+	s := ms.TestWebSocketServer(func(c *websocket.Conn) {
+		_, _, _ = c.ReadMessage()
+	})
+	defer s.Close()
+
+	url, _ := testutil.ConvertHTTPToWS(s.URL)
+	conn := NewConnection(url)
+	require.NoError(t, conn.Connect())
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			conn.IsConnected()
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		require.NoError(t, conn.Disconnect())
+	}()
+	wg.Wait()
+
+	require.False(t, conn.IsConnected())
+}
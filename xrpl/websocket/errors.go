@@ -1,28 +1,26 @@
 package websocket
 
-import "errors"
+import "github.com/Peersyst/xrpl-go/xrpl/client"
 
-// Static errors
+// Static errors returned by the autofill and submission methods of client.Core.
 var (
-	ErrMissingTxSignatureOrSigningPubKey      = errors.New("transaction must have a TxSignature or SigningPubKey set")
-	ErrMissingLastLedgerSequenceInTransaction = errors.New("missing LastLedgerSequence in transaction")
-	ErrMissingWallet                          = errors.New("wallet must be provided when submitting an unsigned transaction")
+	ErrMissingTxSignatureOrSigningPubKey      = client.ErrMissingTxSignatureOrSigningPubKey
+	ErrSignerDataIsEmpty                      = client.ErrSignerDataIsEmpty
+	ErrCannotFundWalletWithoutClassicAddress  = client.ErrCannotFundWalletWithoutClassicAddress
+	ErrMissingLastLedgerSequenceInTransaction = client.ErrMissingLastLedgerSequenceInTransaction
+	ErrMissingWallet                          = client.ErrMissingWallet
 
-	ErrRawTransactionsFieldIsNotAnArray = errors.New("RawTransactions field is not an array")
-	ErrRawTransactionFieldIsNotAnObject = errors.New("RawTransaction field is not an object")
+	ErrRawTransactionsFieldIsNotAnArray = client.ErrRawTransactionsFieldIsNotAnArray
+	ErrRawTransactionFieldIsNotAnObject = client.ErrRawTransactionFieldIsNotAnObject
 
-	ErrSigningPubKeyFieldMustBeEmpty = errors.New("SigningPubKey field must be empty")
-	ErrTxnSignatureFieldMustBeEmpty  = errors.New("TxnSignature field must be empty")
-	ErrSignersFieldMustBeEmpty       = errors.New("Signers field must be empty")
-	ErrAccountFieldIsNotAString      = errors.New("Account field is not a string")
+	ErrSigningPubKeyFieldMustBeEmpty = client.ErrSigningPubKeyFieldMustBeEmpty
+	ErrTxnSignatureFieldMustBeEmpty  = client.ErrTxnSignatureFieldMustBeEmpty
+	ErrSignersFieldMustBeEmpty       = client.ErrSignersFieldMustBeEmpty
+	ErrAccountFieldIsNotAString      = client.ErrAccountFieldIsNotAString
 )
 
 // Dynamic errors
 
-type ClientError struct {
-	ErrorString string
-}
-
-func (e *ClientError) Error() string {
-	return e.ErrorString
-}
+// ClientError is an error reported by the client. It is an alias of client.ClientError,
+// so errors returned by the embedded client.Core match it with errors.As.
+type ClientError = client.ClientError
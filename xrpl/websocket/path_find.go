@@ -0,0 +1,204 @@
+package websocket
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/path"
+)
+
+// PathFind is an open path_find request. The server keeps looking for better paths
+// and sends an updated set of alternatives whenever it finds them, or at least every
+// time a new ledger closes. A connection can only have one open path_find request,
+// so opening a new one ends the previous one.
+type PathFind struct {
+	client *Client
+
+	// Updates received before the response that opened the session are queued,
+	// so they are delivered after it.
+	mu      sync.Mutex
+	started bool
+	queued  []*path.FindResponse
+
+	in        chan *path.FindResponse
+	updates   chan *path.FindResponse
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// OpenPathFind opens a path_find request and returns the session delivering its
+// alternatives. The first update is the server's response to the request.
+func (c *Client) OpenPathFind(req *path.FindCreateRequest) (*PathFind, error) {
+	return c.OpenPathFindWithContext(context.Background(), req)
+}
+
+// OpenPathFindWithContext is like OpenPathFind but uses ctx for cancellation and deadlines.
+func (c *Client) OpenPathFindWithContext(ctx context.Context, req *path.FindCreateRequest) (*PathFind, error) {
+	req.Subcommand = path.Create
+
+	p := &PathFind{
+		client:  c,
+		in:      make(chan *path.FindResponse),
+		updates: make(chan *path.FindResponse),
+		done:    make(chan struct{}),
+	}
+	go p.forward()
+
+	// The session is registered before sending the request, as updates can be sent
+	// before the response is handled.
+	c.setPathFind(p)
+
+	res, err := c.FindPathCreateWithContext(ctx, req)
+	if err != nil {
+		c.clearPathFind(p)
+		p.end()
+		return nil, err
+	}
+	p.start(res)
+	return p, nil
+}
+
+// Updates returns the channel delivering each updated set of alternatives.
+// The channel is closed once the session ends, discarding the updates not read yet.
+func (p *PathFind) Updates() <-chan *path.FindResponse {
+	return p.updates
+}
+
+// Done returns a channel that is closed once the session ends, either because it
+// was closed, replaced by a new path_find request or the connection was lost.
+func (p *PathFind) Done() <-chan struct{} {
+	return p.done
+}
+
+// Close closes the path_find request on the server and ends the session.
+func (p *PathFind) Close() error {
+	return p.CloseWithContext(context.Background())
+}
+
+// CloseWithContext is like Close but uses ctx for cancellation and deadlines.
+func (p *PathFind) CloseWithContext(ctx context.Context) error {
+	defer p.end()
+
+	// The server has already forgotten a request that was replaced or lost.
+	if !p.client.clearPathFind(p) {
+		return nil
+	}
+	_, err := p.client.FindPathCloseWithContext(ctx, &path.FindCloseRequest{Subcommand: path.Close})
+	return err
+}
+
+// start delivers the response that opened the session, followed by the updates
+// received while waiting for it.
+func (p *PathFind) start(res *path.FindResponse) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.deliver(res)
+	for _, update := range p.queued {
+		p.deliver(update)
+	}
+	p.queued = nil
+	p.started = true
+}
+
+// update delivers an asynchronous update, or queues it until the session starts.
+func (p *PathFind) update(res *path.FindResponse) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.started {
+		p.queued = append(p.queued, res)
+		return
+	}
+	p.deliver(res)
+}
+
+// deliver hands an update to the forwarding goroutine, unless the session ended.
+func (p *PathFind) deliver(res *path.FindResponse) {
+	select {
+	case p.in <- res:
+	case <-p.done:
+	}
+}
+
+// forward buffers updates until the consumer reads them, so a slow consumer never
+// blocks the connection. It is the only sender on the updates channel and closes it
+// once the session ends.
+func (p *PathFind) forward() {
+	defer close(p.updates)
+
+	var queue []*path.FindResponse
+	for {
+		var out chan *path.FindResponse
+		var next *path.FindResponse
+		if len(queue) > 0 {
+			out, next = p.updates, queue[0]
+		}
+		select {
+		case res := <-p.in:
+			queue = append(queue, res)
+		case out <- next:
+			queue = queue[1:]
+		case <-p.done:
+			return
+		}
+	}
+}
+
+func (p *PathFind) end() {
+	p.closeOnce.Do(func() {
+		close(p.done)
+	})
+}
+
+// setPathFind makes p the open path_find session, ending the previous one.
+func (c *Client) setPathFind(p *PathFind) {
+	c.pathFindMu.Lock()
+	prev := c.pathFind
+	c.pathFind = p
+	c.pathFindMu.Unlock()
+
+	if prev != nil {
+		prev.end()
+	}
+}
+
+// clearPathFind forgets p if it is still the open path_find session.
+// It reports whether p was open.
+func (c *Client) clearPathFind(p *PathFind) bool {
+	c.pathFindMu.Lock()
+	defer c.pathFindMu.Unlock()
+
+	if c.pathFind != p {
+		return false
+	}
+	c.pathFind = nil
+	return true
+}
+
+// endPathFind ends the open path_find session, if any.
+func (c *Client) endPathFind() {
+	c.pathFindMu.Lock()
+	p := c.pathFind
+	c.pathFind = nil
+	c.pathFindMu.Unlock()
+
+	if p != nil {
+		p.end()
+	}
+}
+
+// handlePathFind delivers an asynchronous path_find update to the open session.
+// Updates nobody is waiting for are discarded.
+func (c *Client) handlePathFind(message []byte) {
+	var res path.FindResponse
+	c.unmarshalMessage(message, &res)
+
+	c.pathFindMu.Lock()
+	p := c.pathFind
+	c.pathFindMu.Unlock()
+
+	if p != nil {
+		p.update(&res)
+	}
+}
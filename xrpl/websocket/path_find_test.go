@@ -0,0 +1,139 @@
+package websocket
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/path"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/websocket/testutil"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+func pathFindMessage(id any, sourceAmount any) map[string]any {
+	return map[string]any{
+		"source_account":      "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+		"destination_account": "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH",
+		"destination_amount":  map[string]any{"currency": "USD", "issuer": "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B", "value": "10"},
+		"full_reply":          true,
+		"alternatives": []any{
+			map[string]any{
+				"paths_computed": []any{},
+				"source_amount":  sourceAmount,
+			},
+		},
+	}
+}
+
+func receivePathFindUpdate(t *testing.T, pf *PathFind) *path.FindResponse {
+	t.Helper()
+	select {
+	case res, ok := <-pf.Updates():
+		require.True(t, ok, "updates channel closed")
+		return res
+	case <-time.After(2 * time.Second):
+		t.Fatal("path_find update was not delivered")
+		return nil
+	}
+}
+
+func TestClient_OpenPathFind(t *testing.T) {
+	closeReqs := make(chan map[string]any, 1)
+
+	ms := &testutil.MockWebSocketServer{}
+	s := ms.TestWebSocketServer(func(c *websocket.Conn) {
+		var req map[string]any
+		if err := c.ReadJSON(&req); err != nil {
+			return
+		}
+		_ = c.WriteJSON(map[string]any{"id": req["id"], "type": "response", "result": pathFindMessage(req["id"], "1000")})
+
+		update := pathFindMessage(req["id"], "900")
+		update["type"] = "path_find"
+		update["id"] = req["id"]
+		_ = c.WriteJSON(update)
+		update = pathFindMessage(req["id"], map[string]any{"currency": "EUR", "issuer": "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B", "value": "9"})
+		update["type"] = "path_find"
+		update["id"] = req["id"]
+		_ = c.WriteJSON(update)
+
+		if err := c.ReadJSON(&req); err != nil {
+			return
+		}
+		closeReqs <- req
+		_ = c.WriteJSON(map[string]any{"id": req["id"], "type": "response", "result": map[string]any{"closed": true}})
+		// Keep the connection open until the client disconnects.
+		_, _, _ = c.ReadMessage()
+	})
+	defer s.Close()
+
+	url, _ := testutil.ConvertHTTPToWS(s.URL)
+	cl := NewClient(NewClientConfig().WithHost(url).WithTimeout(2 * time.Second))
+	require.NoError(t, cl.Connect())
+	defer cl.Disconnect()
+
+	pf, err := cl.OpenPathFind(&path.FindCreateRequest{
+		SourceAccount:      "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+		DestinationAccount: "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH",
+		DestinationAmount:  types.IssuedCurrencyAmount{Currency: "USD", Issuer: "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B", Value: "10"},
+	})
+	require.NoError(t, err)
+
+	expectedDestination := types.IssuedCurrencyAmount{Currency: "USD", Issuer: "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B", Value: "10"}
+
+	res := receivePathFindUpdate(t, pf)
+	require.Equal(t, expectedDestination, res.DestinationAmount)
+	require.Equal(t, types.XRPCurrencyAmount(1000), res.Alternatives[0].SourceAmount)
+
+	res = receivePathFindUpdate(t, pf)
+	require.Equal(t, types.XRPCurrencyAmount(900), res.Alternatives[0].SourceAmount)
+
+	res = receivePathFindUpdate(t, pf)
+	require.Equal(t, expectedDestination, res.DestinationAmount)
+	require.Equal(t, types.IssuedCurrencyAmount{Currency: "EUR", Issuer: "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B", Value: "9"}, res.Alternatives[0].SourceAmount)
+
+	require.NoError(t, pf.Close())
+	require.Equal(t, "path_find", (<-closeReqs)["command"])
+
+	_, ok := <-pf.Updates()
+	require.False(t, ok)
+	<-pf.Done()
+}
+
+func TestClient_OpenPathFind_Replaced(t *testing.T) {
+	ms := &testutil.MockWebSocketServer{}
+	s := ms.TestWebSocketServer(func(c *websocket.Conn) {
+		for {
+			var req map[string]any
+			if err := c.ReadJSON(&req); err != nil {
+				return
+			}
+			_ = c.WriteJSON(map[string]any{"id": req["id"], "type": "response", "result": pathFindMessage(req["id"], "1000")})
+		}
+	})
+	defer s.Close()
+
+	url, _ := testutil.ConvertHTTPToWS(s.URL)
+	cl := NewClient(NewClientConfig().WithHost(url).WithTimeout(2 * time.Second))
+	require.NoError(t, cl.Connect())
+	defer cl.Disconnect()
+
+	first, err := cl.OpenPathFind(&path.FindCreateRequest{})
+	require.NoError(t, err)
+	receivePathFindUpdate(t, first)
+
+	second, err := cl.OpenPathFind(&path.FindCreateRequest{})
+	require.NoError(t, err)
+
+	select {
+	case <-first.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("replaced path_find session was not ended")
+	}
+	// Closing a replaced session does not close the open request on the server.
+	require.NoError(t, first.Close())
+
+	receivePathFindUpdate(t, second)
+	require.NoError(t, second.Close())
+}
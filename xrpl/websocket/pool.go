@@ -0,0 +1,265 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/client"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/utility"
+	"github.com/Peersyst/xrpl-go/xrpl/websocket/interfaces"
+)
+
+var (
+	ErrNoClioEndpoint     = client.ErrNoClioEndpoint
+	ErrAllEndpointsFailed = client.ErrAllEndpointsFailed
+	ErrEndpointLagging    = client.ErrEndpointLagging
+	ErrEndpointNotSynced  = client.ErrEndpointNotSynced
+)
+
+// EndpointKind is the server software behind a pool endpoint.
+type EndpointKind = client.EndpointKind
+
+const (
+	RippledEndpoint = client.RippledEndpoint
+	ClioEndpoint    = client.ClioEndpoint
+)
+
+// Endpoint is a websocket server the client can connect to.
+type Endpoint = client.Endpoint
+
+// EndpointStatus is the last known health of a pool endpoint.
+type EndpointStatus = client.EndpointStatus
+
+// connect opens the connection. With an endpoint pool, it tries the healthy
+// endpoints first, in order of preference, and keeps the first one that is synced
+// with the network. The endpoints that fail are marked unhealthy.
+func (c *Client) connect() error {
+	if c.pool == nil {
+		return c.conn.Connect()
+	}
+
+	endpoints, err := c.pool.Preferred("")
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, e := range endpoints {
+		c.conn.setURL(e.URL)
+		if err := c.conn.Connect(); err != nil {
+			c.pool.MarkUnhealthy(e.URL, err)
+			errs = append(errs, fmt.Errorf("%s: %w", e.URL, err))
+			continue
+		}
+		if _, err := c.checkConnection(context.Background(), c.conn, e.Kind); err != nil {
+			_ = c.conn.Disconnect()
+			c.pool.MarkUnhealthy(e.URL, err)
+			errs = append(errs, fmt.Errorf("%s: %w", e.URL, err))
+			continue
+		}
+		c.endpoint.Store(&e)
+		return nil
+	}
+	return fmt.Errorf("%w: %w", ErrAllEndpointsFailed, errors.Join(errs...))
+}
+
+// checkEndpoint opens a connection to the endpoint, pings it and returns its latest
+// validated ledger from server_info.
+func (c *Client) checkEndpoint(ctx context.Context, e Endpoint) (common.LedgerIndex, error) {
+	conn := NewConnection(e.URL)
+	if err := conn.Connect(); err != nil {
+		return 0, err
+	}
+	defer conn.Disconnect()
+
+	return c.checkConnection(ctx, conn, e.Kind)
+}
+
+// checkConnection pings the server at the other end of conn and returns its latest
+// validated ledger from server_info, or an error if it is not synced with the network.
+func (c *Client) checkConnection(ctx context.Context, conn *Connection, kind EndpointKind) (common.LedgerIndex, error) {
+	if _, err := c.roundTrip(ctx, conn, &utility.PingRequest{}); err != nil {
+		return 0, err
+	}
+	res, err := c.roundTrip(ctx, conn, &server.InfoRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return client.ValidatedLedgerIndex(kind, res)
+}
+
+// roundTrip sends req on conn and reads the connection directly until its response
+// arrives, so it must not run while readMessages reads conn. It gives up after the
+// client timeout, or earlier if ctx has an earlier deadline.
+func (c *Client) roundTrip(ctx context.Context, conn *Connection, req interfaces.Request) (*ClientResponse, error) {
+	id := int(c.idCounter.Add(1))
+	msg, err := c.formatRequest(req, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.WriteMessage(msg); err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(c.cfg.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := conn.setReadDeadline(deadline); err != nil {
+		return nil, err
+	}
+	var res ClientResponse
+	for res.ID != id {
+		message, err := conn.ReadMessage()
+		if err != nil {
+			return nil, err
+		}
+		// Streams and other messages don't carry the request ID.
+		res = ClientResponse{}
+		_ = json.Unmarshal(message, &res)
+	}
+	if err := conn.setReadDeadline(time.Time{}); err != nil {
+		return nil, err
+	}
+	if err := res.CheckError(); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// clioRequest sends a Clio-only request over a separate connection to a Clio
+// endpoint, moving on to the next one when an endpoint can't be reached.
+func (c *Client) clioRequest(ctx context.Context, req interfaces.Request) (*ClientResponse, error) {
+	endpoints, err := c.pool.Candidates(req.Method())
+	if err != nil {
+		return nil, err
+	}
+
+	var lastErr error
+	for _, e := range endpoints {
+		conn := NewConnection(e.URL)
+		if err := conn.Connect(); err != nil {
+			c.pool.MarkUnhealthy(e.URL, err)
+			lastErr = err
+			continue
+		}
+		res, err := c.roundTrip(ctx, conn, req)
+		_ = conn.Disconnect()
+		if err != nil {
+			var xrplErr *ErrorWebsocketClientXrplResponse
+			if errors.As(err, &xrplErr) || ctx.Err() != nil {
+				return nil, err
+			}
+			c.pool.MarkUnhealthy(e.URL, err)
+			lastErr = err
+			continue
+		}
+		return res, nil
+	}
+	return nil, fmt.Errorf("%w: %w", ErrAllEndpointsFailed, lastErr)
+}
+
+// monitorEndpoints checks the health of the pool endpoints every health check
+// interval until stop is closed, moving to a healthy endpoint when the current one
+// is not.
+func (c *Client) monitorEndpoints(stop chan struct{}) {
+	ticker := time.NewTicker(c.pool.HealthCheckInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			c.CheckEndpoints()
+		}
+	}
+}
+
+// startMonitor starts checking the pool endpoints in the background, if the client
+// has a pool with a health check interval and isn't checking them already.
+func (c *Client) startMonitor() {
+	if c.pool == nil || c.pool.HealthCheckInterval() <= 0 {
+		return
+	}
+	c.monitorMu.Lock()
+	defer c.monitorMu.Unlock()
+
+	if c.monitorStop != nil {
+		return
+	}
+	c.monitorStop = make(chan struct{})
+	go c.monitorEndpoints(c.monitorStop)
+}
+
+// stopMonitor stops the background checks of the pool endpoints.
+func (c *Client) stopMonitor() {
+	c.monitorMu.Lock()
+	defer c.monitorMu.Unlock()
+
+	if c.monitorStop != nil {
+		close(c.monitorStop)
+		c.monitorStop = nil
+	}
+}
+
+// moveToHealthyEndpoint closes the connection when the current endpoint is
+// unhealthy and another one is healthy, so readMessages reconnects to it.
+func (c *Client) moveToHealthyEndpoint() {
+	current := c.endpoint.Load()
+	if current == nil || !c.IsConnected() {
+		return
+	}
+	if status, ok := c.pool.Status(current.URL); !ok || status.Healthy {
+		return
+	}
+	endpoints, err := c.pool.Preferred("")
+	if err != nil || len(endpoints) == 0 {
+		return
+	}
+	if status, ok := c.pool.Status(endpoints[0].URL); !ok || !status.Healthy {
+		return
+	}
+	c.switching.Store(true)
+	_ = c.conn.Disconnect()
+}
+
+// Endpoint returns the host the client connects to. With an endpoint pool, it is
+// the endpoint the client last connected to.
+func (c *Client) Endpoint() string {
+	if e := c.endpoint.Load(); e != nil {
+		return e.URL
+	}
+	return c.cfg.host
+}
+
+// EndpointStatuses returns the last known health of every endpoint of the pool.
+// It returns nil if the client was not configured with WithEndpoints.
+func (c *Client) EndpointStatuses() []EndpointStatus {
+	if c.pool == nil {
+		return nil
+	}
+	return c.pool.Statuses()
+}
+
+// CheckEndpoints checks the health of every endpoint of the pool right away,
+// instead of waiting for the health check interval, and returns their statuses.
+// A connected client moves to a healthy endpoint if the current one is not.
+// It returns nil if the client was not configured with WithEndpoints.
+func (c *Client) CheckEndpoints() []EndpointStatus {
+	return c.CheckEndpointsWithContext(context.Background())
+}
+
+// CheckEndpointsWithContext is like CheckEndpoints but uses ctx for cancellation and deadlines.
+func (c *Client) CheckEndpointsWithContext(ctx context.Context) []EndpointStatus {
+	if c.pool == nil {
+		return nil
+	}
+	statuses := c.pool.Check(ctx, c.checkEndpoint)
+	c.moveToHealthyEndpoint()
+	return statuses
+}
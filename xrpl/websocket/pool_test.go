@@ -0,0 +1,246 @@
+package websocket
+
+import (
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/clio"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/utility"
+	"github.com/Peersyst/xrpl-go/xrpl/websocket/testutil"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeNode is a websocket server answering server_info with its state, and every
+// other request with an empty result, recording the commands it receives.
+type fakeNode struct {
+	url string
+
+	mu          sync.Mutex
+	calls       []string
+	serverState string
+	ledgerIndex uint32
+	// When set, the node closes the connection after answering that many
+	// requests other than ping and server_info.
+	closeAfter int
+}
+
+func newFakeNode(t *testing.T, serverState string, closeAfter int) *fakeNode {
+	n := &fakeNode{serverState: serverState, ledgerIndex: 100, closeAfter: closeAfter}
+	ms := &testutil.MockWebSocketServer{}
+	s := ms.TestWebSocketServer(func(c *websocket.Conn) {
+		answered := 0
+		for {
+			var req map[string]any
+			if err := c.ReadJSON(&req); err != nil {
+				return
+			}
+			command, _ := req["command"].(string)
+
+			n.mu.Lock()
+			n.calls = append(n.calls, command)
+			result := map[string]any{}
+			if command == "server_info" {
+				result = map[string]any{"info": map[string]any{
+					"server_state":     n.serverState,
+					"validated_ledger": map[string]any{"seq": n.ledgerIndex},
+				}}
+			}
+			closeAfter := n.closeAfter
+			n.mu.Unlock()
+
+			if err := c.WriteJSON(map[string]any{"id": req["id"], "result": result}); err != nil {
+				return
+			}
+			if command == "server_info" || command == "ping" {
+				continue
+			}
+			answered++
+			if closeAfter > 0 && answered >= closeAfter {
+				_ = c.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, ""))
+				return
+			}
+		}
+	})
+	t.Cleanup(s.Close)
+	n.url = wsURL(t, s)
+	return n
+}
+
+func (n *fakeNode) count(command string) int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	count := 0
+	for _, c := range n.calls {
+		if c == command {
+			count++
+		}
+	}
+	return count
+}
+
+func (n *fakeNode) setLedgerIndex(index uint32) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.ledgerIndex = index
+}
+
+func wsURL(t *testing.T, s *httptest.Server) string {
+	url, err := testutil.ConvertHTTPToWS(s.URL)
+	require.NoError(t, err)
+	return url
+}
+
+func newPoolClient(endpoints ...Endpoint) *Client {
+	return NewClient(NewClientConfig().
+		WithEndpoints(endpoints...).
+		WithHealthCheckInterval(0).
+		WithTimeout(time.Second))
+}
+
+func TestWithEndpoints(t *testing.T) {
+	config := NewClientConfig().WithEndpoints(
+		Endpoint{URL: "ws://s1.ripple.com"},
+		Endpoint{URL: "ws://clio.example.com", Kind: ClioEndpoint},
+	)
+	require.Equal(t, []Endpoint{
+		{URL: "ws://s1.ripple.com"},
+		{URL: "ws://clio.example.com", Kind: ClioEndpoint},
+	}, config.endpoints)
+	require.Equal(t, "ws://s1.ripple.com", config.host)
+}
+
+func TestClient_ConnectPool(t *testing.T) {
+	t.Run("skips unreachable and unsynced endpoints", func(t *testing.T) {
+		down := httptest.NewServer(nil)
+		down.Close()
+		syncing := newFakeNode(t, "syncing", 0)
+		full := newFakeNode(t, "full", 0)
+
+		cl := newPoolClient(Endpoint{URL: wsURL(t, down)}, Endpoint{URL: syncing.url}, Endpoint{URL: full.url})
+		require.NoError(t, cl.Connect())
+		defer cl.Disconnect()
+
+		require.Equal(t, full.url, cl.Endpoint())
+		require.Equal(t, 1, full.count("ping"))
+		_, err := cl.Request(&utility.PingRequest{})
+		require.NoError(t, err)
+
+		statuses := cl.EndpointStatuses()
+		require.False(t, statuses[0].Healthy)
+		require.False(t, statuses[1].Healthy)
+		require.ErrorIs(t, statuses[1].Err, ErrEndpointNotSynced)
+		require.True(t, statuses[2].Healthy)
+	})
+
+	t.Run("all endpoints failing", func(t *testing.T) {
+		syncing := newFakeNode(t, "syncing", 0)
+
+		cl := newPoolClient(Endpoint{URL: syncing.url})
+		err := cl.Connect()
+		require.ErrorIs(t, err, ErrAllEndpointsFailed)
+		require.ErrorIs(t, err, ErrEndpointNotSynced)
+		require.False(t, cl.IsConnected())
+	})
+
+	t.Run("health check timeout", func(t *testing.T) {
+		ms := &testutil.MockWebSocketServer{}
+		s := ms.TestWebSocketServer(func(c *websocket.Conn) {
+			// Never answer.
+			_, _, _ = c.ReadMessage()
+			_, _, _ = c.ReadMessage()
+		})
+		defer s.Close()
+
+		cl := NewClient(NewClientConfig().WithEndpoints(Endpoint{URL: wsURL(t, s)}).WithTimeout(100 * time.Millisecond))
+		require.ErrorIs(t, cl.Connect(), ErrAllEndpointsFailed)
+	})
+}
+
+func TestClient_PoolFailsOverOnReconnect(t *testing.T) {
+	first := newFakeNode(t, "full", 1)
+	second := newFakeNode(t, "full", 0)
+
+	cl := newPoolClient(Endpoint{URL: first.url}, Endpoint{URL: second.url})
+	require.NoError(t, cl.Connect())
+	defer cl.Disconnect()
+	require.Equal(t, first.url, cl.Endpoint())
+
+	// The first endpoint closes the connection after this request.
+	_, err := cl.Request(&utility.RandomRequest{})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return cl.Endpoint() == second.url && cl.IsConnected()
+	}, 2*time.Second, 10*time.Millisecond)
+	_, err = cl.Request(&utility.PingRequest{})
+	require.NoError(t, err)
+	require.False(t, cl.EndpointStatuses()[0].Healthy)
+}
+
+func TestClient_PoolRoutesClioOnlyMethods(t *testing.T) {
+	rippled := newFakeNode(t, "full", 0)
+	clioNode := newFakeNode(t, "", 0)
+	req := &clio.NFTInfoRequest{NFTokenID: "000800006203F49C21D5D6E022CB16DE3538F248662FC73C00000002"}
+
+	cl := newPoolClient(Endpoint{URL: rippled.url}, Endpoint{URL: clioNode.url, Kind: ClioEndpoint})
+	require.NoError(t, cl.Connect())
+	defer cl.Disconnect()
+
+	for i := 0; i < 2; i++ {
+		_, err := cl.Request(req)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 0, rippled.count("nft_info"))
+	require.Equal(t, 2, clioNode.count("nft_info"))
+
+	// Other methods still go to the endpoint the client is connected to.
+	require.Equal(t, rippled.url, cl.Endpoint())
+	_, err := cl.Request(&utility.RandomRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, rippled.count("random"))
+	require.Equal(t, 0, clioNode.count("random"))
+
+	t.Run("no Clio endpoint", func(t *testing.T) {
+		cl := newPoolClient(Endpoint{URL: rippled.url})
+		require.NoError(t, cl.Connect())
+		defer cl.Disconnect()
+
+		_, err := cl.Request(req)
+		require.ErrorIs(t, err, ErrNoClioEndpoint)
+	})
+}
+
+func TestClient_PoolMovesOffLaggingEndpoint(t *testing.T) {
+	lagging := newFakeNode(t, "full", 0)
+	lagging.setLedgerIndex(90)
+	healthy := newFakeNode(t, "full", 0)
+
+	cl := NewClient(NewClientConfig().
+		WithEndpoints(Endpoint{URL: lagging.url}, Endpoint{URL: healthy.url}).
+		WithHealthCheckInterval(50 * time.Millisecond).
+		WithTimeout(time.Second))
+	require.NoError(t, cl.Connect())
+	defer cl.Disconnect()
+	// The first endpoint is synced, so the client connects to it.
+	require.Equal(t, lagging.url, cl.Endpoint())
+
+	// The periodic check finds it behind and moves the client to the other one.
+	require.Eventually(t, func() bool {
+		return cl.Endpoint() == healthy.url && cl.IsConnected()
+	}, 2*time.Second, 10*time.Millisecond)
+
+	statuses := cl.EndpointStatuses()
+	require.False(t, statuses[0].Healthy)
+	require.ErrorIs(t, statuses[0].Err, ErrEndpointLagging)
+	require.Equal(t, common.LedgerIndex(90), statuses[0].LedgerIndex)
+	require.True(t, statuses[1].Healthy)
+
+	_, err := cl.Request(&utility.RandomRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, healthy.count("random"))
+}
@@ -1,15 +1,19 @@
 package websocket
 
 import (
+	"context"
+
 	"github.com/Peersyst/xrpl-go/xrpl/currency"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/channel"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/clio"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/ledger"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/nft"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/oracle"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/path"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/utility"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 )
@@ -20,7 +24,12 @@ import (
 // It takes an AccountInfoRequest as input and returns an AccountInfoResponse,
 // along with the raw XRPL response and any error encountered.
 func (c *Client) GetAccountInfo(req *account.InfoRequest) (*account.InfoResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAccountInfoWithContext(context.Background(), req)
+}
+
+// GetAccountInfoWithContext is like GetAccountInfo but uses ctx for cancellation and deadlines.
+func (c *Client) GetAccountInfoWithContext(ctx context.Context, req *account.InfoRequest) (*account.InfoResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +45,12 @@ func (c *Client) GetAccountInfo(req *account.InfoRequest) (*account.InfoResponse
 // It takes an AccountChannelsRequest as input and returns an AccountChannelsResponse,
 // along with any error encountered.
 func (c *Client) GetAccountChannels(req *account.ChannelsRequest) (*account.ChannelsResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAccountChannelsWithContext(context.Background(), req)
+}
+
+// GetAccountChannelsWithContext is like GetAccountChannels but uses ctx for cancellation and deadlines.
+func (c *Client) GetAccountChannelsWithContext(ctx context.Context, req *account.ChannelsRequest) (*account.ChannelsResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +66,12 @@ func (c *Client) GetAccountChannels(req *account.ChannelsRequest) (*account.Chan
 // It takes an AccountObjectsRequest as input and returns an AccountObjectsResponse,
 // along with any error encountered.
 func (c *Client) GetAccountObjects(req *account.ObjectsRequest) (*account.ObjectsResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAccountObjectsWithContext(context.Background(), req)
+}
+
+// GetAccountObjectsWithContext is like GetAccountObjects but uses ctx for cancellation and deadlines.
+func (c *Client) GetAccountObjectsWithContext(ctx context.Context, req *account.ObjectsRequest) (*account.ObjectsResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +86,12 @@ func (c *Client) GetAccountObjects(req *account.ObjectsRequest) (*account.Object
 // GetXrpBalance retrieves the XRP balance of a given account address.
 // It returns the balance as a string in XRP (not drops) and any error encountered.
 func (c *Client) GetXrpBalance(address types.Address) (string, error) {
-	res, err := c.GetAccountInfo(&account.InfoRequest{
+	return c.GetXrpBalanceWithContext(context.Background(), address)
+}
+
+// GetXrpBalanceWithContext is like GetXrpBalance but uses ctx for cancellation and deadlines.
+func (c *Client) GetXrpBalanceWithContext(ctx context.Context, address types.Address) (string, error) {
+	res, err := c.GetAccountInfoWithContext(ctx, &account.InfoRequest{
 		Account: address,
 	})
 	if err != nil {
@@ -86,7 +110,12 @@ func (c *Client) GetXrpBalance(address types.Address) (string, error) {
 // It takes an AccountLinesRequest as input and returns an AccountLinesResponse,
 // along with any error encountered.
 func (c *Client) GetAccountLines(req *account.LinesRequest) (*account.LinesResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAccountLinesWithContext(context.Background(), req)
+}
+
+// GetAccountLinesWithContext is like GetAccountLines but uses ctx for cancellation and deadlines.
+func (c *Client) GetAccountLinesWithContext(ctx context.Context, req *account.LinesRequest) (*account.LinesResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +131,12 @@ func (c *Client) GetAccountLines(req *account.LinesRequest) (*account.LinesRespo
 // It takes an AccountNFTsRequest as input and returns an AccountNFTsResponse,
 // along with any error encountered.
 func (c *Client) GetAccountNFTs(req *account.NFTsRequest) (*account.NFTsResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAccountNFTsWithContext(context.Background(), req)
+}
+
+// GetAccountNFTsWithContext is like GetAccountNFTs but uses ctx for cancellation and deadlines.
+func (c *Client) GetAccountNFTsWithContext(ctx context.Context, req *account.NFTsRequest) (*account.NFTsResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +152,12 @@ func (c *Client) GetAccountNFTs(req *account.NFTsRequest) (*account.NFTsResponse
 // It takes an AccountCurrenciesRequest as input and returns an AccountCurrenciesResponse,
 // along with any error encountered.
 func (c *Client) GetAccountCurrencies(req *account.CurrenciesRequest) (*account.CurrenciesResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAccountCurrenciesWithContext(context.Background(), req)
+}
+
+// GetAccountCurrenciesWithContext is like GetAccountCurrencies but uses ctx for cancellation and deadlines.
+func (c *Client) GetAccountCurrenciesWithContext(ctx context.Context, req *account.CurrenciesRequest) (*account.CurrenciesResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -135,7 +174,12 @@ func (c *Client) GetAccountCurrencies(req *account.CurrenciesRequest) (*account.
 // It takes an AccountOffersRequest as input and returns an AccountOffersResponse,
 // along with any error encountered.
 func (c *Client) GetAccountOffers(req *account.OffersRequest) (*account.OffersResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAccountOffersWithContext(context.Background(), req)
+}
+
+// GetAccountOffersWithContext is like GetAccountOffers but uses ctx for cancellation and deadlines.
+func (c *Client) GetAccountOffersWithContext(ctx context.Context, req *account.OffersRequest) (*account.OffersResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -151,7 +195,12 @@ func (c *Client) GetAccountOffers(req *account.OffersRequest) (*account.OffersRe
 // It takes an AccountTransactionsRequest as input and returns an AccountTransactionsResponse,
 // along with any error encountered.
 func (c *Client) GetAccountTransactions(req *account.TransactionsRequest) (*account.TransactionsResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAccountTransactionsWithContext(context.Background(), req)
+}
+
+// GetAccountTransactionsWithContext is like GetAccountTransactions but uses ctx for cancellation and deadlines.
+func (c *Client) GetAccountTransactionsWithContext(ctx context.Context, req *account.TransactionsRequest) (*account.TransactionsResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -167,7 +216,12 @@ func (c *Client) GetAccountTransactions(req *account.TransactionsRequest) (*acco
 // It takes a GatewayBalancesRequest as input and returns a GatewayBalancesResponse,
 // along with any error encountered.
 func (c *Client) GetGatewayBalances(req *account.GatewayBalancesRequest) (*account.GatewayBalancesResponse, error) {
-	res, err := c.Request(req)
+	return c.GetGatewayBalancesWithContext(context.Background(), req)
+}
+
+// GetGatewayBalancesWithContext is like GetGatewayBalances but uses ctx for cancellation and deadlines.
+func (c *Client) GetGatewayBalancesWithContext(ctx context.Context, req *account.GatewayBalancesRequest) (*account.GatewayBalancesResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -185,7 +239,12 @@ func (c *Client) GetGatewayBalances(req *account.GatewayBalancesRequest) (*accou
 // It takes a ChannelVerifyRequest as input and returns a ChannelVerifyResponse,
 // along with any error encountered.
 func (c *Client) GetChannelVerify(req *channel.VerifyRequest) (*channel.VerifyResponse, error) {
-	res, err := c.Request(req)
+	return c.GetChannelVerifyWithContext(context.Background(), req)
+}
+
+// GetChannelVerifyWithContext is like GetChannelVerify but uses ctx for cancellation and deadlines.
+func (c *Client) GetChannelVerifyWithContext(ctx context.Context, req *channel.VerifyRequest) (*channel.VerifyResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -202,7 +261,12 @@ func (c *Client) GetChannelVerify(req *channel.VerifyRequest) (*channel.VerifyRe
 // GetLedgerIndex returns the index of the most recently validated ledger.
 // It returns the ledger index as a LedgerIndex type and any error encountered.
 func (c *Client) GetLedgerIndex() (common.LedgerIndex, error) {
-	res, err := c.Request(&ledger.Request{
+	return c.GetLedgerIndexWithContext(context.Background())
+}
+
+// GetLedgerIndexWithContext is like GetLedgerIndex but uses ctx for cancellation and deadlines.
+func (c *Client) GetLedgerIndexWithContext(ctx context.Context) (common.LedgerIndex, error) {
+	res, err := c.RequestWithContext(ctx, &ledger.Request{
 		LedgerIndex: common.LedgerTitle("validated"),
 	})
 	if err != nil {
@@ -220,7 +284,12 @@ func (c *Client) GetLedgerIndex() (common.LedgerIndex, error) {
 // GetClosedLedger retrieves information about the last closed ledger.
 // It returns a ClosedResponse containing the ledger information and any error encountered.
 func (c *Client) GetClosedLedger() (*ledger.ClosedResponse, error) {
-	res, err := c.Request(&ledger.ClosedRequest{})
+	return c.GetClosedLedgerWithContext(context.Background())
+}
+
+// GetClosedLedgerWithContext is like GetClosedLedger but uses ctx for cancellation and deadlines.
+func (c *Client) GetClosedLedgerWithContext(ctx context.Context) (*ledger.ClosedResponse, error) {
+	res, err := c.RequestWithContext(ctx, &ledger.ClosedRequest{})
 	if err != nil {
 		return nil, err
 	}
@@ -235,7 +304,12 @@ func (c *Client) GetClosedLedger() (*ledger.ClosedResponse, error) {
 // GetCurrentLedger retrieves information about the current working ledger.
 // It returns a CurrentResponse containing the ledger information and any error encountered.
 func (c *Client) GetCurrentLedger() (*ledger.CurrentResponse, error) {
-	res, err := c.Request(&ledger.CurrentRequest{})
+	return c.GetCurrentLedgerWithContext(context.Background())
+}
+
+// GetCurrentLedgerWithContext is like GetCurrentLedger but uses ctx for cancellation and deadlines.
+func (c *Client) GetCurrentLedgerWithContext(ctx context.Context) (*ledger.CurrentResponse, error) {
+	res, err := c.RequestWithContext(ctx, &ledger.CurrentRequest{})
 	if err != nil {
 		return nil, err
 	}
@@ -251,7 +325,12 @@ func (c *Client) GetCurrentLedger() (*ledger.CurrentResponse, error) {
 // It takes a DataRequest as input and returns a DataResponse containing the ledger data,
 // along with any error encountered.
 func (c *Client) GetLedgerData(req *ledger.DataRequest) (*ledger.DataResponse, error) {
-	res, err := c.Request(req)
+	return c.GetLedgerDataWithContext(context.Background(), req)
+}
+
+// GetLedgerDataWithContext is like GetLedgerData but uses ctx for cancellation and deadlines.
+func (c *Client) GetLedgerDataWithContext(ctx context.Context, req *ledger.DataRequest) (*ledger.DataResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -267,7 +346,12 @@ func (c *Client) GetLedgerData(req *ledger.DataRequest) (*ledger.DataResponse, e
 // It takes a Request as input and returns a Response containing the ledger information,
 // along with any error encountered.
 func (c *Client) GetLedger(req *ledger.Request) (*ledger.Response, error) {
-	res, err := c.Request(req)
+	return c.GetLedgerWithContext(context.Background(), req)
+}
+
+// GetLedgerWithContext is like GetLedger but uses ctx for cancellation and deadlines.
+func (c *Client) GetLedgerWithContext(ctx context.Context, req *ledger.Request) (*ledger.Response, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -279,13 +363,42 @@ func (c *Client) GetLedger(req *ledger.Request) (*ledger.Response, error) {
 	return &lr, nil
 }
 
+// GetLedgerEntry retrieves a single ledger entry.
+// It takes an EntryRequest selecting the entry and returns an EntryResponse whose
+// Object holds the entry decoded into its ledger object type, along with any error encountered.
+func (c *Client) GetLedgerEntry(req *ledger.EntryRequest) (*ledger.EntryResponse, error) {
+	return c.GetLedgerEntryWithContext(context.Background(), req)
+}
+
+// GetLedgerEntryWithContext is like GetLedgerEntry but uses ctx for cancellation and deadlines.
+func (c *Client) GetLedgerEntryWithContext(ctx context.Context, req *ledger.EntryRequest) (*ledger.EntryResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var lr ledger.EntryResponse
+	err = res.GetResult(&lr)
+	if err != nil {
+		return nil, err
+	}
+	if err := lr.DecodeNode(); err != nil {
+		return nil, err
+	}
+	return &lr, nil
+}
+
 // NFT queries
 
 // GetNFTBuyOffers retrieves all buy offers for a specific NFT.
 // It takes an NFTokenBuyOffersRequest as input and returns an NFTokenBuyOffersResponse,
 // along with any error encountered.
 func (c *Client) GetNFTBuyOffers(req *nft.NFTokenBuyOffersRequest) (*nft.NFTokenBuyOffersResponse, error) {
-	res, err := c.Request(req)
+	return c.GetNFTBuyOffersWithContext(context.Background(), req)
+}
+
+// GetNFTBuyOffersWithContext is like GetNFTBuyOffers but uses ctx for cancellation and deadlines.
+func (c *Client) GetNFTBuyOffersWithContext(ctx context.Context, req *nft.NFTokenBuyOffersRequest) (*nft.NFTokenBuyOffersResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -301,7 +414,12 @@ func (c *Client) GetNFTBuyOffers(req *nft.NFTokenBuyOffersRequest) (*nft.NFToken
 // It takes an NFTokenSellOffersRequest as input and returns an NFTokenSellOffersResponse,
 // along with any error encountered.
 func (c *Client) GetNFTSellOffers(req *nft.NFTokenSellOffersRequest) (*nft.NFTokenSellOffersResponse, error) {
-	res, err := c.Request(req)
+	return c.GetNFTSellOffersWithContext(context.Background(), req)
+}
+
+// GetNFTSellOffersWithContext is like GetNFTSellOffers but uses ctx for cancellation and deadlines.
+func (c *Client) GetNFTSellOffersWithContext(ctx context.Context, req *nft.NFTokenSellOffersRequest) (*nft.NFTokenSellOffersResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -319,7 +437,12 @@ func (c *Client) GetNFTSellOffers(req *nft.NFTokenSellOffersRequest) (*nft.NFTok
 // It takes a BookOffersRequest as input and returns a BookOffersResponse,
 // along with any error encountered.
 func (c *Client) GetBookOffers(req *path.BookOffersRequest) (*path.BookOffersResponse, error) {
-	res, err := c.Request(req)
+	return c.GetBookOffersWithContext(context.Background(), req)
+}
+
+// GetBookOffersWithContext is like GetBookOffers but uses ctx for cancellation and deadlines.
+func (c *Client) GetBookOffersWithContext(ctx context.Context, req *path.BookOffersRequest) (*path.BookOffersResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -335,7 +458,12 @@ func (c *Client) GetBookOffers(req *path.BookOffersRequest) (*path.BookOffersRes
 // It takes a DepositAuthorizedRequest as input and returns a DepositAuthorizedResponse,
 // along with any error encountered.
 func (c *Client) GetDepositAuthorized(req *path.DepositAuthorizedRequest) (*path.DepositAuthorizedResponse, error) {
-	res, err := c.Request(req)
+	return c.GetDepositAuthorizedWithContext(context.Background(), req)
+}
+
+// GetDepositAuthorizedWithContext is like GetDepositAuthorized but uses ctx for cancellation and deadlines.
+func (c *Client) GetDepositAuthorizedWithContext(ctx context.Context, req *path.DepositAuthorizedRequest) (*path.DepositAuthorizedResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -347,11 +475,38 @@ func (c *Client) GetDepositAuthorized(req *path.DepositAuthorizedRequest) (*path
 	return &lr, nil
 }
 
+// GetAMMInfo retrieves the state of an Automated Market Maker instance.
+// It takes an AMMInfoRequest selecting the AMM by its asset pair or account and returns
+// an AMMInfoResponse with its pool amounts, LP Tokens, trading fee, votes and auction slot,
+// along with any error encountered.
+func (c *Client) GetAMMInfo(req *path.AMMInfoRequest) (*path.AMMInfoResponse, error) {
+	return c.GetAMMInfoWithContext(context.Background(), req)
+}
+
+// GetAMMInfoWithContext is like GetAMMInfo but uses ctx for cancellation and deadlines.
+func (c *Client) GetAMMInfoWithContext(ctx context.Context, req *path.AMMInfoRequest) (*path.AMMInfoResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var lr path.AMMInfoResponse
+	err = res.GetResult(&lr)
+	if err != nil {
+		return nil, err
+	}
+	return &lr, nil
+}
+
 // FindPathCreate creates a path finding request that will be monitored until it expires or is closed.
 // It takes a FindCreateRequest as input and returns a FindResponse,
 // along with any error encountered.
 func (c *Client) FindPathCreate(req *path.FindCreateRequest) (*path.FindResponse, error) {
-	res, err := c.Request(req)
+	return c.FindPathCreateWithContext(context.Background(), req)
+}
+
+// FindPathCreateWithContext is like FindPathCreate but uses ctx for cancellation and deadlines.
+func (c *Client) FindPathCreateWithContext(ctx context.Context, req *path.FindCreateRequest) (*path.FindResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -367,7 +522,12 @@ func (c *Client) FindPathCreate(req *path.FindCreateRequest) (*path.FindResponse
 // It takes a FindCloseRequest as input and returns a FindResponse,
 // along with any error encountered.
 func (c *Client) FindPathClose(req *path.FindCloseRequest) (*path.FindResponse, error) {
-	res, err := c.Request(req)
+	return c.FindPathCloseWithContext(context.Background(), req)
+}
+
+// FindPathCloseWithContext is like FindPathClose but uses ctx for cancellation and deadlines.
+func (c *Client) FindPathCloseWithContext(ctx context.Context, req *path.FindCloseRequest) (*path.FindResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -383,7 +543,12 @@ func (c *Client) FindPathClose(req *path.FindCloseRequest) (*path.FindResponse,
 // It takes a FindStatusRequest as input and returns a FindResponse,
 // along with any error encountered.
 func (c *Client) FindPathStatus(req *path.FindStatusRequest) (*path.FindResponse, error) {
-	res, err := c.Request(req)
+	return c.FindPathStatusWithContext(context.Background(), req)
+}
+
+// FindPathStatusWithContext is like FindPathStatus but uses ctx for cancellation and deadlines.
+func (c *Client) FindPathStatusWithContext(ctx context.Context, req *path.FindStatusRequest) (*path.FindResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -399,7 +564,12 @@ func (c *Client) FindPathStatus(req *path.FindStatusRequest) (*path.FindResponse
 // It takes a RipplePathFindRequest as input and returns a RipplePathFindResponse,
 // along with any error encountered.
 func (c *Client) GetRipplePathFind(req *path.RipplePathFindRequest) (*path.RipplePathFindResponse, error) {
-	res, err := c.Request(req)
+	return c.GetRipplePathFindWithContext(context.Background(), req)
+}
+
+// GetRipplePathFindWithContext is like GetRipplePathFind but uses ctx for cancellation and deadlines.
+func (c *Client) GetRipplePathFindWithContext(ctx context.Context, req *path.RipplePathFindRequest) (*path.RipplePathFindResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -417,7 +587,12 @@ func (c *Client) GetRipplePathFind(req *path.RipplePathFindRequest) (*path.Rippl
 // It takes a ServerInfoRequest as input and returns a ServerInfoResponse,
 // along with any error encountered.
 func (c *Client) GetServerInfo(req *server.InfoRequest) (*server.InfoResponse, error) {
-	res, err := c.Request(req)
+	return c.GetServerInfoWithContext(context.Background(), req)
+}
+
+// GetServerInfoWithContext is like GetServerInfo but uses ctx for cancellation and deadlines.
+func (c *Client) GetServerInfoWithContext(ctx context.Context, req *server.InfoRequest) (*server.InfoResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -433,7 +608,12 @@ func (c *Client) GetServerInfo(req *server.InfoRequest) (*server.InfoResponse, e
 // It takes a FeatureAllRequest as input and returns a FeatureAllResponse,
 // along with any error encountered.
 func (c *Client) GetAllFeatures(req *server.FeatureAllRequest) (*server.FeatureAllResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAllFeaturesWithContext(context.Background(), req)
+}
+
+// GetAllFeaturesWithContext is like GetAllFeatures but uses ctx for cancellation and deadlines.
+func (c *Client) GetAllFeaturesWithContext(ctx context.Context, req *server.FeatureAllRequest) (*server.FeatureAllResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -449,7 +629,12 @@ func (c *Client) GetAllFeatures(req *server.FeatureAllRequest) (*server.FeatureA
 // It takes a FeatureOneRequest as input and returns a FeatureResponse,
 // along with any error encountered.
 func (c *Client) GetFeature(req *server.FeatureOneRequest) (*server.FeatureResponse, error) {
-	res, err := c.Request(req)
+	return c.GetFeatureWithContext(context.Background(), req)
+}
+
+// GetFeatureWithContext is like GetFeature but uses ctx for cancellation and deadlines.
+func (c *Client) GetFeatureWithContext(ctx context.Context, req *server.FeatureOneRequest) (*server.FeatureResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -465,7 +650,12 @@ func (c *Client) GetFeature(req *server.FeatureOneRequest) (*server.FeatureRespo
 // It takes a FeeRequest as input and returns a FeeResponse,
 // along with any error encountered.
 func (c *Client) GetFee(req *server.FeeRequest) (*server.FeeResponse, error) {
-	res, err := c.Request(req)
+	return c.GetFeeWithContext(context.Background(), req)
+}
+
+// GetFeeWithContext is like GetFee but uses ctx for cancellation and deadlines.
+func (c *Client) GetFeeWithContext(ctx context.Context, req *server.FeeRequest) (*server.FeeResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -481,7 +671,12 @@ func (c *Client) GetFee(req *server.FeeRequest) (*server.FeeResponse, error) {
 // It takes a ManifestRequest as input and returns a ManifestResponse,
 // along with any error encountered.
 func (c *Client) GetManifest(req *server.ManifestRequest) (*server.ManifestResponse, error) {
-	res, err := c.Request(req)
+	return c.GetManifestWithContext(context.Background(), req)
+}
+
+// GetManifestWithContext is like GetManifest but uses ctx for cancellation and deadlines.
+func (c *Client) GetManifestWithContext(ctx context.Context, req *server.ManifestRequest) (*server.ManifestResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -497,7 +692,12 @@ func (c *Client) GetManifest(req *server.ManifestRequest) (*server.ManifestRespo
 // It takes a StateRequest as input and returns a StateResponse,
 // along with any error encountered.
 func (c *Client) GetServerState(req *server.StateRequest) (*server.StateResponse, error) {
-	res, err := c.Request(req)
+	return c.GetServerStateWithContext(context.Background(), req)
+}
+
+// GetServerStateWithContext is like GetServerState but uses ctx for cancellation and deadlines.
+func (c *Client) GetServerStateWithContext(ctx context.Context, req *server.StateRequest) (*server.StateResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -509,13 +709,63 @@ func (c *Client) GetServerState(req *server.StateRequest) (*server.StateResponse
 	return &lr, nil
 }
 
+// GetServerDefinitions retrieves the definitions the server uses to encode and
+// decode transactions in binary format. Pass the result of Definitions on the
+// response to SetDefinitions to encode transactions the embedded definitions
+// do not know yet.
+func (c *Client) GetServerDefinitions(req *server.DefinitionsRequest) (*server.DefinitionsResponse, error) {
+	return c.GetServerDefinitionsWithContext(context.Background(), req)
+}
+
+// GetServerDefinitionsWithContext is like GetServerDefinitions but uses ctx for cancellation and deadlines.
+func (c *Client) GetServerDefinitionsWithContext(ctx context.Context, req *server.DefinitionsRequest) (*server.DefinitionsResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var dr server.DefinitionsResponse
+	err = res.GetResult(&dr)
+	if err != nil {
+		return nil, err
+	}
+	return &dr, nil
+}
+
+// Transaction queries
+
+// GetTx retrieves a transaction by its hash.
+// It takes a TxRequest as input and returns a TxResponse,
+// along with any error encountered.
+func (c *Client) GetTx(req *requests.TxRequest) (*requests.TxResponse, error) {
+	return c.GetTxWithContext(context.Background(), req)
+}
+
+// GetTxWithContext is like GetTx but uses ctx for cancellation and deadlines.
+func (c *Client) GetTxWithContext(ctx context.Context, req *requests.TxRequest) (*requests.TxResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var tr requests.TxResponse
+	err = res.GetResult(&tr)
+	if err != nil {
+		return nil, err
+	}
+	return &tr, nil
+}
+
 // Oracle queries
 
 // GetAggregatePrice retrieves the aggregate price of an asset.
 // It takes a GetAggregatePriceRequest as input and returns a GetAggregatePriceResponse,
 // along with any error encountered.
 func (c *Client) GetAggregatePrice(req *oracle.GetAggregatePriceRequest) (*oracle.GetAggregatePriceResponse, error) {
-	res, err := c.Request(req)
+	return c.GetAggregatePriceWithContext(context.Background(), req)
+}
+
+// GetAggregatePriceWithContext is like GetAggregatePrice but uses ctx for cancellation and deadlines.
+func (c *Client) GetAggregatePriceWithContext(ctx context.Context, req *oracle.GetAggregatePriceRequest) (*oracle.GetAggregatePriceResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -533,7 +783,12 @@ func (c *Client) GetAggregatePrice(req *oracle.GetAggregatePriceRequest) (*oracl
 // It takes a PingRequest as input and returns a PingResponse,
 // along with any error encountered.
 func (c *Client) Ping(req *utility.PingRequest) (*utility.PingResponse, error) {
-	res, err := c.Request(req)
+	return c.PingWithContext(context.Background(), req)
+}
+
+// PingWithContext is like Ping but uses ctx for cancellation and deadlines.
+func (c *Client) PingWithContext(ctx context.Context, req *utility.PingRequest) (*utility.PingResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -549,7 +804,12 @@ func (c *Client) Ping(req *utility.PingRequest) (*utility.PingResponse, error) {
 // It takes a RandomRequest as input and returns a RandomResponse,
 // along with any error encountered.
 func (c *Client) GetRandom(req *utility.RandomRequest) (*utility.RandomResponse, error) {
-	res, err := c.Request(req)
+	return c.GetRandomWithContext(context.Background(), req)
+}
+
+// GetRandomWithContext is like GetRandom but uses ctx for cancellation and deadlines.
+func (c *Client) GetRandomWithContext(ctx context.Context, req *utility.RandomRequest) (*utility.RandomResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -560,3 +820,68 @@ func (c *Client) GetRandom(req *utility.RandomRequest) (*utility.RandomResponse,
 	}
 	return &lr, nil
 }
+
+// Clio queries
+
+// GetNFTInfo retrieves information about an NFT, including burned ones.
+// It takes a NFTInfoRequest as input and returns a NFTInfoResponse,
+// along with any error encountered. It is only implemented by Clio servers.
+func (c *Client) GetNFTInfo(req *clio.NFTInfoRequest) (*clio.NFTInfoResponse, error) {
+	return c.GetNFTInfoWithContext(context.Background(), req)
+}
+
+// GetNFTInfoWithContext is like GetNFTInfo but uses ctx for cancellation and deadlines.
+func (c *Client) GetNFTInfoWithContext(ctx context.Context, req *clio.NFTInfoRequest) (*clio.NFTInfoResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var lr clio.NFTInfoResponse
+	err = res.GetResult(&lr)
+	if err != nil {
+		return nil, err
+	}
+	return &lr, nil
+}
+
+// GetNFTHistory retrieves the transactions that affected an NFT.
+// It takes a NFTHistoryRequest as input and returns a NFTHistoryResponse,
+// along with any error encountered. It is only implemented by Clio servers.
+func (c *Client) GetNFTHistory(req *clio.NFTHistoryRequest) (*clio.NFTHistoryResponse, error) {
+	return c.GetNFTHistoryWithContext(context.Background(), req)
+}
+
+// GetNFTHistoryWithContext is like GetNFTHistory but uses ctx for cancellation and deadlines.
+func (c *Client) GetNFTHistoryWithContext(ctx context.Context, req *clio.NFTHistoryRequest) (*clio.NFTHistoryResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var lr clio.NFTHistoryResponse
+	err = res.GetResult(&lr)
+	if err != nil {
+		return nil, err
+	}
+	return &lr, nil
+}
+
+// GetNFTsByIssuer retrieves the NFTs issued by an account.
+// It takes a NFTsByIssuerRequest as input and returns a NFTsByIssuerResponse,
+// along with any error encountered. It is only implemented by Clio servers.
+func (c *Client) GetNFTsByIssuer(req *clio.NFTsByIssuerRequest) (*clio.NFTsByIssuerResponse, error) {
+	return c.GetNFTsByIssuerWithContext(context.Background(), req)
+}
+
+// GetNFTsByIssuerWithContext is like GetNFTsByIssuer but uses ctx for cancellation and deadlines.
+func (c *Client) GetNFTsByIssuerWithContext(ctx context.Context, req *clio.NFTsByIssuerRequest) (*clio.NFTsByIssuerResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var lr clio.NFTsByIssuerResponse
+	err = res.GetResult(&lr)
+	if err != nil {
+		return nil, err
+	}
+	return &lr, nil
+}
@@ -21,6 +21,7 @@ import (
 	pathtypes "github.com/Peersyst/xrpl-go/xrpl/queries/path/types"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
 	servertypes "github.com/Peersyst/xrpl-go/xrpl/queries/server/types"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/utility"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
@@ -31,11 +32,8 @@ import (
 func setupTestClient(t *testing.T, messages []map[string]any) (*Client, func()) {
 	ws := &testutil.MockWebSocketServer{Msgs: messages}
 	s := ws.TestWebSocketServer(func(c *websocket.Conn) {
-		for _, m := range messages {
-			err := c.WriteJSON(m)
-			if err != nil {
-				t.Errorf("error writing message: %v", err)
-			}
+		if err := testutil.RespondInOrder(c, messages); err != nil {
+			t.Errorf("error writing message: %v", err)
 		}
 	})
 
@@ -1400,6 +1398,83 @@ func TestClient_GetLedgerData(t *testing.T) {
 	}
 }
 
+func TestClient_GetLedgerEntry(t *testing.T) {
+	tests := []struct {
+		name           string
+		serverMessages []map[string]any
+		expected       ledger.Object
+		expectedErr    error
+	}{
+		{
+			name: "Valid ledger entry",
+			serverMessages: []map[string]any{
+				{
+					"id": 1,
+					"result": map[string]any{
+						"index":        "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+						"ledger_index": 61966165,
+						"node": map[string]any{
+							"Account":         "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+							"Balance":         "424021949",
+							"Flags":           0,
+							"LedgerEntryType": "AccountRoot",
+							"OwnerCount":      4,
+							"Sequence":        44,
+							"index":           "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+						},
+						"validated": true,
+					},
+				},
+			},
+			expected: &ledger.AccountRoot{
+				Index:           "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+				LedgerEntryType: ledger.AccountRootEntry,
+				Account:         "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+				Balance:         types.XRPCurrencyAmount(424021949),
+				OwnerCount:      4,
+				Sequence:        44,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error response",
+			serverMessages: []map[string]any{
+				{
+					"id":    1,
+					"error": "incorrect id",
+				},
+			},
+			expected:    nil,
+			expectedErr: ErrIncorrectID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, cleanup := setupTestClient(t, tt.serverMessages)
+			defer cleanup()
+
+			result, err := cl.GetLedgerEntry(&ledgerqueries.EntryRequest{
+				AccountRoot: "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
+			})
+
+			if tt.expectedErr != nil {
+				if err == nil || err.Error() != tt.expectedErr.Error() {
+					t.Errorf("Expected error %v, but got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expected, result.Object) {
+				t.Errorf("Expected %+v, but got %+v", tt.expected, result.Object)
+			}
+		})
+	}
+}
+
 func TestClient_GetLedger(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -1827,6 +1902,109 @@ func TestClient_GetDepositAuthorized(t *testing.T) {
 	}
 }
 
+func TestClient_GetAMMInfo(t *testing.T) {
+	tests := []struct {
+		name           string
+		serverMessages []map[string]any
+		expected       *path.AMMInfoResponse
+		expectedErr    error
+	}{
+		{
+			name: "Valid AMM info",
+			serverMessages: []map[string]any{
+				{
+					"id": 1,
+					"result": map[string]any{
+						"amm": map[string]any{
+							"account": "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+							"amount":  "296890496",
+							"amount2": map[string]any{
+								"currency": "TST",
+								"issuer":   "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd",
+								"value":    "25.81656470648473",
+							},
+							"lp_token": map[string]any{
+								"currency": "039C99CD9AB0B70B32ECDA51EAAE471625608EA2",
+								"issuer":   "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+								"value":    "87533.41976112682",
+							},
+							"trading_fee": 600,
+							"vote_slots": []map[string]any{
+								{
+									"account":     "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm",
+									"trading_fee": 600,
+									"vote_weight": 100000,
+								},
+							},
+						},
+						"ledger_index": 316745,
+						"validated":    true,
+					},
+				},
+			},
+			expected: &path.AMMInfoResponse{
+				AMM: pathtypes.AMM{
+					Account: "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+					Amount:  types.XRPCurrencyAmount(296890496),
+					Amount2: types.IssuedCurrencyAmount{
+						Currency: "TST",
+						Issuer:   "rP9jPyP5kyvFRb6ZiRghAGw5u8SGAmU4bd",
+						Value:    "25.81656470648473",
+					},
+					LPToken: types.IssuedCurrencyAmount{
+						Currency: "039C99CD9AB0B70B32ECDA51EAAE471625608EA2",
+						Issuer:   "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+						Value:    "87533.41976112682",
+					},
+					TradingFee: 600,
+					VoteSlots: []pathtypes.AMMVoteSlot{
+						{Account: "rJVUeRqDFNs2xqA7ncVE6ZoAhPUoaJJSQm", TradingFee: 600, VoteWeight: 100000},
+					},
+				},
+				LedgerIndex: 316745,
+				Validated:   true,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error response",
+			serverMessages: []map[string]any{
+				{
+					"id":    1,
+					"error": "incorrect id",
+				},
+			},
+			expected:    nil,
+			expectedErr: ErrIncorrectID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, cleanup := setupTestClient(t, tt.serverMessages)
+			defer cleanup()
+
+			result, err := cl.GetAMMInfo(&path.AMMInfoRequest{
+				AMMAccount: "rp9E3FN3gNmvePGhYnf414T2TkUuoxu8vM",
+			})
+
+			if tt.expectedErr != nil {
+				if err == nil || err.Error() != tt.expectedErr.Error() {
+					t.Errorf("Expected error %v, but got %v", tt.expectedErr, err)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			}
+
+			if !reflect.DeepEqual(tt.expected, result) {
+				t.Errorf("Expected %+v, but got %+v", tt.expected, result)
+			}
+		})
+	}
+}
+
 func TestClient_FindPathCreate(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -1872,11 +2050,11 @@ func TestClient_FindPathCreate(t *testing.T) {
 								},
 							},
 						},
-						SourceAmount: "100000",
+						SourceAmount: types.XRPCurrencyAmount(100000),
 					},
 				},
 				DestinationAccount: "rMwjYedjc7qqtKYVLiAccJSmCwih4LnE2q",
-				DestinationAmount:  "100",
+				DestinationAmount:  types.XRPCurrencyAmount(100),
 				SourceAccount:      "rLHmBn4fT93D1NuWEGNxnYvhvGxzPVVJ5C",
 			},
 			expectedErr: nil,
@@ -1945,7 +2123,7 @@ func TestClient_FindPathClose(t *testing.T) {
 			expected: &path.FindResponse{
 				Alternatives:       []pathtypes.Alternative{},
 				DestinationAccount: "rN7n7otQDd6FczFgLdSqtcsAUxDkw6fzRH",
-				DestinationAmount:  "100",
+				DestinationAmount:  types.XRPCurrencyAmount(100),
 				SourceAccount:      "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn",
 				FullReply:          true,
 				Closed:             true,
@@ -2039,11 +2217,11 @@ func TestClient_FindPathStatus(t *testing.T) {
 								{Currency: "USD", Issuer: "rXXXXXXXXXXXXXXXXXXXXX"},
 							},
 						},
-						SourceAmount: "100",
+						SourceAmount: types.XRPCurrencyAmount(100),
 					},
 				},
 				DestinationAccount: "rXXXXXXXXXXXXXXXXXXXXX",
-				DestinationAmount:  "100",
+				DestinationAmount:  types.XRPCurrencyAmount(100),
 				SourceAccount:      "rYYYYYYYYYYYYYYYYYYYYY",
 				FullReply:          true,
 				Status:             true,
@@ -2753,3 +2931,74 @@ func TestClient_GetRandom(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_GetTx(t *testing.T) {
+	tests := []struct {
+		name           string
+		serverMessages []map[string]any
+		expected       *requests.TxResponse
+		expectedErr    error
+	}{
+		{
+			name: "Valid tx",
+			serverMessages: []map[string]any{
+				{
+					"id": 1,
+					"result": map[string]any{
+						"hash":         "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB74A9",
+						"ledger_index": 56865245,
+						"tx_json": map[string]any{
+							"Account":         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+							"TransactionType": "EscrowCreate",
+						},
+						"validated": true,
+					},
+				},
+			},
+			expected: &requests.TxResponse{
+				Hash:        "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB74A9",
+				LedgerIndex: 56865245,
+				Tx: transaction.FlatTransaction{
+					"Account":         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+					"TransactionType": "EscrowCreate",
+				},
+				Validated: true,
+			},
+		},
+		{
+			name: "error response",
+			serverMessages: []map[string]any{
+				{
+					"id":    1,
+					"error": "incorrect id",
+				},
+			},
+			expectedErr: ErrIncorrectID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl, cleanup := setupTestClient(t, tt.serverMessages)
+			defer cleanup()
+
+			result, err := cl.GetTx(&requests.TxRequest{
+				Transaction: "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB74A9",
+			})
+
+			if tt.expectedErr != nil {
+				if err == nil || err.Error() != tt.expectedErr.Error() {
+					t.Errorf("Expected error %v, but got %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(tt.expected, result) {
+				t.Errorf("Expected %+v, but got %+v", tt.expected, result)
+			}
+		})
+	}
+}
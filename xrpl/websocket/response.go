@@ -1,6 +1,8 @@
 package websocket
 
 import (
+	"github.com/Peersyst/xrpl-go/xrpl/client"
+	"github.com/Peersyst/xrpl-go/xrpl/common"
 	"github.com/mitchellh/mapstructure"
 )
 
@@ -19,6 +21,11 @@ func (e *ErrorWebsocketClientXrplResponse) Error() string {
 	return e.Type
 }
 
+// Unwrap returns the static error matching the error code, such as client.ErrSlowDown.
+func (e *ErrorWebsocketClientXrplResponse) Unwrap() error {
+	return client.ErrorForCode(e.Type)
+}
+
 type ClientResponse struct {
 	ID        int               `json:"id"`
 	Status    string            `json:"status"`
@@ -32,7 +39,10 @@ type ClientResponse struct {
 }
 
 func (r *ClientResponse) GetResult(v any) error {
-	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{TagName: "json", Result: &v, DecodeHook: mapstructure.TextUnmarshallerHookFunc()})
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{TagName: "json", Result: &v, DecodeHook: mapstructure.ComposeDecodeHookFunc(
+		mapstructure.TextUnmarshallerHookFunc(),
+		common.CurrencyAmountHookFunc(),
+	)})
 	if err != nil {
 		return err
 	}
@@ -1,29 +1,50 @@
 package websocket
 
 import (
+	"context"
+
 	subscribe "github.com/Peersyst/xrpl-go/xrpl/queries/subscription"
 	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+	wstypes "github.com/Peersyst/xrpl-go/xrpl/websocket/types"
 )
 
 // Subscribe subscribes to the streams and accounts specified in the request.
 // It returns a response from the server.
+// The subscriptions are remembered and restored if the client reconnects.
 func (c *Client) Subscribe(req *subscribe.Request) (*subscribe.Response, error) {
-	res, err := c.Request(req)
+	return c.SubscribeWithContext(context.Background(), req)
+}
+
+// SubscribeWithContext is like Subscribe but uses ctx for cancellation and deadlines.
+func (c *Client) SubscribeWithContext(ctx context.Context, req *subscribe.Request) (*subscribe.Response, error) {
+	// Books are registered before sending the request, as order book updates can be
+	// streamed before the response is handled.
+	added := c.registry().addBooks(req.Books)
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
+		c.registry().removeBooks(added)
 		return nil, err
 	}
 	var lr subscribe.Response
 	err = res.GetResult(&lr)
 	if err != nil {
+		c.registry().removeBooks(added)
 		return nil, err
 	}
+	c.registry().add(req)
+	c.trackLedgerIndex(lr.LedgerIndex)
 	return &lr, nil
 }
 
 // Unsubscribe unsubscribes from the streams and accounts specified in the request.
 // It returns a response from the server.
 func (c *Client) Unsubscribe(req *subscribe.UnsubscribeRequest) (*subscribe.UnsubscribeResponse, error) {
-	res, err := c.Request(req)
+	return c.UnsubscribeWithContext(context.Background(), req)
+}
+
+// UnsubscribeWithContext is like Unsubscribe but uses ctx for cancellation and deadlines.
+func (c *Client) UnsubscribeWithContext(ctx context.Context, req *subscribe.UnsubscribeRequest) (*subscribe.UnsubscribeResponse, error) {
+	res, err := c.RequestWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -32,6 +53,7 @@ func (c *Client) Unsubscribe(req *subscribe.UnsubscribeRequest) (*subscribe.Unsu
 	if err != nil {
 		return nil, err
 	}
+	c.registry().remove(req)
 	return &lr, nil
 }
 
@@ -116,7 +138,7 @@ func (c *Client) OnPeerStatusChange(
 
 // Orderbook streams
 
-// OnOrderbook handles "orderbook" events.
+// OnOrderBook handles updates to the order books subscribed to with Subscribe.
 // It returns a stream of orderbook streams. Creates a new channel and a goroutine to handle the stream.
 func (c *Client) OnOrderBook(
 	handler func(orderbook *streamtypes.OrderBookStream),
@@ -162,3 +184,21 @@ func (c *Client) OnConsensusPhase(
 		}
 	}()
 }
+
+// Reconnect events
+
+// OnReconnect handles reconnection events.
+// The handler is called after the client reconnects and restores its subscriptions,
+// with the range of ledgers that may have been missed. No event is emitted when the client has no subscriptions to restore.
+// Creates a new channel and a goroutine to handle the events.
+func (c *Client) OnReconnect(
+	handler func(event *wstypes.ReconnectEvent),
+) {
+	c.reconnectChan = make(chan *wstypes.ReconnectEvent)
+	go func() {
+		defer close(c.reconnectChan)
+		for event := range c.reconnectChan {
+			handler(event)
+		}
+	}()
+}
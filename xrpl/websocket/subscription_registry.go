@@ -0,0 +1,189 @@
+package websocket
+
+import (
+	"sync"
+
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	subscribe "github.com/Peersyst/xrpl-go/xrpl/queries/subscription"
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// subscriptionRegistry keeps track of the streams, accounts and order books the client
+// is subscribed to, so they can be replayed after the connection is re-established.
+// All methods are safe for concurrent use.
+type subscriptionRegistry struct {
+	mu sync.Mutex
+
+	streams          map[string]struct{}
+	accounts         map[types.Address]struct{}
+	accountsProposed map[types.Address]struct{}
+	books            map[string]streamtypes.OrderBook
+}
+
+func newSubscriptionRegistry() *subscriptionRegistry {
+	return &subscriptionRegistry{
+		streams:          make(map[string]struct{}),
+		accounts:         make(map[types.Address]struct{}),
+		accountsProposed: make(map[types.Address]struct{}),
+		books:            make(map[string]streamtypes.OrderBook),
+	}
+}
+
+// add records every subscription of a successful subscribe request.
+func (r *subscriptionRegistry) add(req *subscribe.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, s := range req.Streams {
+		r.streams[s] = struct{}{}
+	}
+	for _, a := range req.Accounts {
+		r.accounts[a] = struct{}{}
+	}
+	for _, a := range req.AccountsProposed {
+		r.accountsProposed[a] = struct{}{}
+	}
+	for _, b := range req.Books {
+		r.books[bookKey(b.TakerGets, b.TakerPays)] = b
+	}
+}
+
+// addBooks records the given order books and returns the ones that were not
+// already registered.
+func (r *subscriptionRegistry) addBooks(books []streamtypes.OrderBook) []streamtypes.OrderBook {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var added []streamtypes.OrderBook
+	for _, b := range books {
+		key := bookKey(b.TakerGets, b.TakerPays)
+		if _, ok := r.books[key]; ok {
+			continue
+		}
+		r.books[key] = b
+		added = append(added, b)
+	}
+	return added
+}
+
+// removeBooks forgets the given order books.
+func (r *subscriptionRegistry) removeBooks(books []streamtypes.OrderBook) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, b := range books {
+		delete(r.books, bookKey(b.TakerGets, b.TakerPays))
+	}
+}
+
+// remove forgets every subscription cancelled by a successful unsubscribe request.
+func (r *subscriptionRegistry) remove(req *subscribe.UnsubscribeRequest) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, s := range req.Streams {
+		delete(r.streams, s)
+	}
+	for _, a := range req.Accounts {
+		delete(r.accounts, a)
+	}
+	for _, a := range req.AccountsProposed {
+		delete(r.accountsProposed, a)
+	}
+	for _, b := range req.Books {
+		delete(r.books, bookKey(b.TakerGets, b.TakerPays))
+		if b.Both {
+			delete(r.books, bookKey(b.TakerPays, b.TakerGets))
+		}
+	}
+}
+
+// request builds a subscribe request restoring every active subscription.
+// It returns nil if there is nothing to restore.
+func (r *subscriptionRegistry) request() *subscribe.Request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.streams) == 0 && len(r.accounts) == 0 && len(r.accountsProposed) == 0 && len(r.books) == 0 {
+		return nil
+	}
+
+	req := &subscribe.Request{}
+	for s := range r.streams {
+		req.Streams = append(req.Streams, s)
+	}
+	for a := range r.accounts {
+		req.Accounts = append(req.Accounts, a)
+	}
+	for a := range r.accountsProposed {
+		req.AccountsProposed = append(req.AccountsProposed, a)
+	}
+	for _, b := range r.books {
+		req.Books = append(req.Books, b)
+	}
+	return req
+}
+
+// affectsBook reports whether the transaction metadata creates, modifies or deletes
+// an offer in one of the subscribed order books.
+func (r *subscriptionRegistry) affectsBook(meta transaction.TxObjMeta) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.books) == 0 {
+		return false
+	}
+	for _, node := range meta.AffectedNodes {
+		var fields ledger.FlatLedgerObject
+		switch {
+		case node.CreatedNode != nil && node.CreatedNode.LedgerEntryType == ledger.OfferEntry:
+			fields = node.CreatedNode.NewFields
+		case node.ModifiedNode != nil && node.ModifiedNode.LedgerEntryType == ledger.OfferEntry:
+			fields = node.ModifiedNode.FinalFields
+		case node.DeletedNode != nil && node.DeletedNode.LedgerEntryType == ledger.OfferEntry:
+			fields = node.DeletedNode.FinalFields
+		default:
+			continue
+		}
+		takerGets, ok := offerAsset(fields["TakerGets"])
+		if !ok {
+			continue
+		}
+		takerPays, ok := offerAsset(fields["TakerPays"])
+		if !ok {
+			continue
+		}
+		if _, ok := r.books[bookKey(takerGets, takerPays)]; ok {
+			return true
+		}
+		if b, ok := r.books[bookKey(takerPays, takerGets)]; ok && b.Both {
+			return true
+		}
+	}
+	return false
+}
+
+// offerAsset extracts the asset of an offer amount as found in transaction metadata.
+// XRP amounts are strings of drops, tokens are objects with a currency and an issuer.
+func offerAsset(amount any) (types.IssuedCurrencyAmount, bool) {
+	switch v := amount.(type) {
+	case string:
+		return types.IssuedCurrencyAmount{Currency: "XRP"}, true
+	case map[string]any:
+		currency, _ := v["currency"].(string)
+		issuer, _ := v["issuer"].(string)
+		if currency == "" {
+			return types.IssuedCurrencyAmount{}, false
+		}
+		return types.IssuedCurrencyAmount{Currency: currency, Issuer: types.Address(issuer)}, true
+	default:
+		return types.IssuedCurrencyAmount{}, false
+	}
+}
+
+// bookKey identifies an order book by the assets on each side.
+func bookKey(takerGets, takerPays types.IssuedCurrencyAmount) string {
+	return takerGets.Currency + "/" + string(takerGets.Issuer) + ":" + takerPays.Currency + "/" + string(takerPays.Issuer)
+}
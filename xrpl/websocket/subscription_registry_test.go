@@ -0,0 +1,155 @@
+package websocket
+
+import (
+	"testing"
+
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	subscribe "github.com/Peersyst/xrpl-go/xrpl/queries/subscription"
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionRegistry(t *testing.T) {
+	usd := types.IssuedCurrencyAmount{Currency: "USD", Issuer: "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B"}
+	xrp := types.IssuedCurrencyAmount{Currency: "XRP"}
+
+	t.Run("empty registry has nothing to restore", func(t *testing.T) {
+		r := newSubscriptionRegistry()
+		require.Nil(t, r.request())
+	})
+
+	t.Run("restores every active subscription once", func(t *testing.T) {
+		r := newSubscriptionRegistry()
+		r.add(&subscribe.Request{
+			Streams:  []string{"ledger", "transactions"},
+			Accounts: []types.Address{"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"},
+			Books:    []streamtypes.OrderBook{{TakerGets: xrp, TakerPays: usd, Snapshot: true}},
+		})
+		r.add(&subscribe.Request{
+			Streams:          []string{"ledger"},
+			AccountsProposed: []types.Address{"rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn"},
+		})
+
+		req := r.request()
+		require.NotNil(t, req)
+		require.ElementsMatch(t, []string{"ledger", "transactions"}, req.Streams)
+		require.Equal(t, []types.Address{"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"}, req.Accounts)
+		require.Equal(t, []types.Address{"rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn"}, req.AccountsProposed)
+		require.Equal(t, []streamtypes.OrderBook{{TakerGets: xrp, TakerPays: usd, Snapshot: true}}, req.Books)
+	})
+
+	t.Run("removes only newly added books", func(t *testing.T) {
+		r := newSubscriptionRegistry()
+		r.add(&subscribe.Request{Books: []streamtypes.OrderBook{{TakerGets: xrp, TakerPays: usd}}})
+
+		added := r.addBooks([]streamtypes.OrderBook{
+			{TakerGets: xrp, TakerPays: usd},
+			{TakerGets: usd, TakerPays: xrp},
+		})
+		require.Equal(t, []streamtypes.OrderBook{{TakerGets: usd, TakerPays: xrp}}, added)
+
+		r.removeBooks(added)
+		require.Equal(t, []streamtypes.OrderBook{{TakerGets: xrp, TakerPays: usd}}, r.request().Books)
+	})
+
+	t.Run("forgets unsubscribed entries", func(t *testing.T) {
+		r := newSubscriptionRegistry()
+		r.add(&subscribe.Request{
+			Streams:  []string{"ledger", "transactions"},
+			Accounts: []types.Address{"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"},
+			Books: []streamtypes.OrderBook{
+				{TakerGets: xrp, TakerPays: usd},
+				{TakerGets: usd, TakerPays: xrp},
+			},
+		})
+		r.remove(&subscribe.UnsubscribeRequest{
+			Streams:  []string{"transactions"},
+			Accounts: []types.Address{"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"},
+			Books:    []subscribe.UnsubscribeOrderBook{{TakerGets: xrp, TakerPays: usd, Both: true}},
+		})
+
+		req := r.request()
+		require.NotNil(t, req)
+		require.Equal(t, []string{"ledger"}, req.Streams)
+		require.Empty(t, req.Accounts)
+		require.Empty(t, req.Books)
+
+		r.remove(&subscribe.UnsubscribeRequest{Streams: []string{"ledger"}})
+		require.Nil(t, r.request())
+	})
+}
+
+func TestSubscriptionRegistry_AffectsBook(t *testing.T) {
+	usd := types.IssuedCurrencyAmount{Currency: "USD", Issuer: "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B"}
+	xrp := types.IssuedCurrencyAmount{Currency: "XRP"}
+
+	offerMeta := func(takerGets, takerPays any) transaction.TxObjMeta {
+		return transaction.TxObjMeta{
+			AffectedNodes: []transaction.AffectedNode{
+				{ModifiedNode: &transaction.ModifiedNode{
+					LedgerEntryType: ledger.AccountRootEntry,
+					FinalFields:     ledger.FlatLedgerObject{"Balance": "1000"},
+				}},
+				{DeletedNode: &transaction.DeletedNode{
+					LedgerEntryType: ledger.OfferEntry,
+					FinalFields:     ledger.FlatLedgerObject{"TakerGets": takerGets, "TakerPays": takerPays},
+				}},
+			},
+		}
+	}
+	usdAmount := map[string]any{"currency": "USD", "issuer": "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B", "value": "10"}
+	eurAmount := map[string]any{"currency": "EUR", "issuer": "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B", "value": "10"}
+
+	tests := []struct {
+		name     string
+		books    []streamtypes.OrderBook
+		meta     transaction.TxObjMeta
+		expected bool
+	}{
+		{
+			name:     "no book subscriptions",
+			meta:     offerMeta("1000000", usdAmount),
+			expected: false,
+		},
+		{
+			name:     "offer in subscribed book",
+			books:    []streamtypes.OrderBook{{TakerGets: xrp, TakerPays: usd}},
+			meta:     offerMeta("1000000", usdAmount),
+			expected: true,
+		},
+		{
+			name:     "offer in reverse book without both",
+			books:    []streamtypes.OrderBook{{TakerGets: xrp, TakerPays: usd}},
+			meta:     offerMeta(usdAmount, "1000000"),
+			expected: false,
+		},
+		{
+			name:     "offer in reverse book with both",
+			books:    []streamtypes.OrderBook{{TakerGets: xrp, TakerPays: usd, Both: true}},
+			meta:     offerMeta(usdAmount, "1000000"),
+			expected: true,
+		},
+		{
+			name:     "offer in another book",
+			books:    []streamtypes.OrderBook{{TakerGets: xrp, TakerPays: usd, Both: true}},
+			meta:     offerMeta("1000000", eurAmount),
+			expected: false,
+		},
+		{
+			name:     "no offers affected",
+			books:    []streamtypes.OrderBook{{TakerGets: xrp, TakerPays: usd}},
+			meta:     transaction.TxObjMeta{},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newSubscriptionRegistry()
+			r.add(&subscribe.Request{Books: tt.books})
+			require.Equal(t, tt.expected, r.affectsBook(tt.meta))
+		})
+	}
+}
@@ -2,11 +2,18 @@ package websocket
 
 import (
 	"reflect"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	subscribe "github.com/Peersyst/xrpl-go/xrpl/queries/subscription"
+	streamtypes "github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 	"github.com/Peersyst/xrpl-go/xrpl/websocket/testutil"
+	wstypes "github.com/Peersyst/xrpl-go/xrpl/websocket/types"
 	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
 )
 
 func TestClient_Subscribe(t *testing.T) {
@@ -55,11 +62,8 @@ func TestClient_Subscribe(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			ws := &testutil.MockWebSocketServer{Msgs: tt.serverMessages}
 			s := ws.TestWebSocketServer(func(c *websocket.Conn) {
-				for _, m := range tt.serverMessages {
-					err := c.WriteJSON(m)
-					if err != nil {
-						t.Errorf("error writing message: %v", err)
-					}
+				if err := testutil.RespondInOrder(c, tt.serverMessages); err != nil {
+					t.Errorf("error writing message: %v", err)
 				}
 			})
 			defer s.Close()
@@ -123,11 +127,8 @@ func TestClient_Unsubscribe(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			ws := &testutil.MockWebSocketServer{Msgs: tt.serverMessages}
 			s := ws.TestWebSocketServer(func(c *websocket.Conn) {
-				for _, m := range tt.serverMessages {
-					err := c.WriteJSON(m)
-					if err != nil {
-						t.Errorf("error writing message: %v", err)
-					}
+				if err := testutil.RespondInOrder(c, tt.serverMessages); err != nil {
+					t.Errorf("error writing message: %v", err)
 				}
 			})
 			defer s.Close()
@@ -159,3 +160,225 @@ func TestClient_Unsubscribe(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_ReconnectRestoresSubscriptions(t *testing.T) {
+	var connections atomic.Int32
+	restored := make(chan map[string]any, 1)
+
+	ms := &testutil.MockWebSocketServer{}
+	s := ms.TestWebSocketServer(func(c *websocket.Conn) {
+		var req map[string]any
+		if err := c.ReadJSON(&req); err != nil {
+			return
+		}
+
+		if connections.Add(1) == 1 {
+			_ = c.WriteJSON(map[string]any{"id": req["id"], "result": map[string]any{"ledger_index": 100}})
+			_ = c.WriteJSON(map[string]any{"type": "ledgerClosed", "ledger_index": 101})
+			// Drop the connection as a server restart would.
+			_ = c.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, ""))
+			return
+		}
+
+		restored <- req
+		_ = c.WriteJSON(map[string]any{"id": req["id"], "result": map[string]any{"ledger_index": 105}})
+		// Keep the connection open until the client disconnects.
+		_, _, _ = c.ReadMessage()
+	})
+	defer s.Close()
+
+	url, _ := testutil.ConvertHTTPToWS(s.URL)
+	cl := NewClient(NewClientConfig().WithHost(url).WithTimeout(2 * time.Second))
+	require.NoError(t, cl.Connect())
+	defer cl.Disconnect()
+
+	ledgers := make(chan *streamtypes.LedgerStream, 1)
+	cl.OnLedgerClosed(func(ledger *streamtypes.LedgerStream) {
+		ledgers <- ledger
+	})
+	events := make(chan *wstypes.ReconnectEvent, 1)
+	cl.OnReconnect(func(event *wstypes.ReconnectEvent) {
+		events <- event
+	})
+
+	_, err := cl.Subscribe(&subscribe.Request{
+		Streams:  []string{"ledger"},
+		Accounts: []types.Address{"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"},
+	})
+	require.NoError(t, err)
+
+	select {
+	case req := <-restored:
+		require.Equal(t, "subscribe", req["command"])
+		require.Equal(t, []any{"ledger"}, req["streams"])
+		require.Equal(t, []any{"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"}, req["accounts"])
+	case <-time.After(2 * time.Second):
+		t.Fatal("subscriptions were not restored after reconnecting")
+	}
+
+	select {
+	case event := <-events:
+		require.Equal(t, 1, event.Attempt)
+		first, last, ok := event.MissedLedgers()
+		require.True(t, ok)
+		require.Equal(t, common.LedgerIndex(102), first)
+		require.Equal(t, common.LedgerIndex(105), last)
+	case <-time.After(2 * time.Second):
+		t.Fatal("reconnect event was not emitted")
+	}
+	require.Equal(t, common.LedgerIndex(101), (<-ledgers).LedgerIndex)
+}
+
+func TestClient_RestoreSubscriptionsWithoutSubscriptions(t *testing.T) {
+	// A zero value client has no subscriptions, so nothing is sent on the
+	// missing connection and no event is emitted.
+	cl := &Client{}
+	events := make(chan *wstypes.ReconnectEvent, 1)
+	cl.OnReconnect(func(event *wstypes.ReconnectEvent) {
+		events <- event
+	})
+
+	cl.restoreSubscriptions(1)
+
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected reconnect event: %+v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestClient_RestoreSubscriptionsErrorWithoutHandler(t *testing.T) {
+	cl := NewClient(NewClientConfig().WithHost("ws://localhost"))
+	cl.registry().add(&subscribe.Request{Streams: []string{"ledger"}})
+
+	done := make(chan struct{})
+	go func() {
+		// The client isn't connected, so restoring fails. With no OnError
+		// handler the error is dropped instead of blocking.
+		cl.restoreSubscriptions(1)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("restoreSubscriptions blocked reporting its error")
+	}
+}
+
+func TestClient_OrderBookAndBookChangesStreams(t *testing.T) {
+	usd := types.IssuedCurrencyAmount{Currency: "USD", Issuer: "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B"}
+	xrp := types.IssuedCurrencyAmount{Currency: "XRP"}
+
+	ms := &testutil.MockWebSocketServer{}
+	s := ms.TestWebSocketServer(func(c *websocket.Conn) {
+		var req map[string]any
+		if err := c.ReadJSON(&req); err != nil {
+			return
+		}
+		_ = c.WriteJSON(map[string]any{"id": req["id"], "result": map[string]any{}})
+		// A payment that does not touch the subscribed book.
+		_ = c.WriteJSON(map[string]any{
+			"type":         "transaction",
+			"ledger_index": 7,
+			"meta":         map[string]any{"TransactionResult": "tesSUCCESS"},
+			"tx_json":      map[string]any{"TransactionType": "Payment"},
+		})
+		_ = c.WriteJSON(map[string]any{
+			"type":         "transaction",
+			"ledger_index": 7,
+			"validated":    true,
+			"meta": map[string]any{
+				"TransactionResult": "tesSUCCESS",
+				"AffectedNodes": []any{
+					map[string]any{"CreatedNode": map[string]any{
+						"LedgerEntryType": "Offer",
+						"NewFields": map[string]any{
+							"TakerGets": "1000000",
+							"TakerPays": map[string]any{"currency": "USD", "issuer": "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B", "value": "1"},
+						},
+					}},
+				},
+			},
+			"tx_json": map[string]any{"TransactionType": "OfferCreate"},
+		})
+		_ = c.WriteJSON(map[string]any{
+			"type":         "bookChanges",
+			"ledger_index": 7,
+			"ledger_time":  781234567,
+			"changes": []any{
+				map[string]any{
+					"currency_a": "XRP_drops",
+					"currency_b": "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B/USD",
+					"volume_a":   "1000000",
+					"volume_b":   "1",
+					"high":       "1000000",
+					"low":        "1000000",
+					"open":       "1000000",
+					"close":      "1000000",
+				},
+			},
+		})
+		// Keep the connection open until the client disconnects.
+		_, _, _ = c.ReadMessage()
+	})
+	defer s.Close()
+
+	url, _ := testutil.ConvertHTTPToWS(s.URL)
+	cl := NewClient(NewClientConfig().WithHost(url).WithTimeout(2 * time.Second))
+	require.NoError(t, cl.Connect())
+	defer cl.Disconnect()
+
+	orderBooks := make(chan *streamtypes.OrderBookStream, 2)
+	cl.OnOrderBook(func(orderBook *streamtypes.OrderBookStream) {
+		orderBooks <- orderBook
+	})
+	bookChanges := make(chan *streamtypes.BookChangesStream, 1)
+	cl.OnBookChanges(func(changes *streamtypes.BookChangesStream) {
+		bookChanges <- changes
+	})
+	errs := make(chan error, 1)
+	cl.OnError(func(err error) {
+		errs <- err
+	})
+
+	_, err := cl.Subscribe(&subscribe.Request{
+		Streams: []string{"book_changes"},
+		Books:   []streamtypes.OrderBook{{TakerGets: xrp, TakerPays: usd}},
+	})
+	require.NoError(t, err)
+
+	select {
+	case orderBook := <-orderBooks:
+		require.Equal(t, streamtypes.OrderBookStreamType, orderBook.Type)
+		require.True(t, orderBook.Validated)
+		require.Equal(t, "OfferCreate", orderBook.Transaction["TransactionType"])
+	case err := <-errs:
+		t.Fatalf("unexpected stream error: %v", err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("order book update was not delivered")
+	}
+
+	select {
+	case changes := <-bookChanges:
+		require.Equal(t, streamtypes.BookChangesStreamType, changes.Type)
+		require.Equal(t, common.LedgerIndex(7), changes.LedgerIndex)
+		require.Equal(t, []streamtypes.BookUpdate{{
+			CurrencyA: "XRP_drops",
+			CurrencyB: "rvYAfWj5gh67oV6fW32ZzP3Aw4Eubs59B/USD",
+			VolumeA:   "1000000",
+			VolumeB:   "1",
+			High:      "1000000",
+			Low:       "1000000",
+			Open:      "1000000",
+			Close:     "1000000",
+		}}, changes.Changes)
+	case err := <-errs:
+		t.Fatalf("unexpected stream error: %v", err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("book changes were not delivered")
+	}
+
+	// The payment does not touch the subscribed book.
+	require.Empty(t, orderBooks)
+}
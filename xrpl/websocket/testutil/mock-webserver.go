@@ -45,3 +45,18 @@ func ConvertHTTPToWS(u string) (string, error) {
 
 	return s.String(), nil
 }
+
+// RespondInOrder answers each request read from c with the next message in msgs,
+// the way a server only replies once it has received a request.
+// It returns nil when the client goes away before every message is sent.
+func RespondInOrder(c *websocket.Conn, msgs []map[string]any) error {
+	for _, m := range msgs {
+		if _, _, err := c.ReadMessage(); err != nil {
+			return nil
+		}
+		if err := c.WriteJSON(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -4,6 +4,9 @@ import (
 	"github.com/Peersyst/xrpl-go/xrpl/queries/subscription/types"
 )
 
+// PathFindMessageType is the type of the asynchronous updates of an open path_find request.
+const PathFindMessageType types.Type = "path_find"
+
 // Message is a struct that represents a message from the websocket.
 // It contains every field that can be found in a websocket message.
 type Message struct {
@@ -24,3 +27,8 @@ func (m *Message) IsRequest() bool {
 func (m *Message) IsStream() bool {
 	return m.Type != ""
 }
+
+// IsPathFind returns true if the message is an update of an open path_find request.
+func (m *Message) IsPathFind() bool {
+	return m.Type == PathFindMessageType
+}
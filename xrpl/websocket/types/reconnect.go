@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+)
+
+// ReconnectEvent is emitted after the client re-establishes a lost connection and
+// restores its subscriptions. Stream messages for ledgers closed while the client
+// was disconnected are not replayed by the server, so consumers can use the event
+// to backfill them.
+type ReconnectEvent struct {
+	// Number of the reconnection attempt that succeeded, starting at 1.
+	Attempt int
+	// The ledger index of the last ledger the client received before the connection
+	// was lost. Zero if none had been received.
+	LastLedgerIndex common.LedgerIndex
+	// The latest validated ledger index reported by the server once the subscriptions
+	// were restored. Streams only deliver ledgers closed after this one.
+	CurrentLedgerIndex common.LedgerIndex
+}
+
+// MissedLedgers returns the inclusive range of ledgers that closed while the client
+// was disconnected. ok is false if no ledger was missed or the range is unknown.
+func (e *ReconnectEvent) MissedLedgers() (first, last common.LedgerIndex, ok bool) {
+	if e.LastLedgerIndex == 0 || e.CurrentLedgerIndex <= e.LastLedgerIndex {
+		return 0, 0, false
+	}
+	return e.LastLedgerIndex + 1, e.CurrentLedgerIndex, true
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconnectEvent_MissedLedgers(t *testing.T) {
+	tests := []struct {
+		name      string
+		event     ReconnectEvent
+		wantFirst common.LedgerIndex
+		wantLast  common.LedgerIndex
+		wantOk    bool
+	}{
+		{
+			name:      "ledgers closed while disconnected",
+			event:     ReconnectEvent{LastLedgerIndex: 100, CurrentLedgerIndex: 105},
+			wantFirst: 101,
+			wantLast:  105,
+			wantOk:    true,
+		},
+		{
+			name:   "no ledger closed while disconnected",
+			event:  ReconnectEvent{LastLedgerIndex: 100, CurrentLedgerIndex: 100},
+			wantOk: false,
+		},
+		{
+			name:   "no ledger seen before disconnecting",
+			event:  ReconnectEvent{CurrentLedgerIndex: 105},
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, last, ok := tt.event.MissedLedgers()
+			require.Equal(t, tt.wantOk, ok)
+			require.Equal(t, tt.wantFirst, first)
+			require.Equal(t, tt.wantLast, last)
+		})
+	}
+}